@@ -7,13 +7,20 @@ import "github.com/neko233-com/config233-go/pkg/config233"
 
 // FishingKvConfig 配置结构体
 type FishingKvConfig struct {
-	Id    string `json:"id"`
+	// 唯一标识
+	Id string `json:"id"`
+	// 内容
 	Value string `json:"value"`
 }
 
 // Ensure FishingKvConfig implements IKvConfig interface
 var _ config233.IKvConfig = (*FishingKvConfig)(nil)
 
+// GetUid 实现 IKvConfig 接口
+func (c *FishingKvConfig) GetUid() any {
+	return c.Id
+}
+
 // GetValue 实现 IKvConfig 接口
 func (c *FishingKvConfig) GetValue() string {
 	return c.Value
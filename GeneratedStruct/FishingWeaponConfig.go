@@ -5,7 +5,10 @@ package generated
 
 // FishingWeaponConfig 配置结构体
 type FishingWeaponConfig struct {
-	Id                  int `json:"id"`
+	// 唯一标识
+	Id int `json:"id"`
+	// 解锁价格
 	UnlockCostGoldCount int `json:"unlockCostGoldCount"`
-	SkillId             int `json:"skillId"`
+	// 钓鱼主动技能 id - 1
+	SkillId int `json:"skillId"`
 }
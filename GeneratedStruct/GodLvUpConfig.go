@@ -5,9 +5,14 @@ package generated
 
 // GodLvUpConfig 配置结构体
 type GodLvUpConfig struct {
-	Id                     int    `json:"id"`
-	LvUpConditionText      string `json:"lvUpConditionText"`
-	CostItemText           string `json:"costItemText"`
-	LvUpNeedWaitTimeSecond int64  `json:"lvUpNeedWaitTimeSecond"`
-	UnlockOtherBuildingIds []int  `json:"unlockOtherBuildingIds"`
+	// 神职等级
+	Id int `json:"id"`
+	// 解锁条件，空 = 默认解锁 配置逻辑转 ConditionConfig
+	LvUpConditionText string `json:"lvUpConditionText"`
+	// 消耗道具
+	CostItemText string `json:"costItemText"`
+	// 升级所需时间，单位秒
+	LvUpNeedWaitTimeSecond int64 `json:"lvUpNeedWaitTimeSecond"`
+	// 升到这一级别，解锁其他建筑 BuildingMainConfig
+	UnlockOtherBuildingIds []int `json:"unlockOtherBuildingIds"`
 }
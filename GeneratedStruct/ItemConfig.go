@@ -5,16 +5,28 @@ package generated
 
 // ItemConfig 配置结构体
 type ItemConfig struct {
-	ItemId           int64  `json:"itemId"`
-	ItemName         string `json:"itemName"`
-	Desc             string `json:"desc"`
-	BagType          string `json:"bagType"`
-	Type             int    `json:"type"`
-	ExpireTimeMs     int64  `json:"expireTimeMs"`
-	Quality          int    `json:"quality"`
+	// 物品id
+	ItemId int64 `json:"itemId"`
+	// 物品名称
+	ItemName string `json:"itemName"`
+	// 物品描述信息
+	Desc string `json:"desc"`
+	// 背包分组
+	BagType string `json:"bagType"`
+	// 道具类型
+	Type int `json:"type"`
+	// 过期毫秒数
+	ExpireTimeMs int64 `json:"expireTimeMs"`
+	// 道具品质
+	Quality int `json:"quality"`
+	// 物品使用条件
 	UseConditionList string `json:"useConditionList"`
-	UseItemContext   string `json:"useItemContext"`
-	StackNumber      int    `json:"stackNumber"`
-	JumpId           int    `json:"jumpId"`
-	Sort             int    `json:"sort"`
+	// 物品使用的上下文
+	UseItemContext string `json:"useItemContext"`
+	// 物品最大堆栈数量
+	StackNumber int `json:"stackNumber"`
+	// 跳转id
+	JumpId int `json:"jumpId"`
+	// 排序值
+	Sort int `json:"sort"`
 }
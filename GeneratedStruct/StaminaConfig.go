@@ -5,8 +5,12 @@ package generated
 
 // StaminaConfig 配置结构体
 type StaminaConfig struct {
-	Type               string `json:"type"`
-	MaxStaminaValue    int    `json:"maxStaminaValue"`
-	RecoveryValue      int    `json:"recoveryValue"`
-	RecoveryIntervalMs int64  `json:"recoveryIntervalMs"`
+	// 体力值类型 不同关卡类型可能消耗不同的“体力”
+	Type string `json:"type"`
+	// 最大体力值
+	MaxStaminaValue int `json:"maxStaminaValue"`
+	// 每次恢体力复值
+	RecoveryValue int `json:"recoveryValue"`
+	// 恢复间隔毫秒值
+	RecoveryIntervalMs int64 `json:"recoveryIntervalMs"`
 }
@@ -5,6 +5,8 @@ package generated
 
 // StudentExcel 配置结构体
 type StudentExcel struct {
-	Id   int64  `json:"id"`
+	// 物品id
+	Id int64 `json:"id"`
+	// 物品名称
 	Name string `json:"name"`
 }
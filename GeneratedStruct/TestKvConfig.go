@@ -7,13 +7,20 @@ import "github.com/neko233-com/config233-go/pkg/config233"
 
 // TestKvConfig 配置结构体
 type TestKvConfig struct {
-	Id    string `json:"id"`
+	// 配置ID
+	Id string `json:"id"`
+	// 值 配置值
 	Value string `json:"value"`
 }
 
 // Ensure TestKvConfig implements IKvConfig interface
 var _ config233.IKvConfig = (*TestKvConfig)(nil)
 
+// GetUid 实现 IKvConfig 接口
+func (c *TestKvConfig) GetUid() any {
+	return c.Id
+}
+
 // GetValue 实现 IKvConfig 接口
 func (c *TestKvConfig) GetValue() string {
 	return c.Value
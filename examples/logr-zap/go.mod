@@ -0,0 +1,32 @@
+module github.com/neko233-com/config233-go/examples/logr-zap
+
+go 1.21
+
+require (
+	github.com/go-logr/zapr v1.3.0
+	github.com/neko233-com/config233-go v0.0.0
+	go.uber.org/zap v1.28.0
+)
+
+require (
+	github.com/extrame/goyymmdd v0.0.0-20210114090516-7cc815f00d1a // indirect
+	github.com/extrame/ole2 v0.0.0-20160812065207-d69429661ad7 // indirect
+	github.com/extrame/xls v0.0.2-0.20200426124601-4a6cf263071b // indirect
+	github.com/fsnotify/fsnotify v1.7.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/mohae/deepcopy v0.0.0-20170929034955-c48cc78d4826 // indirect
+	github.com/richardlehane/mscfb v1.0.4 // indirect
+	github.com/richardlehane/msoleps v1.0.3 // indirect
+	github.com/tealeg/xlsx v1.0.5 // indirect
+	github.com/xuri/efp v0.0.0-20220603152613-6918739fd470 // indirect
+	github.com/xuri/excelize/v2 v2.7.1 // indirect
+	github.com/xuri/nfp v0.0.0-20220409054826-5e722a1d9e22 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/crypto v0.8.0 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	golang.org/x/sys v0.7.0 // indirect
+	golang.org/x/text v0.9.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/neko233-com/config233-go => ../..
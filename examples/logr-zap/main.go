@@ -0,0 +1,59 @@
+// logr-zap 演示通过 zapr 把 zap 日志库接入 config233
+// 单独作为一个 module，避免主 module 依赖 zap；运行: cd examples/logr-zap && go run .
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/zapr"
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"go.uber.org/zap"
+)
+
+// Student 示例配置结构体
+type Student struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+func main() {
+	fmt.Println("Config233-Go zap 日志示例")
+
+	zapLogger, err := zap.NewDevelopment()
+	if err != nil {
+		fmt.Println("创建 zap 日志失败:", err)
+		return
+	}
+	defer zapLogger.Sync()
+
+	// zapr.NewLogger 把 *zap.Logger 转为 logr.Logger，可直接传给 SetLogger
+	config233.SetLogger(zapr.NewLogger(zapLogger))
+	defer config233.SetLogger(nil)
+
+	dir, err := os.MkdirTemp("", "config233-zap")
+	if err != nil {
+		fmt.Println("创建临时目录失败:", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	content := `[{"id": 1, "name": "Alice", "age": 18}, {"id": 2, "name": "Bob", "age": 20}]`
+	if err := os.WriteFile(filepath.Join(dir, "Student.json"), []byte(content), 0644); err != nil {
+		fmt.Println("写入配置失败:", err)
+		return
+	}
+
+	// 加载过程中的日志由 zap 输出
+	manager := config233.NewConfigManager233(dir)
+	config233.RegisterType[Student]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		fmt.Println("加载配置失败:", err)
+		return
+	}
+
+	if student, ok := config233.GetConfigById[Student](2); ok {
+		fmt.Printf("找到配置: %+v\n", student)
+	}
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"log"
+	"log/slog"
+	"os"
 
 	"github.com/neko233-com/config233-go/pkg/config233"
 
@@ -44,13 +46,13 @@ type Student struct {
 }
 
 func main() {
-	fmt.Println("Config233-Go logr 日志接口示例")
+	fmt.Println("Config233-Go 日志接口示例")
 
 	// 注册配置类型
 	config233.RegisterType[Student]()
 
-	// 示例1: 使用默认日志（静默模式，不输出日志）
-	fmt.Println("=== 使用默认日志（静默模式） ===")
+	// 示例1: 使用默认日志（ConsoleLogger，输出到标准输出）
+	fmt.Println("=== 使用默认日志 ===")
 	config, exists := config233.GetConfigById[Student](1)
 	if exists {
 		fmt.Printf("找到配置: %+v\n", config)
@@ -58,12 +60,11 @@ func main() {
 		fmt.Println("配置不存在")
 	}
 
-	// 示例2: 设置标准库日志实现
-	fmt.Println("\n=== 设置标准库日志实现 ===")
-	logger := logr.New(&stdLogger{})
-	config233.SetLogger(logger)
+	// 示例2: logr.Logger 可直接传给 SetLogger
+	fmt.Println("\n=== 使用 logr（标准库 log 作为 LogSink） ===")
+	config233.SetLogger(logr.New(&stdLogger{}))
 
-	// 现在日志调用会输出到控制台
+	// 现在日志调用会输出到 log 标准输出
 	config, exists = config233.GetConfigById[Student](1)
 	if exists {
 		fmt.Printf("找到配置: %+v\n", config)
@@ -71,9 +72,13 @@ func main() {
 		fmt.Println("配置不存在")
 	}
 
-	// 示例3: 使用其他日志库（如 zap）
-	fmt.Println("\n=== 使用 zap 日志库示例（需要额外安装） ===")
-	fmt.Println("// go get go.uber.org/zap")
-	fmt.Println("// zapLogger := zap.New(...)")
-	fmt.Println("// config233.SetLogger(zapr.NewLogger(zapLogger))")
+	// 示例3: 使用标准库 log/slog
+	fmt.Println("\n=== 使用标准库 slog ===")
+	config233.SetLogger(config233.NewSlogLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
+
+	// 示例4: zap 需要额外依赖，见独立 module examples/logr-zap
+	fmt.Println("\n=== 使用 zap：见 examples/logr-zap ===")
+
+	// 恢复默认日志
+	config233.SetLogger(nil)
 }
@@ -48,6 +48,17 @@ func main() {
 		fmt.Printf("  %d. %s\n", i+1, name)
 	}
 
+	// 5.1 查看加载统计，定位拖慢启动的大表
+	summary := manager.GetLoadStatsSummary()
+	fmt.Printf("\n📊 加载统计: 文件 %d 个, 记录 %d 条, 解析总耗时 %v\n",
+		summary.FileCount, summary.RecordCount, summary.TotalParseDuration)
+	stats := manager.GetLoadStats()
+	for _, name := range summary.SlowestConfigs {
+		stat := stats[name]
+		fmt.Printf("  - %s [%s] 记录 %d 条, 解析 %v, 结构体转换: %v\n",
+			name, stat.Format, stat.RecordCount, stat.ParseDuration, stat.StructConverted)
+	}
+
 	// 6. 使用配置数据
 	fmt.Println("\n📖 配置使用示例:")
 
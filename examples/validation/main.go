@@ -33,7 +33,11 @@ func main() {
 
 	// 1. 直接测试 Excel 处理器读取的数据
 	handler := &excel.ExcelConfigHandler{}
-	dtoResult := handler.ReadToFrontEndDataList("ItemConfig", "./testdata/ItemConfig.xlsx").(*dto.FrontEndConfigDto)
+	result, err := handler.ReadToFrontEndDataList("ItemConfig", "./testdata/ItemConfig.xlsx")
+	if err != nil {
+		log.Fatalf("读取 Excel 失败: %v", err)
+	}
+	dtoResult := result.(*dto.FrontEndConfigDto)
 
 	fmt.Printf("Excel 读取的数据项数量: %d\n", len(dtoResult.DataList))
 
@@ -52,7 +56,7 @@ func main() {
 
 	// 3. 加载配置
 	manager := config233.NewConfigManager233("../testdata")
-	err := manager.LoadAllConfigs()
+	err = manager.LoadAllConfigs()
 	if err != nil {
 		log.Printf("加载配置失败: %v", err)
 	}
@@ -15,11 +15,17 @@ import "reflect"
 //	    Id    string `json:"id"`
 //	    Value string `json:"value"`
 //	}
+//	func (c *GameConfig) GetUid() any { return c.Id }
 //	func (c *GameConfig) GetValue() string { return c.Value }
 //
 //	// 使用时：
 //	maxLevel := config233.GetKvToInt[GameConfig]("max_level", 100)
 type IKvConfig interface {
+	// GetUid 获取配置项的唯一标识
+	// 返回值:
+	//   any: 配置项的 id，KV 查找按它定位配置项
+	GetUid() any
+
 	// GetValue 获取配置值的字符串表示
 	// 返回配置项的值，用于后续的类型转换
 	// 返回值:
@@ -59,6 +65,7 @@ type IBusinessConfigManager interface {
 	// 调用时机:
 	//   - 首次加载所有配置完成后
 	//   - 热重载检测到配置文件变更后
+	//   - 热重载检测到配置文件被删除或重命名后（此时该配置已从内存移除，查询不到）
 	//   - 手动调用 LoadAllConfigs() 或 Reload() 后
 	//
 	// 参数:
@@ -71,12 +78,13 @@ type IBusinessConfigManager interface {
 	//   - 使用批量回调避免频繁调用（N个配置变更只调用1次而非N次）
 	//   - 可以精确知道哪些配置发生了变更，避免无关的缓存刷新
 	//   - 支持多个业务管理器同时注册，按注册顺序依次调用
+	//   - 某个管理器的回调 panic 会被捕获并记录错误日志，不影响后续管理器和加载流程
 	OnConfigLoadComplete(changedConfigNameList []string)
 
 	// OnFirstAllConfigDone 首次所有配置加载完成后调用
 	//
 	// 调用时机:
-	//   - 仅在首次启动且所有配置加载完成后调用一次
+	//   - 仅在第一次所有配置文件都加载成功的全量加载后调用一次，有文件加载失败的全量加载不会触发
 	//   - 热重载时不会重复调用
 	//   - 使用 atomic.CompareAndSwap 确保全局只调用一次
 	//
@@ -94,6 +102,59 @@ type IBusinessConfigManager interface {
 	OnFirstAllConfigDone()
 }
 
+// IConfigRemovalListener 配置删除监听接口（可选）
+// 业务管理器在实现 IBusinessConfigManager 的基础上可额外实现此接口，
+// 以便单独处理配置文件被删除或重命名的情况，未实现的管理器不受影响
+//
+// 示例:
+//
+//	func (m *MyBusinessManager) OnConfigRemoved(removedConfigNameList []string) {
+//	    for _, name := range removedConfigNameList {
+//	        delete(m.caches, name)
+//	    }
+//	}
+type IConfigRemovalListener interface {
+	// OnConfigRemoved 配置被删除后回调（批量）
+	//
+	// 调用时机:
+	//   - 热重载检测到配置文件被删除或重命名后，在 OnConfigLoadComplete 之前调用
+	//   - 此时配置已从内存移除，查询不到
+	//
+	// 参数:
+	//   removedConfigNameList: 本次被删除的配置名称列表，每个管理器收到独立副本
+	OnConfigRemoved(removedConfigNameList []string)
+}
+
+// IConfigItemChangeListener 配置项级别变更监听接口（可选）
+// 业务管理器在实现 IBusinessConfigManager 的基础上可额外实现此接口，
+// 热重载时按配置项 ID 拿到新增、修改、删除的明细，用于精确刷新缓存
+//
+// 示例:
+//
+//	func (m *MyBusinessManager) OnConfigItemsChanged(configName string, added, modified, removed []string) {
+//	    for _, id := range removed {
+//	        delete(m.itemCache, id)
+//	    }
+//	    for _, id := range append(added, modified...) {
+//	        m.itemCache[id], _ = config233.GetConfigById[ItemConfig](id)
+//	    }
+//	}
+type IConfigItemChangeListener interface {
+	// OnConfigItemsChanged 配置项变更后回调（每个有变更的配置调用一次）
+	//
+	// 调用时机:
+	//   - 热重载（含 ReloadConfig / ReloadConfigs）写入新数据后、OnConfigLoadComplete 之前调用
+	//   - 对比重载前后的 ID 索引：内容是否变化按 JSON 序列化结果比较，没有任何变化的配置不回调
+	//   - 配置文件被删除时其全部 ID 视为删除，新出现的配置文件其全部 ID 视为新增
+	//
+	// 参数:
+	//   configName: 配置名称
+	//   added: 新增的配置项 ID（升序）
+	//   modified: 内容发生变化的配置项 ID（升序）
+	//   removed: 被删除的配置项 ID（升序）
+	OnConfigItemsChanged(configName string, added, modified, removed []string)
+}
+
 // =============================================================================
 // 配置处理器接口（用于扩展支持新的配置文件格式）
 // =============================================================================
@@ -105,22 +166,30 @@ type IBusinessConfigManager interface {
 // 内置处理器:
 //   - ExcelConfigHandler: 处理 .xlsx/.xls 文件
 //   - JsonConfigHandler: 处理 .json 文件
-//   - TsvConfigHandler: 处理 .tsv 文件
+//   - TsvConfigHandler: 处理 .tsv 文件（Delimiter 设为 ',' 时处理 .csv 文件）
+//   - YamlConfigHandler: 处理 .yaml/.yml 文件
+//   - XmlConfigHandler: 处理 .xml 文件
 //
 // 自定义处理器示例:
 //
-//	type YamlConfigHandler struct {}
+//	type TomlConfigHandler struct {}
 //
-//	func (h *YamlConfigHandler) TypeName() string { return "yaml" }
+//	func (h *TomlConfigHandler) TypeName() string { return "toml" }
 //
-//	func (h *YamlConfigHandler) ReadToFrontEndDataList(configName, filePath string) interface{} {
-//	    data := h.parseYamlFile(filePath)
-//	    return &dto.FrontEndConfigDto{DataList: data}
+//	func (h *TomlConfigHandler) ReadToFrontEndDataList(configName, filePath string) (interface{}, error) {
+//	    data, err := h.parseTomlFile(filePath)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return &dto.FrontEndConfigDto{DataList: data}, nil
 //	}
 //
-//	func (h *YamlConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, filePath string) []interface{} {
-//	    data := h.parseYamlFile(filePath)
-//	    return h.convertToType(typ, data)
+//	func (h *TomlConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, filePath string) ([]interface{}, error) {
+//	    data, err := h.parseTomlFile(filePath)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return h.convertToType(typ, data), nil
 //	}
 type ConfigHandler interface {
 	// TypeName 处理器类型名
@@ -137,7 +206,8 @@ type ConfigHandler interface {
 	//   configFileFullPath: 配置文件的完整路径
 	// 返回值:
 	//   interface{}: 前端配置数据传输对象（实际类型为*dto.FrontEndConfigDto）
-	ReadToFrontEndDataList(configName, configFileFullPath string) interface{}
+	//   error: 文件无法读取或内容无法解析时返回错误
+	ReadToFrontEndDataList(configName, configFileFullPath string) (interface{}, error)
 
 	// ReadConfigAndORM 读取配置并转换为对象列表
 	// 读取配置文件并使用反射将其转换为指定类型的对象列表
@@ -148,7 +218,9 @@ type ConfigHandler interface {
 	//   configFileFullPath: 配置文件的完整路径
 	// 返回值:
 	//   []interface{}: 配置对象实例列表，每个元素都是typ类型的实例
-	ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) []interface{}
+	//   error: 文件无法读取或内容无法解析时返回错误；
+	//     部分单元格类型转换失败时可返回 ConversionErrors，此时对象列表仍然有效
+	ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) ([]interface{}, error)
 }
 
 // IConfigHandler 配置处理器接口（简化版）
@@ -165,7 +237,8 @@ type IConfigHandler interface {
 	//   interface{}: 应该返回 *dto.FrontEndConfigDto 类型
 	//     其中 DataList 字段包含解析后的配置数据数组
 	//     每个数组元素是 map[string]interface{} 格式
-	ReadToFrontEndDataList(configName string, filePath string) interface{}
+	//   error: 文件无法读取或内容无法解析时返回错误
+	ReadToFrontEndDataList(configName string, filePath string) (interface{}, error)
 }
 
 // =============================================================================
@@ -15,3 +15,13 @@ type IConfigValidator interface {
 	// 返回 nil 表示校验通过，否则返回错误信息
 	Check() error
 }
+
+// IConfigCellUnmarshaler 自定义字段解码接口
+// 字段类型（通常以指针接收者）实现此接口时，Excel / TSV / JSON 转换时用它解析单元格原始内容，
+// 不再走默认的类型转换，适合坐标 "x,y,z"、颜色 "#FFAA00" 这类需要特殊解析的字段
+// 单元格为空时不调用，字段保持零值
+type IConfigCellUnmarshaler interface {
+	// UnmarshalConfigCell 解析单元格原始内容
+	// 返回错误时按类型转换失败处理（记录带行列信息的错误，字段保持零值）
+	UnmarshalConfigCell(raw string) error
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -166,6 +167,48 @@ func TestBatchCallback_OnFirstAllConfigDone(t *testing.T) {
 	t.Log("✓ OnFirstAllConfigDone 测试通过")
 }
 
+// TestBatchCallback_OnFirstAllConfigDone_NotOnHotReload 测试加载失败与热重载都不会触发 OnFirstAllConfigDone
+func TestBatchCallback_OnFirstAllConfigDone_NotOnHotReload(t *testing.T) {
+	tempDir := t.TempDir()
+	configNames := createTestConfigs(t, tempDir, 2)
+	brokenPath := filepath.Join(tempDir, "BrokenConfig.json")
+	if err := os.WriteFile(brokenPath, []byte(`[{"id":`), 0644); err != nil {
+		t.Fatalf("创建测试文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	mockManager := newMockBusinessManager()
+	manager.RegisterBusinessManager(mockManager)
+
+	// 严格模式加载失败，不算首次加载完成
+	if err := manager.LoadAllConfigsStrict(); err == nil {
+		t.Fatal("存在损坏的配置文件时严格模式加载应失败")
+	}
+	if firstDoneCount := mockManager.getFirstDoneCount(); firstDoneCount != 0 {
+		t.Errorf("加载失败时不应调用 OnFirstAllConfigDone，实际 %d 次", firstDoneCount)
+	}
+
+	if err := os.Remove(brokenPath); err != nil {
+		t.Fatalf("删除测试文件失败: %v", err)
+	}
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("首次加载配置失败: %v", err)
+	}
+	if firstDoneCount := mockManager.getFirstDoneCount(); firstDoneCount != 1 {
+		t.Errorf("首次加载成功后 OnFirstAllConfigDone 应被调用 1 次，实际 %d 次", firstDoneCount)
+	}
+
+	// 热重载只触发 OnConfigLoadComplete
+	callCount := mockManager.getCallCount()
+	manager.batchReloadConfigs(configNames)
+	if mockManager.getCallCount() != callCount+1 {
+		t.Errorf("热重载应触发一次 OnConfigLoadComplete")
+	}
+	if firstDoneCount := mockManager.getFirstDoneCount(); firstDoneCount != 1 {
+		t.Errorf("热重载后 OnFirstAllConfigDone 仍应只被调用 1 次，实际 %d 次", firstDoneCount)
+	}
+}
+
 // TestBatchCallback_OnFirstAllConfigDone_MultipleManagers 测试多管理器首次加载完成回调
 func TestBatchCallback_OnFirstAllConfigDone_MultipleManagers(t *testing.T) {
 	tempDir := t.TempDir()
@@ -431,11 +474,53 @@ func TestBatchCallback_CallbackOrder(t *testing.T) {
 	if len(callOrder) != 3 {
 		t.Errorf("期望 3 个回调，实际 %d 个", len(callOrder))
 	}
+	if !reflect.DeepEqual(callOrder, []int{0, 1, 2}) {
+		t.Errorf("默认优先级应按注册顺序回调，实际 %v", callOrder)
+	}
 	orderMutex.Unlock()
 
 	t.Log("✓ 回调顺序测试通过")
 }
 
+// TestBatchCallback_CallbackPriority 测试按优先级（再按注册顺序）回调
+func TestBatchCallback_CallbackPriority(t *testing.T) {
+	tempDir := t.TempDir()
+	configNames := createTestConfigs(t, tempDir, 2)
+
+	manager := NewConfigManager233(tempDir)
+
+	var callOrder []string
+	register := func(name string, priority int, withPriority bool) {
+		m := &orderTrackingManager{onCallback: func() { callOrder = append(callOrder, name) }}
+		if withPriority {
+			manager.RegisterBusinessManagerWithPriority(m, priority)
+		} else {
+			manager.RegisterBusinessManager(m)
+		}
+	}
+	register("default1", 0, false)
+	register("low", -10, true)
+	register("high1", 10, true)
+	register("default2", 0, true)
+	register("high2", 10, true)
+	register("mid", 5, true)
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	expected := []string{"high1", "high2", "mid", "default1", "default2", "low"}
+	if !reflect.DeepEqual(callOrder, expected) {
+		t.Errorf("首次加载回调顺序应为 %v，实际 %v", expected, callOrder)
+	}
+
+	// 热重载使用同样的顺序
+	callOrder = nil
+	manager.batchReloadConfigs(configNames)
+	if !reflect.DeepEqual(callOrder, expected) {
+		t.Errorf("热重载回调顺序应为 %v，实际 %v", expected, callOrder)
+	}
+}
+
 // orderTrackingManager 用于追踪回调顺序
 type orderTrackingManager struct {
 	onCallback func()
@@ -542,12 +627,11 @@ func TestBatchCallback_PanicRecovery(t *testing.T) {
 
 	manager := NewConfigManager233(tempDir)
 
-	// 注册一个正常的管理器
+	// 先注册一个会 panic 的管理器，再注册一个正常的管理器
+	manager.RegisterBusinessManager(&panickingManager{})
 	normalManager := newMockBusinessManager()
 	manager.RegisterBusinessManager(normalManager)
 
-	// 注册一个会 panic 的管理器 - 当前实现不处理 panic
-	// 这里只验证正常管理器能正常工作
 	if err := manager.LoadAllConfigs(); err != nil {
 		t.Fatalf("加载配置失败: %v", err)
 	}
@@ -555,10 +639,30 @@ func TestBatchCallback_PanicRecovery(t *testing.T) {
 	if normalManager.getCallCount() != 1 {
 		t.Errorf("正常管理器应收到 1 次回调，实际 %d 次", normalManager.getCallCount())
 	}
+	if normalManager.getFirstDoneCount() != 1 {
+		t.Errorf("正常管理器应收到 1 次 OnFirstAllConfigDone，实际 %d 次", normalManager.getFirstDoneCount())
+	}
+
+	// 热重载时同样隔离 panic
+	manager.batchReloadConfigs([]string{"Config000"})
+	if normalManager.getCallCount() != 2 {
+		t.Errorf("热重载后正常管理器应收到 2 次回调，实际 %d 次", normalManager.getCallCount())
+	}
 
 	t.Log("✓ 回调安全性测试通过")
 }
 
+// panickingManager 回调中总是 panic 的业务管理器
+type panickingManager struct{}
+
+func (m *panickingManager) OnConfigLoadComplete(changedConfigNameList []string) {
+	panic("业务管理器处理配置失败")
+}
+
+func (m *panickingManager) OnFirstAllConfigDone() {
+	panic("业务管理器初始化失败")
+}
+
 // TestBatchCallback_SliceNotShared 测试切片不共享（避免数据污染）
 func TestBatchCallback_SliceNotShared(t *testing.T) {
 	tempDir := t.TempDir()
@@ -0,0 +1,85 @@
+package config233
+
+import "sync/atomic"
+
+// CacheStat Lock-Free 配置缓存的访问统计
+// 命中表示直接从缓存读到数据；未命中表示缓存中没有该配置（或该 ID），
+// GetConfigById 等接口会回退到加锁读取已提交的数据
+type CacheStat struct {
+	Hits          int64 // 缓存命中次数
+	Misses        int64 // 缓存未命中次数
+	Rebuilds      int64 // 缓存重建次数（每次加载、重载或 InvalidateCache 写入某配置的缓存计一次）
+	Invalidations int64 // 通过 InvalidateCache 主动失效的次数
+}
+
+// cacheCounters 缓存统计计数器，各字段独立原子递增，读取时不加锁
+type cacheCounters struct {
+	hits          atomic.Int64
+	misses        atomic.Int64
+	rebuilds      atomic.Int64
+	invalidations atomic.Int64
+}
+
+// recordLookup 记录一次缓存查找
+func (c *cacheCounters) recordLookup(hit bool) {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+}
+
+// reset 清零所有计数
+func (c *cacheCounters) reset() {
+	c.hits.Store(0)
+	c.misses.Store(0)
+	c.rebuilds.Store(0)
+	c.invalidations.Store(0)
+}
+
+// GetCacheStats 获取配置缓存的命中、未命中与重建次数
+// 各计数独立读取，并发访问时不保证彼此严格一致，仅用于排查与监控
+// 返回值:
+//
+//	CacheStat: 当前统计值
+func (cm *ConfigManager233) GetCacheStats() CacheStat {
+	return CacheStat{
+		Hits:          cm.cacheStats.hits.Load(),
+		Misses:        cm.cacheStats.misses.Load(),
+		Rebuilds:      cm.cacheStats.rebuilds.Load(),
+		Invalidations: cm.cacheStats.invalidations.Load(),
+	}
+}
+
+// ResetCacheStats 清零配置缓存的访问统计
+func (cm *ConfigManager233) ResetCacheStats() {
+	cm.cacheStats.reset()
+}
+
+// InvalidateCache 主动失效某配置的缓存
+// 丢弃该配置的二级索引，并按已提交的数据重建 ID 缓存与热更新持有器；
+// 配置未加载时清除缓存中的残留数据。用于排查"热重载后读到旧数据"等问题
+// 参数:
+//
+//	configName: 配置名称
+//
+// 返回值:
+//
+//	bool: 配置是否已加载（为 false 时缓存已被清除）
+func (cm *ConfigManager233) InvalidateCache(configName string) bool {
+	cm.cacheStats.invalidations.Add(1)
+
+	cm.mutex.RLock()
+	configMap, loaded := cm.configMaps[configName]
+	cm.mutex.RUnlock()
+
+	if !loaded {
+		cm.removeConfigCache(configName)
+		return false
+	}
+
+	// 切片与 ID 映射在 setConfigCache 中成对写入，沿用当前切片保持加载顺序
+	slice := getGlobalSliceCache(cm)[configName]
+	cm.setConfigCache(configName, configMap, slice)
+	return true
+}
@@ -0,0 +1,27 @@
+package config233
+
+// SetColumnNameNormalizer 设置 Excel/TSV/CSV 表头列名的规范化函数（链式调用）
+// 表头是中文或带空格（如 "物品 ID"）无法直接对应 Go 字段时，在匹配字段前先用 normalizer 转换列名，
+// 去空格、中文转拼音、下划线转驼峰等规则由调用方决定。转换后的列名同时用于 DataList 的 key、
+// ID 提取与结构体字段匹配（仍按不区分大小写与 config233_column 别名规则匹配）；返回空字符串的列被忽略。
+// 对之后的全量加载、热重载与远程加载生效，其他格式（JSON、YAML 等）的键名不受影响
+// 参数:
+//
+//	normalizer: 列名转换函数，参数为去掉首尾空白后的原始列名；为 nil 时取消转换
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetColumnNameNormalizer(normalizer func(raw string) string) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.columnNormalizer = normalizer
+	return cm
+}
+
+// columnNameNormalizer 获取当前的表头列名规范化函数，未设置时返回 nil
+func (cm *ConfigManager233) columnNameNormalizer() func(raw string) string {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	return cm.columnNormalizer
+}
@@ -3,15 +3,26 @@
 package config233
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
 )
 
+// ConversionError 单个单元格的类型转换错误，包含配置名、行号、列名、目标类型和原始值
+type ConversionError = dto.ConversionError
+
+// ConversionErrors 一次读取中收集到的所有类型转换错误
+type ConversionErrors = dto.ConversionErrors
+
 // Config233 统一配置入口类
 // 负责配置文件扫描、加载、监听和数据管理
 type Config233 struct {
@@ -24,6 +35,8 @@ type Config233 struct {
 	firstInitDone    bool                     // 是否已完成首次初始化
 	classToHotUpdate map[string]bool          // 需要热更新的类映射
 	configClasses    map[string]reflect.Type  // 配置名到类型的映射
+	duplicateName    DuplicateNamePolicy      // 同名配置文件的处理策略
+	watcher          *fsnotify.Watcher        // 文件监听器，未启动或已停止时为 nil
 	mu               sync.RWMutex             // 读写锁
 }
 
@@ -110,14 +123,19 @@ func (c *Config233) Start() *Config233 {
 	// 扫描配置类
 	configClasses := c.scanConfigClasses()
 
-	// 获取文件映射
-	fileMap := c.getFileNameToPathMap()
+	// 获取文件映射，同名文件冲突时只跳过冲突的配置
+	fileMap, err := c.getFileNameToPathMap()
+	if err != nil {
+		getLogger().Error(err, "存在同名配置文件，已跳过冲突的配置", "dir", c.configDirPath)
+	}
 
 	// 初始加载配置
 	c.loadConfigs(configClasses, fileMap)
 
-	// 启动文件监听
-	c.startFileWatcher(fileMap)
+	// 启动文件监听，失败时降级为不监听，已加载的配置照常可用
+	if err := c.startFileWatcher(fileMap); err != nil {
+		getLogger().Error(err, "启动文件监听失败，配置热更新不可用", "dir", c.configDirPath)
+	}
 
 	c.firstInitDone = true
 	return c
@@ -133,10 +151,10 @@ func (c *Config233) scanConfigClasses() map[string]reflect.Type {
 
 // getFileNameToPathMap 获取文件名到路径的映射
 // 递归扫描配置目录，建立配置文件名（去扩展名）到完整路径的映射
-// 只包含有对应处理器的文件类型
-// 返回文件名到路径的映射表
-func (c *Config233) getFileNameToPathMap() map[string]string {
-	fileMap := make(map[string]string)
+// 只包含有对应处理器的文件类型，同名文件按 SetDuplicateNamePolicy 设置的策略处理
+// 返回文件名到路径的映射表，以及同名文件冲突时的错误（此时冲突的配置不在映射表中）
+func (c *Config233) getFileNameToPathMap() (map[string]string, error) {
+	var paths []string
 
 	// 递归扫描目录
 	filepath.Walk(c.configDirPath, func(path string, info os.FileInfo, err error) error {
@@ -165,15 +183,17 @@ func (c *Config233) getFileNameToPathMap() map[string]string {
 			return nil
 		}
 
-		nameWithoutExt := filename[:len(filename)-len(ext)-1]
-		if existing, ok := fileMap[nameWithoutExt]; ok {
-			panic(fmt.Sprintf("重复的配置文件名: %s 和 %s", existing, path))
-		}
-		fileMap[nameWithoutExt] = path
+		paths = append(paths, path)
 		return nil
 	})
 
-	return fileMap
+	resolved, err := resolveDuplicateNames(paths, c.duplicateName)
+	fileMap := make(map[string]string, len(resolved))
+	for _, path := range resolved {
+		filename := filepath.Base(path)
+		fileMap[strings.TrimSuffix(filename, filepath.Ext(filename))] = path
+	}
+	return fileMap, err
 }
 
 // loadConfigs 加载所有配置
@@ -202,19 +222,43 @@ func (c *Config233) loadConfig(typ reflect.Type, name, path string) {
 		return
 	}
 
-	dataList := handler.ReadConfigAndORM(typ, name, path)
+	dataList, err := handler.ReadConfigAndORM(typ, name, path)
+	var convErrs ConversionErrors
+	if errors.As(err, &convErrs) {
+		// 单元格类型转换失败不影响整表加载，逐条记录后继续使用数据
+		logConversionErrors(convErrs)
+		err = nil
+	}
+	if err != nil {
+		// 读取失败时保留已加载的数据
+		getLogger().Error(err, "加载配置失败，已跳过", "configName", name, "path", path)
+		return
+	}
 	c.configRepository.Put(typ, dataList)
 }
 
+// logConversionErrors 逐条记录单元格类型转换错误
+func logConversionErrors(convErrs ConversionErrors) {
+	for _, convErr := range convErrs {
+		getLogger().Error(convErr, "字段类型转换失败", "configName", convErr.ConfigName,
+			"row", convErr.RowIndex, "column", convErr.ColumnName, "targetType", convErr.TargetType, "value", convErr.RawValue)
+	}
+}
+
 // startFileWatcher 启动文件监听器
 // 使用fsnotify监听配置文件的变化，实现热更新功能
 // fileMap: 要监听的文件映射
-func (c *Config233) startFileWatcher(fileMap map[string]string) {
+// 创建监听器失败时返回错误，不会启动监听；单个文件添加失败只记录日志
+func (c *Config233) startFileWatcher(fileMap map[string]string) error {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		getLogger().Error(err, "创建文件监听器失败")
+		return fmt.Errorf("create file watcher failed: %w", err)
 	}
 
+	c.mu.Lock()
+	c.watcher = watcher
+	c.mu.Unlock()
+
 	go func() {
 		for {
 			select {
@@ -236,15 +280,33 @@ func (c *Config233) startFileWatcher(fileMap map[string]string) {
 
 	// 添加所有配置文件到监听器
 	for _, path := range fileMap {
-		err = watcher.Add(path)
-		if err != nil {
+		if err := watcher.Add(path); err != nil {
 			getLogger().Error(err, "添加文件到监听器失败", "path", path)
 		}
 	}
+	return nil
+}
+
+// Stop 停止文件监听
+// 停止后配置数据仍可读取，但不再响应文件变化；重复调用是安全的
+// 返回值:
+//
+//	error: 关闭监听器失败时返回错误
+func (c *Config233) Stop() error {
+	c.mu.Lock()
+	watcher := c.watcher
+	c.watcher = nil
+	c.mu.Unlock()
+
+	if watcher == nil {
+		return nil
+	}
+	return watcher.Close()
 }
 
 // handleFileChange 处理文件变化事件
 // 当配置文件被修改时，重新加载对应的配置数据
+// 写入仓库后会触发该配置类型的变更监听（注入字段更新、热更新方法等）
 // path: 发生变化的文件路径
 // fileMap: 文件路径映射表
 func (c *Config233) handleFileChange(path string, fileMap map[string]string) {
@@ -316,7 +378,8 @@ func (c *Config233) RegisterForHotUpdate(obj interface{}) {
 // injectFields 注入字段
 // 扫描对象的字段，查找带有 "config233":"inject" 标签的字段
 // 并将对应的配置数据注入到这些字段中
-// 当前支持 map[int]*T 类型的字段注入
+// map 按字段声明的类型构造：key 按声明的类型转换，支持 map[int]、map[int64]、map[string] 等；
+// value 可以声明为 *T 或 T
 // 参数:
 //
 //	obj: 需要注入字段的对象指针
@@ -330,43 +393,269 @@ func (c *Config233) injectFields(obj interface{}) {
 
 		if tag := fieldType.Tag.Get("config233"); tag == "inject" {
 			// 假设是 map 类型
-			if fieldType.Type.Kind() == reflect.Map {
-				// 获取配置类型
-				elemType := fieldType.Type.Elem()
-				uidMap := c.configRepository.GetUIDMap(elemType)
-
-				// 创建正确类型的 map
-				mapType := reflect.MapOf(reflect.TypeOf(0), elemType) // int -> *Student
-				concreteMap := reflect.MakeMap(mapType)
-
-				for k, v := range uidMap {
-					if intKey, ok := k.(int); ok {
-						concreteMap.SetMapIndex(reflect.ValueOf(intKey), reflect.ValueOf(v))
-					}
-				}
+			if configType, ok := injectConfigType(fieldType); ok {
+				uidMap := c.configRepository.GetUIDMap(configType)
+				field.Set(buildInjectMap(fieldType.Name, fieldType.Type, uidMap))
+
+				// 配置热更新后重新注入
+				c.configRepository.AddChangeListener(configType, &FieldUpdateListener{
+					obj:       obj,
+					field:     field,
+					fieldName: fieldType.Name,
+					fieldType: configType,
+				})
+			}
+		}
+	}
+}
+
+// injectConfigType 获取 inject 字段对应的配置类型
+// 字段需为 map 类型，value 声明为 *T 时按 T 查找配置
+func injectConfigType(field reflect.StructField) (reflect.Type, bool) {
+	if field.Tag.Get("config233") != "inject" || field.Type.Kind() != reflect.Map {
+		return nil, false
+	}
+	configType := field.Type.Elem()
+	if configType.Kind() == reflect.Ptr {
+		configType = configType.Elem()
+	}
+	return configType, true
+}
+
+// buildInjectMap 按字段声明的 map 类型构造注入用的 map
+// 参数:
+//
+//	fieldName: 字段名，用于日志
+//	mapType: 字段声明的 map 类型
+//	uidMap: UID 到配置对象的映射
+//
+// 返回值:
+//
+//	reflect.Value: mapType 类型的 map，无法转换的 key 或 value 会记录日志并跳过
+func buildInjectMap(fieldName string, mapType reflect.Type, uidMap map[interface{}]interface{}) reflect.Value {
+	keyType := mapType.Key()
+	elemType := mapType.Elem()
+	concreteMap := reflect.MakeMapWithSize(mapType, len(uidMap))
+
+	for k, v := range uidMap {
+		key, err := convertMapKey(k, keyType)
+		if err != nil {
+			getLogger().Error(err, "配置 uid 无法转换为注入字段的 key 类型，已跳过",
+				"field", fieldName, "uid", k, "keyType", keyType.String())
+			continue
+		}
+		value, ok := convertInjectValue(v, elemType)
+		if !ok {
+			getLogger().Error(nil, "配置对象类型与注入字段的 value 类型不匹配，已跳过",
+				"field", fieldName, "uid", k, "valueType", fmt.Sprintf("%T", v), "elemType", elemType.String())
+			continue
+		}
+		concreteMap.SetMapIndex(key, value)
+	}
+	return concreteMap
+}
+
+// convertInjectValue 将仓库中的配置对象转换为 map 声明的 value 类型
+// 声明为 *T 时为每个配置创建指针，声明为 T 时直接使用值
+// 参数:
+//
+//	item: 仓库中的配置对象
+//	elemType: map 声明的 value 类型
+//
+// 返回值:
+//
+//	reflect.Value: 转换后的 value
+//	bool: 类型不匹配时返回 false
+func convertInjectValue(item interface{}, elemType reflect.Type) (reflect.Value, bool) {
+	val := reflect.ValueOf(item)
+	if !val.IsValid() {
+		return reflect.Value{}, false
+	}
 
-				field.Set(concreteMap)
+	switch {
+	case val.Type() == elemType:
+		return val, true
+	case elemType.Kind() == reflect.Ptr && val.Type() == elemType.Elem():
+		ptr := reflect.New(val.Type())
+		ptr.Elem().Set(val)
+		return ptr, true
+	case val.Kind() == reflect.Ptr && val.Type().Elem() == elemType:
+		if val.IsNil() {
+			return reflect.Value{}, false
+		}
+		return val.Elem(), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// convertMapKey 将配置的 uid 值转换为 map 声明的 key 类型
+// 支持整数之间（含溢出检查）、整数与字符串之间的转换
+// 参数:
+//
+//	key: uid 字段的值
+//	keyType: map 声明的 key 类型
+//
+// 返回值:
+//
+//	reflect.Value: 转换后的 key
+//	error: 无法转换时返回错误
+func convertMapKey(key interface{}, keyType reflect.Type) (reflect.Value, error) {
+	keyVal := reflect.ValueOf(key)
+	if !keyVal.IsValid() {
+		return reflect.Value{}, fmt.Errorf("uid 为 nil")
+	}
+	if keyVal.Type() == keyType {
+		return keyVal, nil
+	}
+
+	switch keyType.Kind() {
+	case reflect.String:
+		switch keyVal.Kind() {
+		case reflect.String:
+			return keyVal.Convert(keyType), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return reflect.ValueOf(strconv.FormatInt(keyVal.Int(), 10)).Convert(keyType), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return reflect.ValueOf(strconv.FormatUint(keyVal.Uint(), 10)).Convert(keyType), nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch keyVal.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n = keyVal.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if keyVal.Uint() > math.MaxInt64 {
+				return reflect.Value{}, fmt.Errorf("%v 超出 %s 范围", key, keyType)
+			}
+			n = int64(keyVal.Uint())
+		case reflect.String:
+			parsed, err := strconv.ParseInt(strings.TrimSpace(keyVal.String()), 10, 64)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			n = parsed
+		default:
+			return reflect.Value{}, fmt.Errorf("不支持从 %s 转换为 %s", keyVal.Type(), keyType)
+		}
+		result := reflect.New(keyType).Elem()
+		if result.OverflowInt(n) {
+			return reflect.Value{}, fmt.Errorf("%v 超出 %s 范围", key, keyType)
+		}
+		result.SetInt(n)
+		return result, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		switch keyVal.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if keyVal.Int() < 0 {
+				return reflect.Value{}, fmt.Errorf("%v 超出 %s 范围", key, keyType)
 			}
+			n = uint64(keyVal.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n = keyVal.Uint()
+		case reflect.String:
+			parsed, err := strconv.ParseUint(strings.TrimSpace(keyVal.String()), 10, 64)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			n = parsed
+		default:
+			return reflect.Value{}, fmt.Errorf("不支持从 %s 转换为 %s", keyVal.Type(), keyType)
+		}
+		result := reflect.New(keyType).Elem()
+		if result.OverflowUint(n) {
+			return reflect.Value{}, fmt.Errorf("%v 超出 %s 范围", key, keyType)
+		}
+		result.SetUint(n)
+		return result, nil
+	case reflect.Interface:
+		if keyVal.Type().Implements(keyType) {
+			result := reflect.New(keyType).Elem()
+			result.Set(keyVal)
+			return result, nil
 		}
 	}
+
+	if keyVal.Type().ConvertibleTo(keyType) && keyVal.Kind() == keyType.Kind() {
+		return keyVal.Convert(keyType), nil
+	}
+	return reflect.Value{}, fmt.Errorf("不支持从 %s 转换为 %s", keyVal.Type(), keyType)
 }
 
 // registerMethods 注册方法监听
-// 扫描对象的方法，查找需要监听配置变更的方法
-// 当配置发生变化时，会调用这些方法
-// 注意: Go 中方法没有标签，这里暂时跳过实现，
-// 后续可以通过其他方式（如方法名约定）来识别监听方法
+// Go 中方法没有标签，通过方法名约定识别热更新方法（方法不能有参数，返回值会被忽略）：
+//   - OnHotUpdate(): 对象任一 inject 字段对应的配置热更新后调用（此时字段已重新注入）
+//   - On<配置名>HotUpdate(): 对应配置热更新后调用，配置名为 RegisterConfigClass 注册的名称或类型名，
+//     例如 OnStudentHotUpdate
+//
 // 参数:
 //
 //	obj: 需要注册方法监听的对象指针
 func (c *Config233) registerMethods(obj interface{}) {
-	typ := reflect.TypeOf(obj)
-	// val := reflect.ValueOf(obj)
+	val := reflect.ValueOf(obj)
+	typ := val.Type()
+	if typ.Kind() != reflect.Ptr || typ.Elem().Kind() != reflect.Struct {
+		return
+	}
 
-	for i := 0; i < typ.NumMethod(); i++ {
-		method := typ.Method(i)
-		// Go 中方法没有 tag，这里需要其他方式
-		// 暂时跳过
-		_ = method
+	// OnHotUpdate：监听所有 inject 字段对应的配置类型
+	if method := val.MethodByName("OnHotUpdate"); method.IsValid() && method.Type().NumIn() == 0 {
+		seen := make(map[reflect.Type]bool)
+		structType := typ.Elem()
+		for i := 0; i < structType.NumField(); i++ {
+			configType, ok := injectConfigType(structType.Field(i))
+			if !ok || seen[configType] {
+				continue
+			}
+			seen[configType] = true
+			c.addMethodListener(configType, typ.String()+".OnHotUpdate", method)
+		}
 	}
+
+	// On<配置名>HotUpdate：监听指定的配置类型
+	seen := make(map[reflect.Type]bool)
+	for name, configType := range c.configClasses {
+		for _, candidate := range []string{name, configType.Name()} {
+			method := val.MethodByName("On" + candidate + "HotUpdate")
+			if !method.IsValid() || method.Type().NumIn() != 0 || seen[configType] {
+				continue
+			}
+			seen[configType] = true
+			c.addMethodListener(configType, typ.String()+".On"+candidate+"HotUpdate", method)
+		}
+	}
+}
+
+// addMethodListener 为配置类型注册热更新方法回调
+func (c *Config233) addMethodListener(configType reflect.Type, name string, method reflect.Value) {
+	c.configRepository.AddChangeListener(configType, &MethodUpdateListener{
+		name:      name,
+		fieldType: configType,
+		callback: func() {
+			method.Call(nil)
+		},
+	})
+}
+
+// RegisterHotUpdateFunc 注册配置热更新回调
+// 指定类型的配置重新加载后调用 fn，适合不方便使用方法名约定的场景
+// 参数:
+//
+//	typ: 配置类的反射类型（与 RegisterConfigClass 注册的类型一致）
+//	fn: 热更新后调用的回调
+//
+// 返回值:
+//
+//	*Config233: 返回自身，支持链式调用
+func (c *Config233) RegisterHotUpdateFunc(typ reflect.Type, fn func()) *Config233 {
+	if fn == nil {
+		return c
+	}
+	c.configRepository.AddChangeListener(typ, &MethodUpdateListener{
+		name:      "func(" + typ.String() + ")",
+		fieldType: typ,
+		callback:  fn,
+	})
+	return c
 }
@@ -0,0 +1,99 @@
+package config233
+
+import "reflect"
+
+// GetConfigByIdCopy 根据 ID 获取配置的副本（纯泛型）
+// GetConfigById 返回的 *T 是所有调用方共享的缓存对象，业务修改字段会污染内存中的配置，还会与热更新竞争；
+// 这里返回值类型的深拷贝：切片、map、指针指向的数据都复制一份，修改副本不影响下一次查询。
+// config233_ref 引用字段仍指向被引用配置的共享对象，未导出字段按值复制（其中的切片、map 与原对象共享）。
+// 每次调用都会遍历并分配整条配置，不适合在热路径上频繁调用，只读场景请直接使用 GetConfigById
+// 参数:
+//
+//	id: 配置 ID，规范化规则同 GetConfigById
+//
+// 返回值:
+//
+//	T: 配置的副本，未找到时为零值
+//	bool: 是否找到
+func GetConfigByIdCopy[T any](id interface{}) (T, bool) {
+	return GetConfigByIdCopyFrom[T](GetInstance(), id)
+}
+
+// GetConfigByIdCopyFrom 与 GetConfigByIdCopy 相同，但从指定的管理器 cm 读取
+func GetConfigByIdCopyFrom[T any](cm *ConfigManager233, id interface{}) (T, bool) {
+	var zero T
+	item, ok := GetConfigByIdFrom[T](cm, id)
+	if !ok || item == nil {
+		return zero, false
+	}
+	copied := reflect.New(reflect.TypeOf(item).Elem()).Elem()
+	copied.Set(cloneValue(reflect.ValueOf(item).Elem(), make(map[uintptr]reflect.Value)))
+	return copied.Interface().(T), true
+}
+
+// cloneValue 深拷贝 v，seen 记录已复制的指针，同一对象被多处引用时副本中仍指向同一个新对象
+func cloneValue(v reflect.Value, seen map[uintptr]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		if copied, ok := seen[v.Pointer()]; ok && copied.Type() == v.Type() {
+			return copied
+		}
+		copied := reflect.New(v.Type().Elem())
+		seen[v.Pointer()] = copied
+		copied.Elem().Set(cloneValue(v.Elem(), seen))
+		return copied
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(cloneValue(v.Elem(), seen))
+		return copied
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(cloneValue(v.Index(i), seen))
+		}
+		return copied
+
+	case reflect.Array:
+		copied := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(cloneValue(v.Index(i), seen))
+		}
+		return copied
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), cloneValue(iter.Value(), seen))
+		}
+		return copied
+
+	case reflect.Struct:
+		// 先整体按值复制（包括无法通过反射设置的未导出字段），再逐个替换导出字段
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || isRefField(field) {
+				continue
+			}
+			copied.Field(i).Set(cloneValue(v.Field(i), seen))
+		}
+		return copied
+	}
+	return v
+}
@@ -0,0 +1,223 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ConfigDiff 两版配置列表之间的差异，由 DiffConfig 生成
+type ConfigDiff[T any] struct {
+	Added    []*T                  // 新版新增的配置（按新列表顺序）
+	Removed  []*T                  // 新版删除的配置（按旧列表顺序）
+	Modified []ConfigItemChange[T] // ID 相同但内容有变化的配置（按新列表顺序）
+}
+
+// ConfigItemChange 一条配置在两版之间的变化
+type ConfigItemChange[T any] struct {
+	Id     string      // 配置 ID
+	Old    *T          // 旧版配置
+	New    *T          // 新版配置
+	Fields []FieldDiff // 有变化的字段（按字段声明顺序）
+}
+
+// FieldDiff 单个字段的新旧值
+type FieldDiff struct {
+	Field string      // 字段路径，嵌套结构体用 "." 连接，如 "Reward.Gold"
+	Old   interface{} // 旧值
+	New   interface{} // 新值
+}
+
+// IsEmpty 两版配置是否完全一致
+func (d ConfigDiff[T]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// String 输出可读的变更日志，每行一条（"+" 新增、"-" 删除、"~" 修改），新增与删除的配置按 %+v 输出，例如:
+//
+//	fmt.Print(diff) // 输出:
+//	+ {Id:3 Name:Bow}
+//	- {Id:2 Name:Axe}
+//	~ 1 Name: "Sword" -> "Blade"; Tags: []string{"a"} -> []string{"a", "b"}
+func (d ConfigDiff[T]) String() string {
+	return d.Format(nil)
+}
+
+// Format 与 String 相同，但新增与删除的配置用 idFunc 输出 ID
+// 参数:
+//
+//	idFunc: 取配置 ID 的函数，为 nil 时新增与删除的配置按 %+v 输出
+//
+// 返回值:
+//
+//	string: 变更日志，没有差异时返回空字符串
+func (d ConfigDiff[T]) Format(idFunc func(*T) string) string {
+	describe := func(item *T) string {
+		if idFunc != nil {
+			return idFunc(item)
+		}
+		return fmt.Sprintf("%+v", *item)
+	}
+
+	var b strings.Builder
+	for _, item := range d.Added {
+		fmt.Fprintf(&b, "+ %s\n", describe(item))
+	}
+	for _, item := range d.Removed {
+		fmt.Fprintf(&b, "- %s\n", describe(item))
+	}
+	for _, change := range d.Modified {
+		fields := make([]string, len(change.Fields))
+		for i, f := range change.Fields {
+			fields[i] = fmt.Sprintf("%s: %#v -> %#v", f.Field, f.Old, f.New)
+		}
+		fmt.Fprintf(&b, "~ %s %s\n", change.Id, strings.Join(fields, "; "))
+	}
+	return b.String()
+}
+
+// DiffConfig 对比两版配置列表，返回新增、删除与修改（带字段级差异）的配置
+// 配合 GetSnapshotConfigList 可在热更新后输出与上一版的变更日志:
+//
+//	oldList, _ := config233.GetSnapshotConfigList[ItemConfig](lastSnapshot)
+//	diff := config233.DiffConfig(oldList, config233.GetConfigList[ItemConfig](), itemId)
+//	log.Print(diff.Format(itemId))
+//
+// 字段按反射逐个比较：只比较导出字段（配置结构体上的未导出字段被忽略），嵌入与嵌套的结构体（及其指针）展开为 "A.B" 路径逐字段比较，
+// 切片、map 与含未导出字段的结构体（如 time.Time）整体用 reflect.DeepEqual 比较，nil 与空切片/map 视为相同；
+// config233_ref 引用字段不参与比较，被引用配置的变化由该配置自身的 diff 体现
+// 参数:
+//
+//	oldList: 旧版配置列表
+//	newList: 新版配置列表
+//	idFunc: 取配置 ID 的函数，返回空字符串或配置为 nil 时该条不参与对比；同一列表内 ID 重复时以最后一条为准
+//
+// 返回值:
+//
+//	ConfigDiff[T]: 两版之间的差异
+func DiffConfig[T any](oldList, newList []*T, idFunc func(*T) string) ConfigDiff[T] {
+	var diff ConfigDiff[T]
+	oldById := indexConfigList(oldList, idFunc)
+	newById := indexConfigList(newList, idFunc)
+
+	for _, item := range uniqueConfigItems(newList, newById, idFunc) {
+		id := idFunc(item)
+		oldItem, existed := oldById[id]
+		if !existed {
+			diff.Added = append(diff.Added, item)
+			continue
+		}
+		if fields := diffFields(reflect.ValueOf(oldItem).Elem(), reflect.ValueOf(item).Elem()); len(fields) > 0 {
+			diff.Modified = append(diff.Modified, ConfigItemChange[T]{Id: id, Old: oldItem, New: item, Fields: fields})
+		}
+	}
+	for _, item := range uniqueConfigItems(oldList, oldById, idFunc) {
+		if _, exists := newById[idFunc(item)]; !exists {
+			diff.Removed = append(diff.Removed, item)
+		}
+	}
+	return diff
+}
+
+// indexConfigList 按 ID 建立索引，跳过 nil 与空 ID
+func indexConfigList[T any](list []*T, idFunc func(*T) string) map[string]*T {
+	byId := make(map[string]*T, len(list))
+	for _, item := range list {
+		if item == nil {
+			continue
+		}
+		if id := idFunc(item); id != "" {
+			byId[id] = item
+		}
+	}
+	return byId
+}
+
+// uniqueConfigItems 按列表顺序返回索引中保留的配置（ID 重复时只返回最后一条）
+func uniqueConfigItems[T any](list []*T, byId map[string]*T, idFunc func(*T) string) []*T {
+	items := make([]*T, 0, len(byId))
+	for _, item := range list {
+		if item != nil && byId[idFunc(item)] == item {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// diffFields 逐字段比较两条配置，返回有变化的字段
+// 配置结构体本身总是逐字段展开，其中的未导出字段（如 AfterLoad 中计算的缓存）被忽略
+func diffFields(oldValue, newValue reflect.Value) []FieldDiff {
+	var fields []FieldDiff
+	if oldValue.Kind() == reflect.Struct {
+		appendStructFieldDiffs(&fields, "", oldValue, newValue)
+	} else {
+		appendFieldDiffs(&fields, "", oldValue, newValue)
+	}
+	return fields
+}
+
+// appendFieldDiffs 比较 path 处的两个值，结构体递归展开，其余类型整体比较
+func appendFieldDiffs(out *[]FieldDiff, path string, oldValue, newValue reflect.Value) {
+	switch {
+	case oldValue.Kind() == reflect.Struct && isDiffableStruct(oldValue.Type()):
+		appendStructFieldDiffs(out, path, oldValue, newValue)
+		return
+
+	case oldValue.Kind() == reflect.Ptr && oldValue.Type().Elem().Kind() == reflect.Struct &&
+		isDiffableStruct(oldValue.Type().Elem()) && !oldValue.IsNil() && !newValue.IsNil():
+		appendStructFieldDiffs(out, path, oldValue.Elem(), newValue.Elem())
+		return
+	}
+
+	if !fieldValueEqual(oldValue, newValue) {
+		*out = append(*out, FieldDiff{Field: path, Old: oldValue.Interface(), New: newValue.Interface()})
+	}
+}
+
+// appendStructFieldDiffs 逐个比较结构体的导出字段，跳过 config233_ref 引用字段
+func appendStructFieldDiffs(out *[]FieldDiff, path string, oldValue, newValue reflect.Value) {
+	for i := 0; i < oldValue.NumField(); i++ {
+		field := oldValue.Type().Field(i)
+		if !field.IsExported() || isRefField(field) {
+			continue
+		}
+		childPath := field.Name
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			childPath = "" // 嵌入结构体的字段提升到外层
+		}
+		appendFieldDiffs(out, joinFieldPath(path, childPath), oldValue.Field(i), newValue.Field(i))
+	}
+}
+
+// isDiffableStruct 结构体是否逐字段展开比较：含未导出字段的结构体（如 time.Time）整体比较
+func isDiffableStruct(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldValueEqual 比较两个字段值，nil 与空切片/map 视为相同
+func fieldValueEqual(oldValue, newValue reflect.Value) bool {
+	switch oldValue.Kind() {
+	case reflect.Slice, reflect.Map:
+		if oldValue.Len() == 0 && newValue.Len() == 0 {
+			return true
+		}
+	}
+	return reflect.DeepEqual(oldValue.Interface(), newValue.Interface())
+}
+
+// joinFieldPath 拼接字段路径
+func joinFieldPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	default:
+		return parent + "." + child
+	}
+}
@@ -0,0 +1,142 @@
+package config233
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// normalizeConfigGroup 规范化分组路径：统一为 "/" 分隔、去掉首尾的 "/"，"." 视为空分组（整个配置目录）
+// 绝对路径与跳出配置目录的路径返回错误
+func normalizeConfigGroup(group string) (string, error) {
+	if filepath.IsAbs(group) {
+		return "", fmt.Errorf("配置分组 %s 必须是相对于配置目录的路径", group)
+	}
+	cleaned := filepath.ToSlash(filepath.Clean(group))
+	if cleaned == "." {
+		return "", nil
+	}
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("配置分组 %s 不能跳出配置目录", group)
+	}
+	return strings.Trim(cleaned, "/"), nil
+}
+
+// configFileGroup 计算配置文件所属的分组：文件所在目录相对于配置目录的路径，位于配置目录根下时为空字符串
+// 文件不在 dir 下（如远程配置的 URL）时返回 false
+func configFileGroup(dir, path string) (string, bool) {
+	rel, err := filepath.Rel(dir, filepath.Dir(path))
+	if err != nil {
+		return "", false
+	}
+	group, err := normalizeConfigGroup(rel)
+	if err != nil {
+		return "", false
+	}
+	return group, true
+}
+
+// inConfigGroup 判断 group 是否属于 parent 分组（相同或为其子目录），parent 为空时匹配所有分组
+func inConfigGroup(group, parent string) bool {
+	return parent == "" || group == parent || strings.HasPrefix(group, parent+"/")
+}
+
+// GetLoadedConfigNamesByGroup 获取某分组下已加载的配置名
+// 分组为配置文件所在目录相对于配置目录（GetConfigDirs 中的任一目录）的路径，如 "combat"、"combat/skill"；
+// 查询包含子目录中的配置，即 "combat" 同时返回 combat/skill 下的配置，空字符串返回所有从目录加载的配置。
+// 远程加载的配置不属于任何分组
+// 参数:
+//
+//	group: 分组路径，使用 "/" 或系统路径分隔符均可
+//
+// 返回值:
+//
+//	[]string: 已加载的配置名（已排序），分组路径非法或没有配置时返回空切片
+func (cm *ConfigManager233) GetLoadedConfigNamesByGroup(group string) []string {
+	names := make([]string, 0)
+	group, err := normalizeConfigGroup(group)
+	if err != nil {
+		return names
+	}
+
+	dirs := cm.GetConfigDirs()
+	stats := cm.GetLoadStats()
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	for configName, stat := range stats {
+		if _, loaded := cm.configs[configName]; !loaded {
+			continue
+		}
+		for _, dir := range dirs {
+			if fileGroup, ok := configFileGroup(dir, stat.FileName); ok {
+				if inConfigGroup(fileGroup, group) {
+					names = append(names, configName)
+				}
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadConfigGroup 只加载（或重载）某分组下的配置文件
+// 分组规则与 GetLoadedConfigNamesByGroup 一致，包含子目录中的配置。文件的收集沿用全量加载的规则
+// （跳过隐藏目录与临时文件，同名文件与多目录冲突按 SetDuplicateNamePolicy / SetConfigDirConflictPolicy 处理），
+// 因此被其他分组覆盖的同名配置不会被重复加载；之前从该分组加载、文件已不存在的配置会被移除。
+// 加载与热重载共用同一把锁依次执行，完成后只对该分组的配置触发 OnConfigLoadComplete、保存快照并发布重载事件
+// 参数:
+//
+//	groupDir: 相对于配置目录的分组路径，如 "combat"
+//
+// 返回值:
+//
+//	error: 分组路径非法、分组下没有配置文件、遍历目录失败或部分配置加载失败时返回错误
+func (cm *ConfigManager233) LoadConfigGroup(groupDir string) error {
+	group, err := normalizeConfigGroup(groupDir)
+	if err != nil {
+		return err
+	}
+
+	allFiles, duplicates, err := cm.collectConfigFiles(context.Background())
+	if err == nil && len(duplicates) > 0 {
+		err = duplicates
+	}
+	if err != nil {
+		return fmt.Errorf("收集配置分组 %s 的文件失败: %w", group, err)
+	}
+
+	dirs := cm.GetConfigDirs()
+	resolved := make(map[string]bool, len(allFiles)) // 配置目录中存在文件的配置名
+	configFiles := make(map[string]string)
+	for _, f := range allFiles {
+		configName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
+		resolved[configName] = true
+		if fileGroup, ok := configFileGroup(dirs[f.dir], f.path); ok && inConfigGroup(fileGroup, group) {
+			configFiles[configName] = f.path
+		}
+	}
+
+	// 之前从该分组加载、现在任何目录中都找不到文件的配置需要移除；移到其他分组的配置保持不变
+	configNames := make([]string, 0, len(configFiles))
+	for configName := range configFiles {
+		configNames = append(configNames, configName)
+	}
+	for _, configName := range cm.GetLoadedConfigNamesByGroup(group) {
+		if !resolved[configName] {
+			configNames = append(configNames, configName)
+		}
+	}
+	if len(configNames) == 0 {
+		return fmt.Errorf("配置分组 %s 下没有配置文件", group)
+	}
+	sort.Strings(configNames)
+
+	if failed := cm.reloadConfigFiles(configNames, configFiles, manualReload); len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("加载配置分组 %s 失败: %s", group, strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
+)
+
+// indexCacheKey 二级索引缓存的键
+// configName 用于热重载时按配置失效，key 区分同一配置上的不同索引
+type indexCacheKey struct {
+	configName string
+	key        string
+}
+
+// indexCacheEntry 二级索引缓存项
+// 记录构建索引时所基于的缓存切片，切片被替换（热重载）后索引自动视为过期
+type indexCacheEntry struct {
+	source *interface{} // 构建索引时缓存切片的首元素地址
+	length int          // 构建索引时缓存切片的长度
+	value  interface{}  // 索引数据，实际类型为 map[K][]*T
+}
+
+// matches 判断索引是否仍基于当前的缓存切片
+func (e *indexCacheEntry) matches(slice []interface{}) bool {
+	return len(slice) > 0 && e.length == len(slice) && e.source == &slice[0]
+}
+
+// invalidateConfigIndex 清除指定配置的所有二级索引缓存
+// 参数:
+//
+//	configName: 配置名称
+func (cm *ConfigManager233) invalidateConfigIndex(configName string) {
+	cm.indexCache.Range(func(k, _ interface{}) bool {
+		if k.(indexCacheKey).configName == configName {
+			cm.indexCache.Delete(k)
+		}
+		return true
+	})
+}
+
+// clearConfigIndex 清除所有二级索引缓存
+func (cm *ConfigManager233) clearConfigIndex() {
+	cm.indexCache.Range(func(k, _ interface{}) bool {
+		cm.indexCache.Delete(k)
+		return true
+	})
+}
+
+// getOrBuildIndex 获取或构建某类型配置的二级索引
+// 索引基于 Lock-Free 缓存中的切片构建，热重载替换切片后会自动重建
+func getOrBuildIndex[T any, K comparable](cm *ConfigManager233, key string, build func([]*T) map[K][]*T) map[K][]*T {
+	configName := configNameOf[T](cm)
+
+	slices := getGlobalSliceCache(cm)
+	slice, exists := slices[configName]
+	if !exists || len(slice) == 0 {
+		return make(map[K][]*T)
+	}
+
+	cacheKey := indexCacheKey{configName: configName, key: key}
+	if loaded, ok := cm.indexCache.Load(cacheKey); ok {
+		entry := loaded.(*indexCacheEntry)
+		if entry.matches(slice) {
+			if index, ok := entry.value.(map[K][]*T); ok {
+				return index
+			}
+		}
+	}
+
+	list, err := convertSliceToStructSlice[T](slice)
+	if err != nil {
+		return make(map[K][]*T)
+	}
+
+	index := build(list)
+	cm.indexCache.Store(cacheKey, &indexCacheEntry{
+		source: &slice[0],
+		length: len(slice),
+		value:  index,
+	})
+	return index
+}
+
+// GetConfigGroupBy 按自定义 key 对某类型的配置分组（纯泛型）
+// 首次调用时基于已加载的配置构建分组并缓存，热重载后自动失效重建
+// 缓存按 keyFunc 的函数地址区分，请传入不依赖外部可变变量的函数
+// 返回的分组为共享缓存，调用方不应修改
+// 参数:
+//
+//	keyFunc: 从配置中提取分组 key 的函数
+//
+// 返回值:
+//
+//	map[K][]*T: 分组 key -> 配置列表，没有该类型配置时返回空 map
+func GetConfigGroupBy[T any, K comparable](keyFunc func(*T) K) map[K][]*T {
+	return GetConfigGroupByFrom[T, K](GetInstance(), keyFunc)
+}
+
+// GetConfigGroupByFrom 与 GetConfigGroupBy 相同，但从指定的管理器 cm 读取
+func GetConfigGroupByFrom[T any, K comparable](cm *ConfigManager233, keyFunc func(*T) K) map[K][]*T {
+	if keyFunc == nil {
+		return make(map[K][]*T)
+	}
+
+	var zeroKey K
+	key := fmt.Sprintf("group:%T:%x", zeroKey, reflect.ValueOf(keyFunc).Pointer())
+
+	return getOrBuildIndex[T, K](cm, key, func(list []*T) map[K][]*T {
+		index := make(map[K][]*T)
+		for _, item := range list {
+			k := keyFunc(item)
+			index[k] = append(index[k], item)
+		}
+		return index
+	})
+}
+
+// GetConfigByIndex 按指定字段的值查询某类型的配置（纯泛型）
+// 首次按某字段查询时通过反射读取字段值建立索引并缓存，热重载后自动失效重建
+// 返回的列表为共享缓存，调用方不应修改
+// 参数:
+//
+//	field: 结构体字段名，也支持 config233_column 或 json tag 中的名称
+//	key: 要匹配的字段值，类型需要与字段类型一致或可转换
+//
+// 返回值:
+//
+//	[]*T: 字段值等于 key 的配置列表，没有匹配时返回 nil
+func GetConfigByIndex[T any, K comparable](field string, key K) []*T {
+	return GetConfigByIndexFrom[T, K](GetInstance(), field, key)
+}
+
+// GetConfigByIndexFrom 与 GetConfigByIndex 相同，但从指定的管理器 cm 读取
+func GetConfigByIndexFrom[T any, K comparable](cm *ConfigManager233, field string, key K) []*T {
+	var zero T
+	typ := reflect.TypeOf(zero)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	structField, ok := findIndexField(typ, field)
+	if !ok || structField.PkgPath != "" {
+		getLogger().Error(nil, "索引字段不存在", "configName", typ.Name(), "field", field)
+		return nil
+	}
+
+	keyType := reflect.TypeOf(key)
+	if keyType == nil || !structField.Type.ConvertibleTo(keyType) {
+		getLogger().Error(nil, "索引字段类型与查询值类型不匹配", "configName", typ.Name(), "field", field,
+			"fieldType", structField.Type.String(), "keyType", fmt.Sprintf("%T", key))
+		return nil
+	}
+
+	indexKey := fmt.Sprintf("field:%s:%s", structField.Name, keyType.String())
+	index := getOrBuildIndex[T, K](cm, indexKey, func(list []*T) map[K][]*T {
+		index := make(map[K][]*T)
+		for _, item := range list {
+			value := reflect.ValueOf(item).Elem().FieldByIndex(structField.Index)
+			k, ok := value.Convert(keyType).Interface().(K)
+			if !ok {
+				continue
+			}
+			index[k] = append(index[k], item)
+		}
+		return index
+	})
+
+	return index[key]
+}
+
+// findIndexField 按字段名或 tag 名称查找结构体字段
+func findIndexField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	if field, ok := typ.FieldByName(name); ok {
+		return field, true
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if slices.Contains(convert.ColumnAliases(field), name) {
+			return field, true
+		}
+		if jsonTag := strings.Split(field.Tag.Get("json"), ",")[0]; jsonTag != "" && jsonTag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,179 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
+)
+
+// refField 结构体上通过 config233_ref 标签声明的配置引用字段
+//
+// 用法:
+//
+//	type ItemConfig struct {
+//		Id    int          `json:"id"`
+//		Skill *SkillConfig `json:"skillId" config233_ref:"SkillConfig"` // 单元格里填技能 ID
+//	}
+//
+// 转换时不会把单元格写入该字段，只记录其中的 ID；所有配置加载完成后按 ID 到目标配置中查找并回填指针
+type refField struct {
+	index  []int               // 字段在结构体中的索引路径（支持嵌入字段）
+	field  reflect.StructField // 字段定义，用于按 json tag 等规则查找原始数据中的 ID
+	target string              // 引用的配置名
+	typ    reflect.Type        // 字段类型（指向目标配置结构体的指针）
+}
+
+// refFieldCache 结构体类型 -> 配置引用字段
+var refFieldCache sync.Map
+
+// isRefField 判断字段是否声明了 config233_ref 标签，这类字段在转换时跳过，由 resolveConfigRefs 回填
+func isRefField(field reflect.StructField) bool {
+	_, ok := field.Tag.Lookup("config233_ref")
+	return ok
+}
+
+// refFieldsOf 解析结构体类型上声明的配置引用字段（按类型缓存）
+// 字段类型不是结构体指针或标签为空时记录错误日志并忽略
+func refFieldsOf(t reflect.Type) []refField {
+	if cached, ok := refFieldCache.Load(t); ok {
+		return cached.([]refField)
+	}
+
+	var fields []refField
+	for _, field := range reflect.VisibleFields(t) {
+		if field.Anonymous || !field.IsExported() || !isRefField(field) {
+			continue
+		}
+		target := field.Tag.Get("config233_ref")
+		if target == "" {
+			getLogger().Error(fmt.Errorf("字段 %s 的 config233_ref 标签为空", field.Name), "配置引用标签需要指定配置名，已忽略", "type", t.String())
+			continue
+		}
+		if field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct {
+			getLogger().Error(fmt.Errorf("字段 %s 不是结构体指针", field.Name), "配置引用字段必须是 *T 类型，已忽略", "type", t.String(), "fieldType", field.Type.String())
+			continue
+		}
+		fields = append(fields, refField{index: field.Index, field: field, target: target, typ: field.Type})
+	}
+
+	refFieldCache.Store(t, fields)
+	return fields
+}
+
+// recordConfigRefs 记录一条配置中引用字段的原始 ID，供加载完成后回填
+// 参数:
+//
+//	typ: 配置结构体类型
+//	item: 转换后的配置（*T）
+//	data: 转换前的原始数据
+func (cm *ConfigManager233) recordConfigRefs(typ reflect.Type, item interface{}, data map[string]interface{}) {
+	fields := refFieldsOf(typ)
+	if len(fields) == 0 {
+		return
+	}
+
+	ids := make([]string, len(fields))
+	for i, f := range fields {
+		if value, found := lookupFieldValue(f.field, data); found && value != nil {
+			ids[i] = normalizeId(value)
+		}
+	}
+	cm.configRefs.Store(item, ids)
+}
+
+// resolveConfigRefs 按记录的 ID 回填所有配置中的引用字段
+// 在全量加载、热重载与回滚完成后、通知业务管理器之前调用，引用目标被重载后会重新指向新的对象；
+// 只回填指针、不递归解析被引用配置，A→B→A 的循环引用同样只是互相持有指针，不会死循环
+// 引用的配置不存在或其中没有该 ID 时记录错误日志，字段保持 nil
+//
+// 注意: 回填直接修改缓存中的配置对象，热重载期间并发读取引用字段可能读到回填前的值
+func (cm *ConfigManager233) resolveConfigRefs() {
+	slices := getGlobalSliceCache(cm)
+	idMaps := getGlobalIdMapCache(cm)
+
+	for configName, slice := range slices {
+		typ, registered := cm.getRegisteredType(configName)
+		if !registered {
+			continue
+		}
+		fields := refFieldsOf(typ)
+		if len(fields) == 0 {
+			continue
+		}
+
+		for _, item := range slice {
+			// 转换失败保留为 map 的配置项不可作为 key，也没有引用记录
+			v := reflect.ValueOf(item)
+			if v.Kind() != reflect.Ptr || v.IsNil() {
+				continue
+			}
+			loaded, ok := cm.configRefs.Load(item)
+			if !ok {
+				continue
+			}
+			ids := loaded.([]string)
+			for i, f := range fields {
+				fieldValue := convert.FieldByIndexAlloc(v.Elem(), f.index)
+				if !fieldValue.IsValid() {
+					continue
+				}
+				fieldValue.Set(reflect.Zero(f.typ))
+				if ids[i] == "" {
+					continue
+				}
+
+				target, found := idMaps[f.target][ids[i]]
+				if !found {
+					getLogger().Error(fmt.Errorf("配置 %s 中不存在 ID %s", f.target, ids[i]), "配置引用解析失败，字段保持 nil",
+						"configName", configName, "field", f.field.Name)
+					continue
+				}
+				if reflect.TypeOf(target) != f.typ {
+					getLogger().Error(fmt.Errorf("配置 %s 的类型 %T 与字段类型 %s 不一致", f.target, target, f.typ), "配置引用解析失败，字段保持 nil",
+						"configName", configName, "field", f.field.Name)
+					continue
+				}
+				fieldValue.Set(reflect.ValueOf(target))
+			}
+		}
+	}
+
+	cm.pruneConfigRefs()
+}
+
+// pruneConfigRefs 清除已不在缓存与历史快照中的配置的引用记录（重载替换掉的旧对象、校验失败被丢弃的解析结果）
+func (cm *ConfigManager233) pruneConfigRefs() {
+	live := make(map[interface{}]struct{})
+	collect := func(slices map[string][]interface{}) {
+		for _, slice := range slices {
+			for _, item := range slice {
+				if item != nil && reflect.TypeOf(item).Kind() == reflect.Ptr {
+					live[item] = struct{}{}
+				}
+			}
+		}
+	}
+	collect(getGlobalSliceCache(cm))
+	cm.snapshotMu.Lock()
+	for _, snapshot := range cm.snapshots {
+		collect(*snapshot.slices)
+	}
+	cm.snapshotMu.Unlock()
+
+	cm.configRefs.Range(func(k, _ interface{}) bool {
+		if _, ok := live[k]; !ok {
+			cm.configRefs.Delete(k)
+		}
+		return true
+	})
+}
+
+// clearConfigRefs 清除所有引用记录
+func (cm *ConfigManager233) clearConfigRefs() {
+	cm.configRefs.Range(func(k, _ interface{}) bool {
+		cm.configRefs.Delete(k)
+		return true
+	})
+}
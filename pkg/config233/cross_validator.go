@@ -0,0 +1,71 @@
+package config233
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CrossValidatorFunc 跨配置校验函数
+// 在所有配置加载完成后执行，可通过 GetConfigByIdFrom 等方法检查配置之间的引用是否存在
+type CrossValidatorFunc func(cm *ConfigManager233) error
+
+// RegisterCrossValidator 注册跨配置校验函数
+// 每次全量加载（LoadAllConfigs / LoadAllConfigsStrict）完成后按注册顺序统一执行，
+// 此时所有配置都已写入内存，适合校验物品的 jumpId 是否存在于跳转配置这类跨表引用，
+// 比在单条配置的 Check() 中跨表查询更可靠（那时其他配置可能还没加载）
+// 参数:
+//
+//	fn: 校验函数，返回的错误会被汇总
+//
+// 示例:
+//
+//	manager.RegisterCrossValidator(func(cm *config233.ConfigManager233) error {
+//	    var errs []error
+//	    for _, item := range config233.GetConfigListFrom[ItemConfig](cm) {
+//	        if _, ok := config233.GetConfigByIdFrom[JumpConfig](cm, item.JumpId); !ok {
+//	            errs = append(errs, fmt.Errorf("物品 %d 的 jumpId %d 不存在", item.Id, item.JumpId))
+//	        }
+//	    }
+//	    return errors.Join(errs...)
+//	})
+func (cm *ConfigManager233) RegisterCrossValidator(fn CrossValidatorFunc) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.crossValidators = append(cm.crossValidators, fn)
+}
+
+// ValidateCrossReferences 立即执行所有已注册的跨配置校验函数
+// 全量加载完成后会自动执行，热重载或远程加载后需要校验时也可手动调用
+// 校验函数 panic 时转为错误，不影响其他校验函数执行
+// 返回值:
+//
+//	error: 所有校验函数返回的错误（errors.Join 汇总），全部通过时返回 nil
+func (cm *ConfigManager233) ValidateCrossReferences() error {
+	cm.mutex.RLock()
+	validators := append([]CrossValidatorFunc(nil), cm.crossValidators...)
+	cm.mutex.RUnlock()
+
+	var errs []error
+	for i, fn := range validators {
+		if err := runCrossValidator(cm, fn); err != nil {
+			errs = append(errs, fmt.Errorf("跨配置校验 #%d 失败: %w", i+1, err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	err := errors.Join(errs...)
+	getLogger().Error(err, "跨配置校验未通过", "failedCount", len(errs), "validatorCount", len(validators))
+	return err
+}
+
+// runCrossValidator 执行单个跨配置校验函数，panic 时转为错误
+func runCrossValidator(cm *ConfigManager233, fn CrossValidatorFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("校验函数发生 panic: %v", r)
+		}
+	}()
+	return fn(cm)
+}
@@ -2,7 +2,7 @@
 //
 // # 功能特性
 //
-//   - 支持多种配置文件格式（JSON, TSV, Excel）
+//   - 支持多种配置文件格式（JSON, TSV, CSV, Excel, YAML, XML）
 //   - 热更新监听文件变化
 //   - 配置数据 ORM 到结构体
 //   - 字段注入和方法回调
@@ -47,8 +47,17 @@
 // # 结构体标签
 //
 //   - `config233:"uid"` - 标记唯一标识字段（必须）
-//   - `config233:"inject"` - 标记需要注入配置映射的字段
-//   - `config233:"hotupdate"` - 标记热更新时调用的方法
+//   - `config233:"inject"` - 标记需要注入配置映射的字段，支持 map[string]*T、map[int64]T 等 key / value 组合
+//   - `config233_column:"itemId,item_id"` - 列名别名，逗号分隔多个，任一命中即可；Excel/TSV 的列名匹配不区分大小写
+//   - `config233_sep:";"` - 切片字段的分隔符，字符串按分隔符拆分后逐元素转换，默认逗号
+//   - `config233_json:"true"` - 单元格内容按内嵌 JSON 解析进字段（struct / map 字段以 { 或 [ 开头时自动尝试）
+//   - `config233_timefmt:"2006/01/02"` - time.Time 字段的解析格式（默认 "2006-01-02 15:04:05" 和 RFC3339），time.Duration 支持 "5s" 与毫秒数
+//   - `config233_required:"true"` - 必填字段，加载后为零值时报错（LoadAllConfigsStrict 下加载失败，宽松模式只记录日志）
+//   - `config233_allow_zero:"true"` - 配合 required 使用，数字 0 与 false 视为已填写
+//   - `config233_min:"1"` / `config233_max:"100"` - 数值字段的闭区间范围，越界时与 required 同样处理
+//   - `config233_default:"100"` - 字段转换后为零值（没填）时使用的默认值，支持字符串、布尔、数值及其指针；有默认值的字段不做 required 校验
+//   - `config233_ref:"SkillConfig"` - *T 字段的单元格填被引用配置的 ID，所有配置加载完成后回填为对应配置的指针，找不到时保持 nil
+//   - 热更新方法按方法名约定：OnHotUpdate()、On<配置名>HotUpdate()（Go 的方法不支持标签）
 //
 // # 热更新
 //
@@ -61,12 +70,12 @@
 //	listener := &Listener{}
 //	cfg.RegisterForHotUpdate(listener)
 //
-// 当配置文件变化时，ConfigMap 字段会自动更新。
+// 当配置文件变化时，ConfigMap 字段会自动更新，随后调用对象上的 OnHotUpdate() 方法（如果有）。
 //
 // # 日志集成
 //
-// Config233 支持 logr 接口：
+// Logger 的方法签名与 logr.Logger 一致，logr.Logger 可直接传入；标准库 slog 可通过 NewSlogLogger 适配：
 //
-//	import "github.com/go-logr/logr"
-//	config233.SetLogger(yourLogger)
+//	config233.SetLogger(yourLogrLogger)
+//	config233.SetLogger(config233.NewSlogLogger(slog.Default()))
 package config233
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConversionError 单个单元格的类型转换错误
+// 转换失败的字段保持零值，同一行的其他字段照常转换
+type ConversionError struct {
+	// ConfigName 配置名称
+	ConfigName string
+	// RowIndex 数据所在行号，从 1 开始（Excel 为工作表中的行号，TSV/CSV 不计空行与 # 注释行，第 1 个非空行为第 1 行）
+	RowIndex int
+	// ColumnName 列名（表头中的字段名）
+	ColumnName string
+	// TargetType 目标类型，如 "int"、"float64"、声明的列类型或字段的 Go 类型
+	TargetType string
+	// RawValue 单元格原始内容
+	RawValue string
+	// Err 底层转换错误
+	Err error
+}
+
+// Error 返回形如 "[Item] 第 3 行 badInt 列: 无法将 'abc' 转换为 int: ..." 的错误信息
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("[%s] 第 %d 行 %s 列: 无法将 '%s' 转换为 %s: %v",
+		e.ConfigName, e.RowIndex, e.ColumnName, e.RawValue, e.TargetType, e.Err)
+}
+
+// Unwrap 返回底层转换错误
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// ConversionErrors 一次读取中收集到的所有类型转换错误
+// 处理器返回该错误时，对象列表仍然有效，只是其中部分字段保持零值
+type ConversionErrors []*ConversionError
+
+// Error 按行拼接所有转换错误
+func (e ConversionErrors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, convErr := range e {
+		lines = append(lines, convErr.Error())
+	}
+	return fmt.Sprintf("%d 个字段类型转换失败:\n%s", len(e), strings.Join(lines, "\n"))
+}
+
+// Unwrap 返回所有转换错误，支持 errors.Is / errors.As
+func (e ConversionErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, convErr := range e {
+		errs = append(errs, convErr)
+	}
+	return errs
+}
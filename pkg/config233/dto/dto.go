@@ -12,4 +12,10 @@ type FrontEndConfigDto struct {
 	Suffix string `json:"suffix"`
 	// ConfigNameSimple 配置的简单名称，不包含路径和扩展名
 	ConfigNameSimple string `json:"configNameSimple"`
+	// ColumnNames 表格类配置（TSV/CSV/Excel）按原始顺序排列的列名，其他格式为空
+	ColumnNames []string `json:"columnNames,omitempty"`
+	// ConversionErrors 按类型行转换失败的单元格（目前只有 Excel 填充），失败的单元格在 DataList 中保留原始字符串
+	ConversionErrors ConversionErrors `json:"-"`
+	// SchemaVersion 配置文件声明的 schema 版本号（JSON 顶层 __version 字段、Excel 表头的 version 行），0 表示未声明
+	SchemaVersion int `json:"schemaVersion,omitempty"`
 }
@@ -0,0 +1,111 @@
+package config233
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DuplicateIdPolicy 同一配置文件中多行使用相同 ID 时的处理策略
+// 无论哪种策略，ID 映射与配置列表中的条目始终一一对应，不会出现列表里两条、映射里一条的情况
+type DuplicateIdPolicy int
+
+const (
+	// DuplicateIdKeepLast 记录警告日志（Info 级别）并保留最后一条（默认），保留的配置位于该 ID 首次出现的位置
+	DuplicateIdKeepLast DuplicateIdPolicy = iota
+	// DuplicateIdKeepFirst 记录警告日志（Info 级别）并保留第一条，之后的同 ID 行被丢弃
+	DuplicateIdKeepFirst
+	// DuplicateIdError 视为加载失败并返回错误，该配置保留旧数据（严格模式下整次加载失败）
+	DuplicateIdError
+)
+
+// SetDuplicateIdPolicy 设置同一配置文件中出现重复 ID 时的处理策略（链式调用）
+// 参数:
+//
+//	policy: DuplicateIdKeepLast（默认）、DuplicateIdKeepFirst 或 DuplicateIdError
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetDuplicateIdPolicy(policy DuplicateIdPolicy) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.duplicateId = policy
+	return cm
+}
+
+// configItemSet 按 ID 去重收集一个配置文件中的配置项，保证 ID 映射与列表一致
+type configItemSet struct {
+	configName string
+	policy     DuplicateIdPolicy
+	configMap  map[string]interface{}
+	slice      []interface{}
+	positions  map[string]int // ID -> 在 slice 中的下标
+	duplicates []string       // 重复出现的 ID（按首次重复的顺序，不重复记录）
+}
+
+// newConfigItemSet 创建配置项集合，按当前的重复 ID 策略去重
+// 参数:
+//
+//	configName: 配置名称
+//	capacity: 预计的配置项数量
+func (cm *ConfigManager233) newConfigItemSet(configName string, capacity int) *configItemSet {
+	cm.mutex.RLock()
+	policy := cm.duplicateId
+	cm.mutex.RUnlock()
+
+	return &configItemSet{
+		configName: configName,
+		policy:     policy,
+		configMap:  make(map[string]interface{}, capacity),
+		slice:      make([]interface{}, 0, capacity),
+		positions:  make(map[string]int, capacity),
+	}
+}
+
+// add 加入一条配置，id 为空时只加入列表
+func (s *configItemSet) add(id string, item interface{}) {
+	if id == "" {
+		s.slice = append(s.slice, item)
+		return
+	}
+
+	pos, exists := s.positions[id]
+	if !exists {
+		s.positions[id] = len(s.slice)
+		s.configMap[id] = item
+		s.slice = append(s.slice, item)
+		return
+	}
+
+	if !slices.Contains(s.duplicates, id) {
+		s.duplicates = append(s.duplicates, id)
+	}
+	if s.policy == DuplicateIdKeepFirst {
+		return
+	}
+	s.configMap[id] = item
+	s.slice[pos] = item
+}
+
+// result 返回去重后的 ID 映射与列表
+// 返回值:
+//
+//	map[string]interface{}: ID -> 配置
+//	[]interface{}: 配置列表
+//	error: DuplicateIdError 策略下存在重复 ID 时返回错误
+func (s *configItemSet) result() (map[string]interface{}, []interface{}, error) {
+	if len(s.duplicates) == 0 {
+		return s.configMap, s.slice, nil
+	}
+
+	switch s.policy {
+	case DuplicateIdError:
+		return nil, nil, fmt.Errorf("配置 %s 存在重复的 ID: %s", s.configName, strings.Join(s.duplicates, ", "))
+	case DuplicateIdKeepFirst:
+		getLogger().Info("配置存在重复的 ID，已保留第一条", "configName", s.configName, "ids", s.duplicates)
+	default:
+		getLogger().Info("配置存在重复的 ID，已保留最后一条", "configName", s.configName, "ids", s.duplicates)
+	}
+	return s.configMap, s.slice, nil
+}
@@ -0,0 +1,101 @@
+package config233
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DuplicateNamePolicy 同一配置目录内出现同名配置文件（如 ItemConfig.json 与 ItemConfig.tsv，或子目录中的同名文件）时的处理策略
+// 多个配置目录之间的同名配置由 SetConfigDirConflictPolicy 单独控制
+type DuplicateNamePolicy int
+
+const (
+	// DuplicateNameError 视为冲突并返回错误（默认）
+	// 冲突的配置不加载，其他配置照常加载；ConfigManager233 的 LoadAllConfigs 在返回的 ConfigValidationErrors 中报告冲突，
+	// Config233 的 Start 打错误日志并跳过冲突的配置
+	DuplicateNameError DuplicateNamePolicy = iota
+	// DuplicateNamePanic 直接 panic
+	DuplicateNamePanic
+	// DuplicateNameFirstWins 按遍历顺序（文件路径字典序）保留第一个文件
+	DuplicateNameFirstWins
+	// DuplicateNameLastWins 按遍历顺序（文件路径字典序）保留最后一个文件
+	DuplicateNameLastWins
+)
+
+// SetDuplicateNamePolicy 设置同一配置目录内出现同名配置文件时的处理策略（链式调用）
+// 参数:
+//
+//	policy: DuplicateNameError（默认）、DuplicateNamePanic、DuplicateNameFirstWins 或 DuplicateNameLastWins
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetDuplicateNamePolicy(policy DuplicateNamePolicy) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.duplicateName = policy
+	return cm
+}
+
+// SetDuplicateNamePolicy 设置配置目录内出现同名配置文件时的处理策略
+// policy: DuplicateNameError（默认）、DuplicateNamePanic、DuplicateNameFirstWins 或 DuplicateNameLastWins
+// 返回Config233实例支持链式调用
+func (c *Config233) SetDuplicateNamePolicy(policy DuplicateNamePolicy) *Config233 {
+	c.duplicateName = policy
+	return c
+}
+
+// resolveDuplicateNames 按策略处理同名（去掉扩展名后相同）的配置文件
+// paths 为遍历顺序的文件路径，返回保留的路径（保持原顺序）
+// DuplicateNameError 策略下冲突的配置全部不保留，并返回包含所有冲突的 ConfigValidationErrors（每个冲突的配置一项）
+func resolveDuplicateNames(paths []string, policy DuplicateNamePolicy) ([]string, error) {
+	var names []string
+	groups := make(map[string][]string)
+	for _, path := range paths {
+		configName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if _, ok := groups[configName]; !ok {
+			names = append(names, configName)
+		}
+		groups[configName] = append(groups[configName], path)
+	}
+
+	keep := make(map[string]string, len(groups))
+	var errs ConfigValidationErrors
+	for _, configName := range names {
+		group := groups[configName]
+		if len(group) == 1 {
+			keep[configName] = group[0]
+			continue
+		}
+
+		switch policy {
+		case DuplicateNamePanic:
+			panic(fmt.Sprintf("重复的配置文件名: %s 和 %s", group[0], group[1]))
+		case DuplicateNameFirstWins:
+			keep[configName] = group[0]
+			getLogger().Info("存在同名配置文件，保留第一个", "configName", configName, "path", group[0], "skipped", group[1:])
+		case DuplicateNameLastWins:
+			keep[configName] = group[len(group)-1]
+			getLogger().Info("存在同名配置文件，保留最后一个", "configName", configName, "path", group[len(group)-1], "skipped", group[:len(group)-1])
+		default:
+			errs = append(errs, &ConfigValidationError{
+				ConfigName: configName,
+				FilePath:   group[0],
+				Err:        fmt.Errorf("存在多个同名文件: %v", group),
+			})
+		}
+	}
+
+	resolved := make([]string, 0, len(keep))
+	for _, path := range paths {
+		configName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if keep[configName] == path {
+			resolved = append(resolved, path)
+		}
+	}
+	if len(errs) > 0 {
+		return resolved, errs
+	}
+	return resolved, nil
+}
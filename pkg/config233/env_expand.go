@@ -0,0 +1,115 @@
+package config233
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+)
+
+// EnvExpandPolicy 字符串字段中 ${NAME} 占位符的展开策略
+// 只识别带花括号的 ${NAME}，不展开 $NAME 写法，以免误伤 "$5" 之类含 $ 的正常文本
+type EnvExpandPolicy int
+
+const (
+	// EnvExpandOff 不展开占位符（默认）
+	EnvExpandOff EnvExpandPolicy = iota
+	// EnvExpandKeepUndefined 展开已定义的环境变量，未定义的变量保留原文 ${NAME}
+	EnvExpandKeepUndefined
+	// EnvExpandErrorUndefined 展开已定义的环境变量，遇到未定义的变量时记录错误日志（带配置名与字段名），该占位符保留原文
+	EnvExpandErrorUndefined
+)
+
+// envPlaceholderPattern 匹配 ${NAME} 形式的占位符，变量名规则与 shell 一致
+var envPlaceholderPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// SetEnvExpandPolicy 设置加载时字符串字段中 ${ENV_NAME} 占位符的展开策略（链式调用）
+// 开启后 ConfigManager233 加载的配置在字段转换完成后（默认值填充之后、AfterLoad 之前），
+// 把 string、*string、[]string、map[string]string 字段（含嵌套结构体中的字段）里的 ${NAME} 替换为环境变量的值；
+// 定义为空字符串的变量按已定义处理。config233_ref 引用字段不处理
+// 参数:
+//
+//	policy: EnvExpandOff（默认）、EnvExpandKeepUndefined 或 EnvExpandErrorUndefined
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetEnvExpandPolicy(policy EnvExpandPolicy) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.envExpand = policy
+	return cm
+}
+
+// envExpandPolicy 返回当前的占位符展开策略
+func (cm *ConfigManager233) envExpandPolicy() EnvExpandPolicy {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	return cm.envExpand
+}
+
+// expandEnvFields 按策略展开配置结构体中字符串字段的占位符
+// 参数:
+//
+//	instance: 配置结构体（可设置的 reflect.Value）
+//	configName: 配置名称，用于错误日志
+func (cm *ConfigManager233) expandEnvFields(instance reflect.Value, configName string) {
+	policy := cm.envExpandPolicy()
+	if policy == EnvExpandOff {
+		return
+	}
+	expandEnvValue(instance, "", func(fieldPath, raw string) string {
+		return envPlaceholderPattern.ReplaceAllStringFunc(raw, func(placeholder string) string {
+			name := placeholder[2 : len(placeholder)-1]
+			if value, ok := os.LookupEnv(name); ok {
+				return value
+			}
+			if policy == EnvExpandErrorUndefined {
+				getLogger().Error(fmt.Errorf("环境变量 %s 未定义", name), "配置中的占位符无法展开，已保留原文",
+					"configName", configName, "field", fieldPath)
+			}
+			return placeholder
+		})
+	})
+}
+
+// expandEnvValue 递归处理 v 中的字符串，expand 返回替换后的字符串
+func expandEnvValue(v reflect.Value, path string, expand func(fieldPath, raw string) string) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(expand(path, v.String()))
+		}
+
+	case reflect.Ptr:
+		if !v.IsNil() {
+			expandEnvValue(v.Elem(), path, expand)
+		}
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.String {
+			for i := 0; i < v.Len(); i++ {
+				expandEnvValue(v.Index(i), path, expand)
+			}
+		}
+
+	case reflect.Map:
+		if v.Type().Elem().Kind() == reflect.String && !v.IsNil() {
+			iter := v.MapRange()
+			for iter.Next() {
+				value := reflect.New(v.Type().Elem()).Elem()
+				value.SetString(expand(path, iter.Value().String()))
+				v.SetMapIndex(iter.Key(), value)
+			}
+		}
+
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() || isRefField(field) {
+				continue
+			}
+			expandEnvValue(v.Field(i), joinFieldPath(path, field.Name), expand)
+		}
+	}
+}
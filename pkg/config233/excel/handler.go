@@ -1,20 +1,35 @@
 package excel
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
-	"strconv"
 	"strings"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/logging"
 
 	"github.com/xuri/excelize/v2"
 )
 
 // ExcelConfigHandler Excel 配置处理器
 // 负责处理 Excel 格式的配置文件，读取并解析为配置对象
-type ExcelConfigHandler struct{}
+type ExcelConfigHandler struct {
+	// SheetName 指定读取的工作表名称，为空时读取第一个可见的工作表
+	SheetName string
+	// HeaderMode 表头模式，默认自动识别多行表头，无法识别时回退为单行表头
+	HeaderMode HeaderMode
+	// KeepIgnoredColumns 为 true 时保留被忽略的列
+	// 默认跳过字段名以 # 开头、或类型行标记为 skip/ignore 的列（策划注释或内部使用的列），
+	// 这些列不进入 DataList，也不参与结构体映射
+	KeepIgnoredColumns bool
+	// ColumnNameNormalizer 匹配字段前对字段名行的每个列名做转换（如去空格、中文转拼音、下划线转驼峰），
+	// 为 nil 时保持原样；转换结果为空字符串的列不映射。以 # 开头的忽略列按转换前的列名判断
+	ColumnNameNormalizer func(raw string) string
+}
 
 // TypeName 返回处理器类型名
 // 返回值:
@@ -24,9 +39,96 @@ func (h *ExcelConfigHandler) TypeName() string {
 	return "excel"
 }
 
+// resolveSheetName 确定要读取的工作表名称
+// 指定了名称时校验其存在，未指定时返回第一个可见的工作表
+func resolveSheetName(f *excelize.File, sheetName string) (string, error) {
+	sheets := f.GetSheetList()
+	if sheetName != "" {
+		for _, name := range sheets {
+			if name == sheetName {
+				return name, nil
+			}
+		}
+		return "", fmt.Errorf("工作表 %q 不存在，可用工作表: %v", sheetName, sheets)
+	}
+
+	for _, name := range sheets {
+		if visible, err := f.GetSheetVisible(name); err == nil && visible {
+			return name, nil
+		}
+	}
+	if len(sheets) > 0 {
+		return sheets[0], nil
+	}
+	return "", nil
+}
+
+// readSheetRows 打开 Excel 文件并读取指定工作表的所有行
+// 按扩展名选择解析器：.xls 使用 extrame/xls，其余使用 excelize
+// 文件没有任何工作表时返回 nil
+func (h *ExcelConfigHandler) readSheetRows(configFileFullPath, sheetName string) ([][]string, error) {
+	if isXlsFile(configFileFullPath) {
+		data, err := os.ReadFile(configFileFullPath)
+		if err != nil {
+			return nil, fmt.Errorf("打开 Excel 文件失败 (%s): %w", configFileFullPath, err)
+		}
+		return readXlsRows(bytes.NewReader(data), configFileFullPath, sheetName)
+	}
+
+	f, err := excelize.OpenFile(configFileFullPath)
+	if err != nil {
+		return nil, fmt.Errorf("打开 Excel 文件失败 (%s): %w", configFileFullPath, err)
+	}
+	defer f.Close()
+
+	return h.sheetRows(f, configFileFullPath, sheetName)
+}
+
+// readSheetRowsFromBytes 通过 excelize.OpenReader 从内存内容读取指定工作表的所有行
+// 内容以 OLE2 文件头开头时按旧版 .xls 解析
+func (h *ExcelConfigHandler) readSheetRowsFromBytes(configName string, data []byte, sheetName string) ([][]string, error) {
+	if isXlsContent(data) {
+		return readXlsRows(bytes.NewReader(data), configName, sheetName)
+	}
+
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("打开 Excel 内容失败 (%s): %w", configName, err)
+	}
+	defer f.Close()
+
+	return h.sheetRows(f, configName, sheetName)
+}
+
+// sheetRows 读取已打开文件中指定工作表的所有行，configFileFullPath 仅用于错误信息
+// 合并单元格会回填为左上角的值，见 fillMergedCells
+func (h *ExcelConfigHandler) sheetRows(f *excelize.File, configFileFullPath, sheetName string) ([][]string, error) {
+	resolved, err := resolveSheetName(f, sheetName)
+	if err != nil {
+		return nil, fmt.Errorf("读取 Excel 文件失败 (%s): %w", configFileFullPath, err)
+	}
+	if resolved == "" {
+		return nil, nil
+	}
+
+	rows, err := f.GetRows(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("读取工作表 %q 失败 (%s): %w", resolved, configFileFullPath, err)
+	}
+
+	merges, err := f.GetMergeCells(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("读取工作表 %q 的合并单元格失败 (%s): %w", resolved, configFileFullPath, err)
+	}
+	if len(merges) > 0 {
+		rows = fillMergedCells(rows, merges, h.resolveLayout(rows).dataStart)
+	}
+	return rows, nil
+}
+
 // ReadToFrontEndDataList 读取配置并转为前端数据列表
 // 读取 Excel 配置文件并转换为前端可用的数据传输对象
-// 默认读取第一个工作表，第一行为表头
+// 读取 SheetName 指定的工作表，未指定时读取第一个可见工作表
 // 参数:
 //
 //	configName: 配置名称
@@ -35,47 +137,109 @@ func (h *ExcelConfigHandler) TypeName() string {
 // 返回值:
 //
 //	interface{}: 包含解析后数据的传输对象
-func (h *ExcelConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) interface{} {
-	f, err := excelize.OpenFile(configFileFullPath)
+//	error: 文件无法打开或指定的工作表不存在时返回错误
+func (h *ExcelConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) (interface{}, error) {
+	return h.ReadToFrontEndDataListFromSheet(configName, configFileFullPath, h.SheetName)
+}
+
+// ReadToFrontEndDataListFromSheet 从指定工作表读取配置并转为前端数据列表
+// 参数:
+//
+//	configName: 配置名称
+//	configFileFullPath: Excel 配置文件的完整路径
+//	sheetName: 工作表名称，为空时读取第一个可见工作表
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 文件无法打开或指定的工作表不存在时返回错误
+func (h *ExcelConfigHandler) ReadToFrontEndDataListFromSheet(configName, configFileFullPath, sheetName string) (interface{}, error) {
+	rows, err := h.readSheetRows(configFileFullPath, sheetName)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	defer f.Close()
+	return h.rowsToFrontEndDto(configName, rows), nil
+}
 
-	// 获取第一个工作表的名称
-	sheets := f.GetSheetList()
-	if len(sheets) == 0 {
-		return &dto.FrontEndConfigDto{
-			DataList:         nil,
-			Type:             h.TypeName(),
-			Suffix:           "xlsx",
-			ConfigNameSimple: configName,
+// ReadToFrontEndDataListFromBytes 从内存中的 Excel 内容读取配置并转为前端数据列表
+// 用于 embed.FS 等无法直接按路径读取文件的场景，读取 SheetName 指定的工作表
+// 参数:
+//
+//	configName: 配置名称
+//	data: Excel 文件内容
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 内容无法解析或指定的工作表不存在时返回错误
+func (h *ExcelConfigHandler) ReadToFrontEndDataListFromBytes(configName string, data []byte) (interface{}, error) {
+	rows, err := h.readSheetRowsFromBytes(configName, data, h.SheetName)
+	if err != nil {
+		return nil, err
+	}
+	return h.rowsToFrontEndDto(configName, rows), nil
+}
+
+// SheetConfig 一个工作表解析出的配置
+type SheetConfig struct {
+	SheetName string                 // 工作表名称
+	Config    *dto.FrontEndConfigDto // 工作表中的配置数据，ConfigNameSimple 为工作表名称
+}
+
+// ReadAllSheetsFromBytes 从内存中的 Excel 内容读取所有可见工作表，每个工作表作为一个独立配置
+// 忽略 SheetName，隐藏的工作表与没有数据行的工作表被跳过
+// 参数:
+//
+//	configName: 配置名称，仅用于错误信息
+//	data: Excel 文件内容
+//
+// 返回值:
+//
+//	[]SheetConfig: 按工作表顺序排列的配置
+//	error: 内容无法解析时返回错误
+func (h *ExcelConfigHandler) ReadAllSheetsFromBytes(configName string, data []byte) ([]SheetConfig, error) {
+	var names []string
+	var sheets [][][]string
+	if isXlsContent(data) {
+		var err error
+		if names, sheets, err = readXlsAllSheets(bytes.NewReader(data), configName); err != nil {
+			return nil, err
+		}
+	} else {
+		f, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("打开 Excel 内容失败 (%s): %w", configName, err)
+		}
+		defer f.Close()
+
+		for _, name := range f.GetSheetList() {
+			if visible, err := f.GetSheetVisible(name); err != nil || !visible {
+				continue
+			}
+			rows, err := h.sheetRows(f, configName, name)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, name)
+			sheets = append(sheets, rows)
 		}
 	}
-	sheetName := sheets[0]
 
-	rows, err := f.GetRows(sheetName)
-	if err != nil {
-		panic(err)
-	}
-
-	// 第 1 列 column 完全没有跳过
-	// 固定行结构（0-based 索引）：
-	// 第 1 行 (index 0): 空行或注释
-	// 第 2 行 (index 1): 中文字段名
-	// 第 3 行 (index 2): Client 字段名
-	// 第 4 行 (index 3): 类型 (type)
-	// 第 5 行 (index 4): Server 字段名 (服务端使用这一行作为字段名)
-	// 第 6 行 (index 5): 数据开始
-	const (
-		clientRowIndex = 2 // Client 字段名行
-		typeRowIndex   = 3 // 类型行
-		serverRowIndex = 4 // Server 字段名行 (使用这个)
-		dataStartIndex = 5 // 数据开始行
-	)
+	var result []SheetConfig
+	for i, rows := range sheets {
+		if configDto := h.rowsToFrontEndDto(names[i], rows); configDto.DataList != nil {
+			result = append(result, SheetConfig{SheetName: names[i], Config: configDto})
+		}
+	}
+	return result, nil
+}
+
+// rowsToFrontEndDto 将工作表的所有行转换为前端数据传输对象
+func (h *ExcelConfigHandler) rowsToFrontEndDto(configName string, rows [][]string) *dto.FrontEndConfigDto {
+	layout := h.resolveLayout(rows)
 
 	// 检查行数是否足够
-	if len(rows) <= dataStartIndex {
+	if len(rows) <= layout.dataStart {
 		return &dto.FrontEndConfigDto{
 			DataList:         nil,
 			Type:             h.TypeName(),
@@ -84,22 +248,23 @@ func (h *ExcelConfigHandler) ReadToFrontEndDataList(configName, configFileFullPa
 		}
 	}
 
-	// Server 行作为字段名，从第 2 列开始（跳过第 1 列的标识）
-	headers := rows[serverRowIndex]
+	// 字段名行（多行表头时为 Server 行），被忽略的列字段名置空
+	headers := h.fieldHeaders(rows, layout)
 
-	// 获取类型信息
-	var types []string
-	if typeRowIndex < len(rows) {
-		types = rows[typeRowIndex]
+	// 类型行解析为 列名 -> 类型
+	var columnTypes map[string]string
+	if layout.typeRow >= 0 {
+		columnTypes = parseColumnTypes(headers, rows[layout.typeRow], layout.firstColumn)
 	}
 
 	var dataList []map[string]interface{}
+	var convErrs dto.ConversionErrors
 
 	// 从数据行开始读取
-	for _, row := range rows[dataStartIndex:] {
+	for rowIndex, row := range rows[layout.dataStart:] {
 		item := make(map[string]interface{})
-		// 从第二列开始（跳过第一列的标识符）
-		for i := 1; i < len(row); i++ {
+		// 多行表头时从第二列开始（跳过第一列的标识符）
+		for i := layout.firstColumn; i < len(row); i++ {
 			if i < len(headers) {
 				fieldName := strings.TrimSpace(headers[i])
 				if fieldName == "" {
@@ -108,12 +273,17 @@ func (h *ExcelConfigHandler) ReadToFrontEndDataList(configName, configFileFullPa
 
 				cellValue := row[i]
 
-				// 如果有类型信息，进行类型转换
-				if types != nil && i < len(types) {
-					item[fieldName] = h.convertValue(cellValue, types[i])
-				} else {
+				// 如果有类型信息，按声明的类型转换，失败时保留原始字符串
+				typeStr, hasType := columnTypes[fieldName]
+				if !hasType {
 					item[fieldName] = cellValue
+					continue
+				}
+				converted, err := h.convertValue(cellValue, typeStr)
+				if err != nil {
+					convErrs = append(convErrs, newConversionError(configName, layout.dataStart+rowIndex, fieldName, cellValue, typeStr, err))
 				}
+				item[fieldName] = converted
 			}
 		}
 		if len(item) > 0 {
@@ -122,106 +292,152 @@ func (h *ExcelConfigHandler) ReadToFrontEndDataList(configName, configFileFullPa
 		}
 	}
 
+	columnNames := make([]string, 0, len(headers))
+	for i := layout.firstColumn; i < len(headers); i++ {
+		if name := strings.TrimSpace(headers[i]); name != "" {
+			columnNames = append(columnNames, name)
+		}
+	}
+
 	return &dto.FrontEndConfigDto{
 		DataList:         dataList,
 		Type:             h.TypeName(),
 		Suffix:           "xlsx",
 		ConfigNameSimple: configName,
+		ColumnNames:      columnNames,
+		ConversionErrors: convErrs,
+		SchemaVersion:    schemaVersion(configName, rows, layout),
 	}
 }
 
 // ReadConfigAndORM 读取配置并转换为对象列表
-func (h *ExcelConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) []interface{} {
-	f, err := excelize.OpenFile(configFileFullPath)
+// 读取 SheetName 指定的工作表，未指定时读取第一个可见工作表
+func (h *ExcelConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) ([]interface{}, error) {
+	return h.ReadConfigAndORMFromSheet(typ, configName, configFileFullPath, h.SheetName)
+}
+
+// ReadConfigAndORMFromSheet 从指定工作表读取配置并转换为对象列表
+// 参数:
+//
+//	typ: 目标配置对象的类型
+//	configName: 配置名称
+//	configFileFullPath: Excel 配置文件的完整路径
+//	sheetName: 工作表名称，为空时读取第一个可见工作表
+//
+// 返回值:
+//
+//	[]interface{}: 配置对象实例列表
+//	error: 文件无法打开或指定的工作表不存在时返回错误；
+//	  单元格类型转换失败时返回 dto.ConversionErrors，此时对象列表仍然有效
+func (h *ExcelConfigHandler) ReadConfigAndORMFromSheet(typ reflect.Type, configName, configFileFullPath, sheetName string) ([]interface{}, error) {
+	rows, err := h.readSheetRows(configFileFullPath, sheetName)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	defer f.Close()
+	return conversionResult(h.rowsToObjects(typ, configName, rows))
+}
 
-	// 获取第一个工作表的名称
-	sheets := f.GetSheetList()
-	if len(sheets) == 0 {
-		return nil
+// ReadConfigAndORMFromBytes 从内存中的 Excel 内容读取配置并转换为对象列表
+// 读取 SheetName 指定的工作表，未指定时读取第一个可见工作表
+func (h *ExcelConfigHandler) ReadConfigAndORMFromBytes(typ reflect.Type, configName string, data []byte) ([]interface{}, error) {
+	rows, err := h.readSheetRowsFromBytes(configName, data, h.SheetName)
+	if err != nil {
+		return nil, err
 	}
-	sheetName := sheets[0]
+	return conversionResult(h.rowsToObjects(typ, configName, rows))
+}
 
-	rows, err := f.GetRows(sheetName)
-	if err != nil {
-		panic(err)
+// conversionResult 有类型转换错误时返回 ConversionErrors，否则返回 nil error
+func conversionResult(result []interface{}, convErrs dto.ConversionErrors) ([]interface{}, error) {
+	if len(convErrs) > 0 {
+		return result, convErrs
 	}
+	return result, nil
+}
 
-	// 固定行结构（0-based 索引）：
-	// 第 5 行 (index 4): Server 字段名
-	// 第 6 行 (index 5): 数据开始
-	const (
-		serverRowIndex = 4 // Server 字段名行
-		dataStartIndex = 5 // 数据开始行
-	)
+// rowsToObjects 将工作表的所有行转换为 typ 类型的对象列表，并执行生命周期方法
+// 类型转换失败的字段保持零值，失败信息收集到返回的 ConversionErrors 中
+func (h *ExcelConfigHandler) rowsToObjects(typ reflect.Type, configName string, rows [][]string) ([]interface{}, dto.ConversionErrors) {
+	layout := h.resolveLayout(rows)
 
 	// 检查行数是否足够
-	if len(rows) <= dataStartIndex {
-		return nil
+	if len(rows) <= layout.dataStart {
+		return nil, nil
 	}
 
-	headers := rows[serverRowIndex]
+	headers := h.fieldHeaders(rows, layout)
 	var result []interface{}
+	var convErrs dto.ConversionErrors
 
-	// 构建 header 名称到 struct 字段名的映射，使用灵活匹配策略
-	headerToField := make(map[string]string)
-	for _, hdr := range headers {
-		h := strings.TrimSpace(hdr)
-		if h == "" {
-			continue
-		}
-		// 在 struct 字段中查找与 header 匹配的字段名或 tag（不区分大小写）
-		// 优先匹配 `config233_column` 标签，如果没有标签则使用字段名或首字母小写的字段名
-		for i := 0; i < typ.NumField(); i++ {
-			f := typ.Field(i)
-			// 候选名称：config233_column 标签��字段名
-			columnTag := f.Tag.Get("config233_column")
-			fieldName := f.Name
-
-			// 优先使用 config233_column 标签
-			if columnTag != "" && strings.EqualFold(columnTag, h) {
-				headerToField[h] = fieldName
-				break
-			}
+	// 类型行解析为 列名 -> 类型
+	var columnTypes map[string]string
+	if layout.typeRow >= 0 {
+		columnTypes = parseColumnTypes(headers, rows[layout.typeRow], layout.firstColumn)
+	}
 
-			// 如果没有 config233_column 标签，则使用字段名匹配（不区分大小写）
-			if columnTag == "" && (strings.EqualFold(fieldName, h) || strings.EqualFold(lowerFirst(fieldName), h)) {
-				headerToField[h] = fieldName
-				break
-			}
+	// 每列映射到的 struct 字段（按类型缓存），使用灵活匹配策略
+	// 支持匿名嵌入结构体提升的字段，以及 reward.itemId 形式的点分列名映射到嵌套结构体字段
+	columns := make([]*convert.CellField, len(headers))
+	columnNames := make([]string, len(headers))
+	for i, hdr := range headers {
+		columnNames[i] = strings.TrimSpace(hdr)
+		if columnNames[i] != "" {
+			columns[i] = headerFields.Lookup(typ, columnNames[i])
 		}
 	}
 
 	// 从数据行开始读取
-	for _, row := range rows[dataStartIndex:] {
+	for rowIndex, row := range rows[layout.dataStart:] {
 		obj := reflect.New(typ).Elem()
 
-		// 从第二列开始（跳过第一列的标识符）
-		for i := 1; i < len(row); i++ {
+		// 多行表头时从第二列开始（跳过第一列的标识符）
+		for i := layout.firstColumn; i < len(row); i++ {
 			if i >= len(headers) {
 				continue
 			}
 
-			header := strings.TrimSpace(headers[i])
-			if header == "" {
-				continue
-			}
-
-			goFieldName, ok := headerToField[header]
-			if !ok {
-				// 没有映射到 struct 字段，跳过
+			mapped := columns[i]
+			if mapped == nil {
+				// 空列名或没有映射到 struct 字段，跳过
 				continue
 			}
+			header := columnNames[i]
 
-			field := obj.FieldByName(goFieldName)
+			// 嵌套的结构体指针字段在首次写入时自动初始化
+			field := convert.FieldByIndexAlloc(obj, mapped.Index)
 			if !field.IsValid() || !field.CanSet() {
 				continue
 			}
 
-			h.setFieldValue(field, row[i])
+			targetType := mapped.TypeName
+
+			var err error
+			switch {
+			case mapped.Kind == convert.CellKindUnmarshaler:
+				// 字段类型实现了 UnmarshalConfigCell，由业务自定义解析
+				err = convert.UnmarshalCell(field, row[i])
+			case mapped.JSON.Match(row[i]):
+				// 单元格内嵌 JSON 对象或数组
+				targetType = "json"
+				err = convert.SetJSONCellValue(field, row[i])
+			case mapped.Kind == convert.CellKindTime:
+				// time.Time / time.Duration 字段，layout 可通过 config233_timefmt 标签指定
+				err = convert.SetTimeValue(field, row[i], mapped.TimeFormat)
+			case mapped.Kind == convert.CellKindSlice:
+				// 切片字段按 config233_sep 标签指定的分隔符拆分
+				err = h.setSliceFieldValue(field, row[i], mapped.Separator)
+			default:
+				// 有类型声明时按声明的类型转换，否则按字段类型转换
+				if typeStr, hasType := columnTypes[header]; hasType {
+					targetType = typeStr
+					err = h.setTypedFieldValue(field, row[i], typeStr)
+				} else {
+					err = h.setFieldValue(field, row[i])
+				}
+			}
+			if err != nil {
+				convErrs = append(convErrs, newConversionError(configName, layout.dataStart+rowIndex, header, row[i], targetType, err))
+			}
 		}
 
 		result = append(result, obj.Interface())
@@ -229,8 +445,10 @@ func (h *ExcelConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, conf
 
 	// 配置加载完成后，执行生命周期方法
 	for i, item := range result {
-		// 获取指针实际类型，以便调用方法
-		itemPtr := reflect.ValueOf(item).Addr().Interface()
+		// 复制到可寻址的值上获取指针，以便调用指针接收者方法
+		ptr := reflect.New(reflect.TypeOf(item))
+		ptr.Elem().Set(reflect.ValueOf(item))
+		itemPtr := ptr.Interface()
 
 		// 1. 调用 AfterLoad() 方法（如果实现了 IConfigLifecycle 接口）
 		if lifecycle, ok := itemPtr.(interface{ AfterLoad() }); ok {
@@ -240,40 +458,47 @@ func (h *ExcelConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, conf
 		// 2. 调用 Check() 方法（如果实现了 IConfigValidator 接口）
 		if validator, ok := itemPtr.(interface{ Check() error }); ok {
 			if err := validator.Check(); err != nil {
-				// 控制台红色输出校验错误
-				fmt.Printf("\033[31m[ERROR] 配置校验失败 [%s] index=%d: %v\033[0m\n", configName, i, err)
+				logging.Get().Error(err, "配置校验失败", "configName", configName, "index", i)
 			}
 		}
+
+		// 写回 AfterLoad 可能修改过的值
+		result[i] = ptr.Elem().Interface()
 	}
 
-	return result
+	return result, convErrs
 }
 
-// lowerFirst 将字符串首字母转为小写（用于将 Go 字段名如 "Id" 对应到 header 的 "id"）
-func lowerFirst(s string) string {
-	// 将首字母小写（用于将 Go 字段名如 "Id" 对应到 header 的 "id"）
-	if s == "" {
-		return s
-	}
-	return strings.ToLower(s[:1]) + s[1:]
+// headerFields 列名到 struct 字段的映射缓存，按 matchHeaderField 匹配
+var headerFields = convert.NewFieldCache(matchHeaderField)
+
+// matchHeaderField 判断字段是否与 header（或点分列名中的一段）匹配，不区分大小写
+// 优先匹配 `config233_column` 标签（逗号分隔的多个别名任一命中即可），如果没有标签则使用字段名
+func matchHeaderField(f reflect.StructField, h string) bool {
+	return convert.MatchColumn(f, h)
 }
 
 // setFieldValue 设置字段值，自动转换 string 到目标类型
-func (h *ExcelConfigHandler) setFieldValue(field reflect.Value, value string) {
+// 转换失败时字段保持零值并返回错误
+func (h *ExcelConfigHandler) setFieldValue(field reflect.Value, value string) error {
+	// 指针字段：空单元格保持 nil 表示未配置，有值且转换成功时才分配
 	if field.Kind() == reflect.Ptr {
-		if field.IsNil() {
-			field.Set(reflect.New(field.Type().Elem()))
+		if strings.TrimSpace(value) == "" {
+			return nil
+		}
+		target := reflect.New(field.Type().Elem())
+		if err := h.setFieldValue(target.Elem(), value); err != nil {
+			return err
 		}
-		h.setFieldValue(field.Elem(), value)
-		return
+		field.Set(target)
+		return nil
 	}
 
 	if field.Kind() == reflect.Slice {
-		h.setSliceFieldValue(field, value)
-		return
+		return h.setSliceFieldValue(field, value, convert.DefaultSliceSeparator)
 	}
 
-	// 空字符串处理：对于数值类型设置为 0，字符串保持空，布尔���型为 false
+	// 空字符串处理：对于数值类型设置为 0，字符串保持空，布尔类型为 false
 	if value == "" {
 		switch field.Kind() {
 		case reflect.String:
@@ -287,7 +512,7 @@ func (h *ExcelConfigHandler) setFieldValue(field reflect.Value, value string) {
 		case reflect.Bool:
 			field.SetBool(false)
 		}
-		return
+		return nil
 	}
 
 	switch field.Kind() {
@@ -295,55 +520,45 @@ func (h *ExcelConfigHandler) setFieldValue(field reflect.Value, value string) {
 		field.SetString(value)
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		intVal, err := strconv.ParseInt(value, 10, 64)
+		intVal, err := convert.ParseInt(value)
 		if err != nil {
-			fmt.Printf("\033[31m[ERROR] 字段类型转换失败: 无法将 '%s' 转换为 int, 错误: %v\033[0m\n", value, err)
-			return
+			return err
 		}
 		field.SetInt(intVal)
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		uintVal, err := strconv.ParseUint(value, 10, 64)
+		uintVal, err := convert.ParseUint(value)
 		if err != nil {
-			fmt.Printf("\033[31m[ERROR] 字段类型转换失败: 无法将 '%s' 转换为 uint, 错误: %v\033[0m\n", value, err)
-			return
+			return err
 		}
 		field.SetUint(uintVal)
 
 	case reflect.Float32, reflect.Float64:
-		floatVal, err := strconv.ParseFloat(value, 64)
+		floatVal, err := convert.ParseFloat(value, 64)
 		if err != nil {
-			fmt.Printf("\033[31m[ERROR] 字段类型转换失败: 无法将 '%s' 转换为 float, 错误: %v\033[0m\n", value, err)
-			return
+			return err
 		}
 		field.SetFloat(floatVal)
 
 	case reflect.Bool:
-		boolVal, err := strconv.ParseBool(value)
+		boolVal, err := convert.ParseBool(value)
 		if err != nil {
-			// 尝试其他布尔值格式
-			lower := strings.ToLower(strings.TrimSpace(value))
-			switch lower {
-			case "true", "1", "yes", "on", "enabled":
-				field.SetBool(true)
-			case "false", "0", "no", "off", "disabled", "":
-				field.SetBool(false)
-			default:
-				fmt.Printf("\033[31m[ERROR] 字段类型转换失败: 无法将 '%s' 转换为 bool\033[0m\n", value)
-			}
-			return
+			return err
 		}
 		field.SetBool(boolVal)
 
 	default:
-		fmt.Printf("\033[31m[ERROR] 不支持的字段类型: %v\033[0m\n", field.Kind())
+		return fmt.Errorf("不支持的字段类型: %v", field.Kind())
 	}
+	return nil
 }
 
-func (h *ExcelConfigHandler) setSliceFieldValue(field reflect.Value, value string) {
+// setSliceFieldValue 设置切片字段值
+// 先尝试按 JSON 数组解析，失败时按 sep 拆分后逐元素转换，空字符串得到空切片
+func (h *ExcelConfigHandler) setSliceFieldValue(field reflect.Value, value, sep string) error {
 	if value == "" {
 		field.Set(reflect.MakeSlice(field.Type(), 0, 0))
-		return
+		return nil
 	}
 
 	trimmed := strings.TrimSpace(value)
@@ -351,13 +566,13 @@ func (h *ExcelConfigHandler) setSliceFieldValue(field reflect.Value, value strin
 		parsed := reflect.New(field.Type()).Interface()
 		if err := json.Unmarshal([]byte(trimmed), parsed); err == nil {
 			field.Set(reflect.ValueOf(parsed).Elem())
-			return
+			return nil
 		}
 	}
 
 	trimmed = strings.TrimPrefix(trimmed, "[")
 	trimmed = strings.TrimSuffix(trimmed, "]")
-	parts := strings.Split(trimmed, ",")
+	parts := strings.Split(trimmed, sep)
 	result := reflect.MakeSlice(field.Type(), 0, len(parts))
 	for _, part := range parts {
 		part = strings.TrimSpace(strings.Trim(part, `"'`))
@@ -366,45 +581,148 @@ func (h *ExcelConfigHandler) setSliceFieldValue(field reflect.Value, value strin
 		}
 
 		elem := reflect.New(field.Type().Elem()).Elem()
-		h.setFieldValue(elem, part)
+		if err := h.setFieldValue(elem, part); err != nil {
+			return err
+		}
 		result = reflect.Append(result, elem)
 	}
 	field.Set(result)
+	return nil
+}
+
+// parseColumnTypes 把类型行解析为 列名 -> 类型（小写）
+// 字段名或类型为空的列不会出现在结果中
+func parseColumnTypes(headers, types []string, firstColumn int) map[string]string {
+	columnTypes := make(map[string]string)
+	for i := firstColumn; i < len(headers) && i < len(types); i++ {
+		fieldName := strings.TrimSpace(headers[i])
+		typeStr := strings.ToLower(strings.TrimSpace(types[i]))
+		if fieldName == "" || typeStr == "" {
+			continue
+		}
+		columnTypes[fieldName] = typeStr
+	}
+	return columnTypes
+}
+
+// newConversionError 创建带行号与列名的类型转换错误，由调用方决定如何报告
+// rowIndex 为 0-based 索引，转换为 Excel 中的行号
+func newConversionError(configName string, rowIndex int, fieldName, value, typeStr string, err error) *dto.ConversionError {
+	return &dto.ConversionError{
+		ConfigName: configName,
+		RowIndex:   rowIndex + 1,
+		ColumnName: fieldName,
+		TargetType: typeStr,
+		RawValue:   value,
+		Err:        err,
+	}
+}
+
+// setTypedFieldValue 按类型行声明的类型转换单元格后设置字段值
+// 字符串、切片字段或无法识别的类型按字段类型转换
+func (h *ExcelConfigHandler) setTypedFieldValue(field reflect.Value, value, typeStr string) error {
+	if field.Kind() == reflect.Ptr {
+		if strings.TrimSpace(value) == "" {
+			return nil
+		}
+		target := reflect.New(field.Type().Elem())
+		if err := h.setTypedFieldValue(target.Elem(), value, typeStr); err != nil {
+			return err
+		}
+		field.Set(target)
+		return nil
+	}
+
+	if value == "" || !isScalarType(typeStr) || field.Kind() == reflect.String || field.Kind() == reflect.Slice {
+		return h.setFieldValue(field, value)
+	}
+
+	converted, err := h.convertValue(value, typeStr)
+	if err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(converted)
+	switch {
+	case field.Kind() == reflect.Interface:
+		field.Set(rv)
+	case isNumericKind(field.Kind()) && isNumericKind(rv.Kind()):
+		field.Set(rv.Convert(field.Type()))
+	case field.Kind() == reflect.Bool && rv.Kind() == reflect.Bool:
+		field.SetBool(rv.Bool())
+	default:
+		return fmt.Errorf("声明类型 %s 与字段类型 %s 不匹配", typeStr, field.Type())
+	}
+	return nil
+}
+
+// isScalarType 判断类型行声明的是否为可直接转换的标量类型
+func isScalarType(typeStr string) bool {
+	switch typeStr {
+	case "int", "int32", "long", "int64", "float", "float32", "double", "float64", "bool", "boolean":
+		return true
+	}
+	return false
+}
+
+// isNumericKind 判断是否为数值类型
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
 }
 
 // convertValue 根据类型字符串转换值
-func (h *ExcelConfigHandler) convertValue(value string, typeStr string) interface{} {
+// 支持的类型：int/int32、long/int64、float/float32、double/float64、bool/boolean，
+// 其余类型（string、i18n、json 等）保持字符串
+// 转换失败时返回原始字符串和错误
+func (h *ExcelConfigHandler) convertValue(value string, typeStr string) (interface{}, error) {
 	// 空值直接返回
 	if value == "" {
-		return value
+		return value, nil
 	}
 
-	switch typeStr {
+	trimmed := strings.TrimSpace(value)
+	switch strings.ToLower(strings.TrimSpace(typeStr)) {
 	case "int", "int32":
-		if intVal, err := strconv.Atoi(value); err == nil {
-			return intVal
+		intVal, err := convert.ParseInt(trimmed)
+		if err != nil {
+			return value, err
 		}
+		return int(intVal), nil
 	case "long", "int64":
-		if intVal, err := strconv.ParseInt(value, 10, 64); err == nil {
-			return intVal
+		intVal, err := convert.ParseInt(trimmed)
+		if err != nil {
+			return value, err
 		}
+		return intVal, nil
 	case "float", "float32":
-		if floatVal, err := strconv.ParseFloat(value, 32); err == nil {
-			return float32(floatVal)
+		floatVal, err := convert.ParseFloat(trimmed, 32)
+		if err != nil {
+			return value, err
 		}
+		return float32(floatVal), nil
 	case "double", "float64":
-		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
-			return floatVal
+		floatVal, err := convert.ParseFloat(trimmed, 64)
+		if err != nil {
+			return value, err
 		}
+		return floatVal, nil
 	case "bool", "boolean":
-		// 支持多种 bool 格式
-		lower := strings.ToLower(value)
-		return lower == "true" || lower == "1" || lower == "yes"
+		boolVal, err := convert.ParseBool(trimmed)
+		if err != nil {
+			return value, err
+		}
+		return boolVal, nil
 	case "json":
 		// JSON 类型保持字符串，由调用方自行解析
-		return value
+		return value, nil
 	}
 
 	// 默认返回字符串
-	return value
+	return value, nil
 }
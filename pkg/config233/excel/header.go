@@ -0,0 +1,212 @@
+package excel
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/neko233-com/config233-go/pkg/config233/internal/logging"
+)
+
+// HeaderMode Excel 表头模式
+type HeaderMode int
+
+const (
+	// HeaderModeAuto 自动识别（默认）
+	// 第 1 列存在 Client/type/Server 标记时按多行表头解析，否则回退为单行表头
+	HeaderModeAuto HeaderMode = iota
+	// HeaderModeMultiRow 标准多行表头（注释/中文/Client/type/Server）
+	// 优先按第 1 列的标记定位各行，没有标记时使用固定行号
+	HeaderModeMultiRow
+	// HeaderModeSingleRow 单行表头：第 1 行为字段名，第 2 行起为数据，不跳过任何列
+	HeaderModeSingleRow
+)
+
+// 标准多行表头的固定行结构（0-based 索引）：
+// 第 1 行 (index 0): 空行或注释
+// 第 2 行 (index 1): 中文字段名
+// 第 3 行 (index 2): Client 字段名
+// 第 4 行 (index 3): 类型 (type)
+// 第 5 行 (index 4): Server 字段名 (服务端使用这一行作为字段名)
+// 第 6 行 (index 5): 数据开始
+const (
+	defaultTypeRowIndex   = 3
+	defaultServerRowIndex = 4
+	defaultDataStartIndex = 5
+
+	// headerMarkerScanRows 查找表头标记时最多扫描的行数
+	headerMarkerScanRows = 10
+)
+
+// sheetLayout 工作表的表头布局
+type sheetLayout struct {
+	fieldRow    int // 字段名所在行
+	typeRow     int // 类型声明所在行，-1 表示没有类型行
+	dataStart   int // 数据开始行
+	firstColumn int // 数据开始列（多行表头的第 1 列为标记列，需要跳过）
+}
+
+// singleRowLayout 单行表头布局
+var singleRowLayout = sheetLayout{fieldRow: 0, typeRow: -1, dataStart: 1, firstColumn: 0}
+
+// defaultMultiRowLayout 固定行号的标准多行表头布局
+var defaultMultiRowLayout = sheetLayout{
+	fieldRow:    defaultServerRowIndex,
+	typeRow:     defaultTypeRowIndex,
+	dataStart:   defaultDataStartIndex,
+	firstColumn: 1,
+}
+
+// resolveLayout 根据表头模式确定工作表的布局
+func (h *ExcelConfigHandler) resolveLayout(rows [][]string) sheetLayout {
+	switch h.HeaderMode {
+	case HeaderModeSingleRow:
+		return singleRowLayout
+	case HeaderModeMultiRow:
+		if layout, ok := detectMultiRowLayout(rows); ok {
+			return layout
+		}
+		return defaultMultiRowLayout
+	default:
+		if layout, ok := detectMultiRowLayout(rows); ok {
+			return layout
+		}
+		return singleRowLayout
+	}
+}
+
+// detectMultiRowLayout 通过第 1 列的 Client/type/Server 标记（不区分大小写）识别多行表头
+// 字段名优先取 Server 行，没有 Server 行时取 Client 行
+// 没有 type 标记时，若 Server 行的上一行不是 Client 行，则视其为类型行
+func detectMultiRowLayout(rows [][]string) (sheetLayout, bool) {
+	clientRow, typeRow, serverRow := -1, -1, -1
+	for i := 0; i < len(rows) && i < headerMarkerScanRows; i++ {
+		if len(rows[i]) == 0 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(rows[i][0])) {
+		case "client":
+			if clientRow < 0 {
+				clientRow = i
+			}
+		case "type":
+			if typeRow < 0 {
+				typeRow = i
+			}
+		case "server":
+			if serverRow < 0 {
+				serverRow = i
+			}
+		}
+	}
+
+	fieldRow := serverRow
+	if fieldRow < 0 {
+		fieldRow = clientRow
+	}
+	if fieldRow < 0 {
+		return sheetLayout{}, false
+	}
+
+	if typeRow < 0 && serverRow > 0 && serverRow-1 != clientRow {
+		typeRow = serverRow - 1
+	}
+
+	dataStart := fieldRow
+	for _, row := range []int{clientRow, typeRow, serverRow} {
+		if row > dataStart {
+			dataStart = row
+		}
+	}
+
+	return sheetLayout{
+		fieldRow:    fieldRow,
+		typeRow:     typeRow,
+		dataStart:   dataStart + 1,
+		firstColumn: 1,
+	}, true
+}
+
+// ignoredColumnTypes 类型行中表示忽略该列的标记（不区分大小写）
+var ignoredColumnTypes = map[string]bool{"skip": true, "ignore": true}
+
+// fieldHeaders 返回字段名行，被忽略的列字段名置空，后续按空字段名统一跳过
+// KeepIgnoredColumns 为 false 时忽略字段名以 # 开头、或类型行标记为 skip/ignore 的列；
+// 设置了 ColumnNameNormalizer 时返回转换后的列名
+func (h *ExcelConfigHandler) fieldHeaders(rows [][]string, layout sheetLayout) []string {
+	return h.normalizeHeaders(h.visibleHeaders(rows, layout), layout.firstColumn)
+}
+
+// visibleHeaders 返回字段名行，被忽略的列字段名置空
+func (h *ExcelConfigHandler) visibleHeaders(rows [][]string, layout sheetLayout) []string {
+	headers := rows[layout.fieldRow]
+	if h.KeepIgnoredColumns {
+		return headers
+	}
+
+	var types []string
+	if layout.typeRow >= 0 && layout.typeRow < len(rows) {
+		types = rows[layout.typeRow]
+	}
+
+	var result []string
+	for i := layout.firstColumn; i < len(headers); i++ {
+		ignored := strings.HasPrefix(strings.TrimSpace(headers[i]), "#")
+		if !ignored && i < len(types) {
+			ignored = ignoredColumnTypes[strings.ToLower(strings.TrimSpace(types[i]))]
+		}
+		if !ignored {
+			continue
+		}
+		if result == nil {
+			// 复制一份再修改，不影响原始行
+			result = append([]string(nil), headers...)
+		}
+		result[i] = ""
+	}
+	if result == nil {
+		return headers
+	}
+	return result
+}
+
+// normalizeHeaders 用 ColumnNameNormalizer 转换从 firstColumn 开始的非空列名，返回新的切片，不修改原始行
+func (h *ExcelConfigHandler) normalizeHeaders(headers []string, firstColumn int) []string {
+	if h.ColumnNameNormalizer == nil {
+		return headers
+	}
+	normalized := append([]string(nil), headers...)
+	for i := firstColumn; i < len(normalized); i++ {
+		if name := strings.TrimSpace(normalized[i]); name != "" {
+			normalized[i] = strings.TrimSpace(h.ColumnNameNormalizer(name))
+		}
+	}
+	return normalized
+}
+
+// schemaVersionMarkers 第 1 列中表示 schema 版本号行的标记（不区分大小写），版本号写在同一行的第 2 列
+var schemaVersionMarkers = map[string]bool{"version": true, "__version": true}
+
+// schemaVersion 读取多行表头中声明的 schema 版本号，只查找数据开始行之前的行
+// 没有声明或单行表头时返回 0；版本号不是正整数时记录错误日志并返回 0
+func schemaVersion(configName string, rows [][]string, layout sheetLayout) int {
+	if layout.firstColumn == 0 {
+		return 0
+	}
+	for i := 0; i < len(rows) && i < layout.dataStart; i++ {
+		row := rows[i]
+		if len(row) == 0 || !schemaVersionMarkers[strings.ToLower(strings.TrimSpace(row[0]))] {
+			continue
+		}
+		raw := ""
+		if len(row) > 1 {
+			raw = strings.TrimSpace(row[1])
+		}
+		version, err := strconv.Atoi(raw)
+		if err != nil || version <= 0 {
+			logging.Get().Error(err, "Excel配置的版本号不是正整数，已忽略", "configName", configName, "row", i+1, "value", raw)
+			return 0
+		}
+		return version
+	}
+	return 0
+}
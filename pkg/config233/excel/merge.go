@@ -0,0 +1,61 @@
+package excel
+
+import (
+	"github.com/xuri/excelize/v2"
+)
+
+// fillMergedCells 将合并区域内的空单元格回填为左上角的值
+// excelize 的 GetRows 对合并单元格只在左上角返回值，其余位置为空
+// 同时处理横向和纵向合并；合并区从表头跨入数据行时只回填表头部分，避免把表头文字当作数据
+// 只回填 GetRows 已返回的行，不会为末尾的空行新建数据
+// 参数:
+//
+//	rows: GetRows 读取到的所有行，会被原地修改
+//	merges: 工作表的合并单元格
+//	dataStart: 数据开始行（0-based）
+//
+// 返回值:
+//
+//	[][]string: 回填后的所有行
+func fillMergedCells(rows [][]string, merges []excelize.MergeCell, dataStart int) [][]string {
+	for _, mc := range merges {
+		startCol, startRow, err := excelize.CellNameToCoordinates(mc.GetStartAxis())
+		if err != nil {
+			continue
+		}
+		endCol, endRow, err := excelize.CellNameToCoordinates(mc.GetEndAxis())
+		if err != nil {
+			continue
+		}
+		// 转为 0-based 索引
+		startCol, startRow, endCol, endRow = startCol-1, startRow-1, endCol-1, endRow-1
+
+		// 左上角在表头中时，数据行部分不回填
+		if startRow < dataStart && endRow >= dataStart {
+			endRow = dataStart - 1
+		}
+		if endRow >= len(rows) {
+			endRow = len(rows) - 1
+		}
+
+		value := mc.GetCellValue()
+		if startRow < len(rows) && startCol < len(rows[startRow]) {
+			value = rows[startRow][startCol]
+		}
+		if value == "" {
+			continue
+		}
+
+		for r := startRow; r <= endRow; r++ {
+			for len(rows[r]) <= endCol {
+				rows[r] = append(rows[r], "")
+			}
+			for c := startCol; c <= endCol; c++ {
+				if rows[r][c] == "" {
+					rows[r][c] = value
+				}
+			}
+		}
+	}
+	return rows
+}
@@ -0,0 +1,134 @@
+package excel
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/extrame/xls"
+)
+
+// oleSignature 旧版 .xls（OLE2 复合文档）文件头
+var oleSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// isXlsFile 判断文件扩展名是否为旧版 .xls
+func isXlsFile(configFileFullPath string) bool {
+	return strings.EqualFold(filepath.Ext(configFileFullPath), ".xls")
+}
+
+// isXlsContent 根据文件头判断内存内容是否为旧版 .xls
+func isXlsContent(data []byte) bool {
+	return bytes.HasPrefix(data, oleSignature)
+}
+
+// readXlsRows 通过 extrame/xls 读取旧版 .xls 中指定工作表的所有行
+// 返回的行与 excelize.GetRows 一致：去掉每行末尾的空单元格以及末尾的空行
+// extrame/xls 遇到损坏或不支持的文件时可能 panic，这里统一转为错误返回
+// 注意: .xls 中的合并单元格不会回填
+func readXlsRows(r io.ReadSeeker, source, sheetName string) (rows [][]string, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			rows = nil
+			err = fmt.Errorf("解析 .xls 文件失败，请另存为 xlsx (%s): %v", source, p)
+		}
+	}()
+
+	wb, err := xls.OpenReader(r, "utf-8")
+	if err != nil {
+		return nil, fmt.Errorf("解析 .xls 文件失败，请另存为 xlsx (%s): %w", source, err)
+	}
+	if wb == nil {
+		return nil, fmt.Errorf("解析 .xls 文件失败，请另存为 xlsx (%s): 找不到 Workbook 数据流", source)
+	}
+
+	sheet, err := resolveXlsSheet(wb, sheetName)
+	if err != nil {
+		return nil, fmt.Errorf("读取 Excel 文件失败 (%s): %w", source, err)
+	}
+	if sheet == nil {
+		return nil, nil
+	}
+	return xlsSheetRows(sheet), nil
+}
+
+// readXlsAllSheets 读取旧版 .xls 中所有可见工作表的行，按工作表顺序返回
+func readXlsAllSheets(r io.ReadSeeker, source string) (names []string, sheets [][][]string, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			names, sheets = nil, nil
+			err = fmt.Errorf("解析 .xls 文件失败，请另存为 xlsx (%s): %v", source, p)
+		}
+	}()
+
+	wb, err := xls.OpenReader(r, "utf-8")
+	if err != nil {
+		return nil, nil, fmt.Errorf("解析 .xls 文件失败，请另存为 xlsx (%s): %w", source, err)
+	}
+	if wb == nil {
+		return nil, nil, fmt.Errorf("解析 .xls 文件失败，请另存为 xlsx (%s): 找不到 Workbook 数据流", source)
+	}
+
+	for i := 0; i < wb.NumSheets(); i++ {
+		sheet := wb.GetSheet(i)
+		if sheet.Visibility != xls.WorkSheetVisible {
+			continue
+		}
+		names = append(names, sheet.Name)
+		sheets = append(sheets, xlsSheetRows(sheet))
+	}
+	return names, sheets, nil
+}
+
+// xlsSheetRows 读取 .xls 工作表的所有行
+// 返回的行与 excelize.GetRows 一致：去掉每行末尾的空单元格以及末尾的空行
+func xlsSheetRows(sheet *xls.WorkSheet) [][]string {
+	rows := make([][]string, 0, int(sheet.MaxRow)+1)
+	for i := 0; i <= int(sheet.MaxRow); i++ {
+		row := sheet.Row(i)
+		if row == nil {
+			rows = append(rows, nil)
+			continue
+		}
+		cells := make([]string, 0, row.LastCol()+1)
+		for j := 0; j <= row.LastCol(); j++ {
+			cells = append(cells, row.Col(j))
+		}
+		for len(cells) > 0 && cells[len(cells)-1] == "" {
+			cells = cells[:len(cells)-1]
+		}
+		rows = append(rows, cells)
+	}
+	for len(rows) > 0 && len(rows[len(rows)-1]) == 0 {
+		rows = rows[:len(rows)-1]
+	}
+	return rows
+}
+
+// resolveXlsSheet 与 resolveSheetName 规则一致：指定了名称时校验其存在，未指定时返回第一个可见的工作表
+func resolveXlsSheet(wb *xls.WorkBook, sheetName string) (*xls.WorkSheet, error) {
+	names := make([]string, 0, wb.NumSheets())
+	for i := 0; i < wb.NumSheets(); i++ {
+		names = append(names, wb.GetSheet(i).Name)
+	}
+
+	if sheetName != "" {
+		for i, name := range names {
+			if name == sheetName {
+				return wb.GetSheet(i), nil
+			}
+		}
+		return nil, fmt.Errorf("工作表 %q 不存在，可用工作表: %v", sheetName, names)
+	}
+
+	for i := 0; i < wb.NumSheets(); i++ {
+		if sheet := wb.GetSheet(i); sheet.Visibility == xls.WorkSheetVisible {
+			return sheet, nil
+		}
+	}
+	if wb.NumSheets() > 0 {
+		return wb.GetSheet(0), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,241 @@
+package config233
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// exportSliceSeparator 导出标量切片时的默认分隔符，与 Excel 读取时的默认分隔符一致
+const exportSliceSeparator = ","
+
+// SetIsOpenWriteExcelFileToSeeMemoryConfig 设置是否开启导出内存配置到 Excel 文件的功能
+// 开启后，每次加载或重载配置后，会将内存中的配置导出为 <配置名>.xlsx，方便与原表比对类型转换结果
+// 导出目录与 JSON 导出相同，通过 SetLoadDoneWriteConfigFileDir 设置，不存在时自动创建
+func (cm *ConfigManager233) SetIsOpenWriteExcelFileToSeeMemoryConfig(isOpen bool) *ConfigManager233 {
+	cm.isOpenWriteExcelFile = isOpen
+	return cm
+}
+
+// ExportConfigToExcel 将指定配置导出为 Excel 文件
+// 第 1 行为列名，之后每行一条配置。嵌套结构体展开为 "父字段.子字段" 列，
+// 标量切片按逗号（或字段的 config233_sep 标签）拼接，结构体切片与 map 写为 JSON
+// 参数:
+//
+//	configName: 配置名称，同时作为导出的文件名
+//	data: 配置列表，通常为 []interface{}
+func (cm *ConfigManager233) ExportConfigToExcel(configName string, data interface{}) {
+	if !cm.isOpenWriteExcelFile || cm.loadDoneWriteConfigFileDir == "" {
+		return
+	}
+
+	// 确保目录存在
+	if err := os.MkdirAll(cm.loadDoneWriteConfigFileDir, 0755); err != nil {
+		getLogger().Error(err, "创建导出目录失败", "dir", cm.loadDoneWriteConfigFileDir)
+		return
+	}
+
+	filePath := filepath.Join(cm.loadDoneWriteConfigFileDir, configName+".xlsx")
+	if err := writeConfigExcel(filePath, data); err != nil {
+		getLogger().Error(err, "写入配置文件失败", "path", filePath)
+		return
+	}
+
+	getLogger().Info("已导出配置到文件", "configName", configName, "path", filePath)
+}
+
+// flatCell 扁平化后的一个单元格
+type flatCell struct {
+	column string
+	value  interface{}
+}
+
+// writeConfigExcel 将配置列表扁平化后写入 Excel 文件的第一个工作表
+func writeConfigExcel(filePath string, data interface{}) error {
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return fmt.Errorf("不支持导出的配置数据类型: %T", data)
+	}
+
+	// 按列首次出现的顺序确定表头
+	columns := make([]string, 0)
+	columnIndex := make(map[string]int)
+	rows := make([][]flatCell, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		cells := flattenConfigRow(rv.Index(i))
+		for _, cell := range cells {
+			if _, ok := columnIndex[cell.column]; !ok {
+				columnIndex[cell.column] = len(columns)
+				columns = append(columns, cell.column)
+			}
+		}
+		rows = append(rows, cells)
+	}
+
+	f := excelize.NewFile()
+	defer f.Close()
+	sheet := f.GetSheetName(0)
+
+	header := make([]interface{}, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+	if err := f.SetSheetRow(sheet, "A1", &header); err != nil {
+		return fmt.Errorf("写入表头失败: %w", err)
+	}
+	for i, cells := range rows {
+		values := make([]interface{}, len(columns))
+		for _, cell := range cells {
+			values[columnIndex[cell.column]] = cell.value
+		}
+		axis, _ := excelize.CoordinatesToCellName(1, i+2)
+		if err := f.SetSheetRow(sheet, axis, &values); err != nil {
+			return fmt.Errorf("写入第 %d 行失败: %w", i+2, err)
+		}
+	}
+
+	return f.SaveAs(filePath)
+}
+
+// flattenConfigRow 扁平化一条配置：结构体按字段展开，map 按 key 排序后展开（未注册类型的配置）
+func flattenConfigRow(v reflect.Value) []flatCell {
+	v = indirectValue(v)
+	cells := make([]flatCell, 0)
+	if v.IsValid() && v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String {
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			flattenValue(key.String(), v.MapIndex(key), exportSliceSeparator, &cells)
+		}
+		return cells
+	}
+	flattenValue("", v, exportSliceSeparator, &cells)
+	return cells
+}
+
+// flattenValue 将值展开为若干单元格
+// 参数:
+//
+//	column: 当前列名，嵌套结构体的字段以 "." 拼接
+//	v: 字段值
+//	sep: 标量切片的拼接分隔符
+//	cells: 输出的单元格列表
+func flattenValue(column string, v reflect.Value, sep string, cells *[]flatCell) {
+	// nil 的结构体指针仍展开出完整的列，保证各行列一致
+	if v.IsValid() && v.Kind() == reflect.Ptr && v.IsNil() && v.Type().Elem().Kind() == reflect.Struct {
+		start := len(*cells)
+		flattenValue(column, reflect.New(v.Type().Elem()).Elem(), sep, cells)
+		for i := start; i < len(*cells); i++ {
+			(*cells)[i].value = nil
+		}
+		return
+	}
+
+	v = indirectValue(v)
+	if !v.IsValid() {
+		*cells = append(*cells, flatCell{column: column})
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if _, ok := v.Interface().(fmt.Stringer); ok {
+			*cells = append(*cells, flatCell{column: column, value: fmt.Sprint(v.Interface())})
+			return
+		}
+		flattenStruct(column, v, cells)
+	case reflect.Slice, reflect.Array:
+		*cells = append(*cells, flatCell{column: column, value: formatSliceCell(v, sep)})
+	case reflect.Map:
+		*cells = append(*cells, flatCell{column: column, value: formatJSONCell(v)})
+	default:
+		*cells = append(*cells, flatCell{column: column, value: v.Interface()})
+	}
+}
+
+// flattenStruct 按导出字段展开结构体，列名优先使用 json tag，json:"-" 的字段跳过
+// 未指定 json 名的匿名嵌入结构体与 encoding/json 一致，字段提升到当前层级
+func flattenStruct(prefix string, v reflect.Value, cells *[]flatCell) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if name == "" {
+			name = field.Name
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if field.Anonymous && fieldType.Kind() == reflect.Struct {
+				name = ""
+			}
+		}
+
+		column := name
+		if prefix != "" && name != "" {
+			column = prefix + "." + name
+		} else if name == "" {
+			column = prefix
+		}
+
+		sep := exportSliceSeparator
+		if s := field.Tag.Get("config233_sep"); s != "" {
+			sep = s
+		}
+		flattenValue(column, v.Field(i), sep, cells)
+	}
+}
+
+// formatSliceCell 标量切片按 sep 拼接，元素为结构体、切片或 map 时整体写为 JSON
+func formatSliceCell(v reflect.Value, sep string) string {
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+		return formatJSONCell(v)
+	}
+	parts := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem := indirectValue(v.Index(i))
+		if !elem.IsValid() {
+			parts = append(parts, "")
+			continue
+		}
+		switch elem.Kind() {
+		case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+			return formatJSONCell(v)
+		}
+		parts = append(parts, fmt.Sprint(elem.Interface()))
+	}
+	return strings.Join(parts, sep)
+}
+
+// formatJSONCell 将值序列化为 JSON 字符串，失败时退回 fmt 格式
+func formatJSONCell(v reflect.Value) string {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(data)
+}
+
+// indirectValue 解开指针与 interface，nil 时返回无效值
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
@@ -0,0 +1,131 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
+)
+
+// fieldDefault 结构体字段上通过 `config233_default:"100"` 标签声明的默认值
+// 字段转换后为零值（单元格为空、JSON 缺省或填写了零值）时使用默认值填充；
+// 支持字符串、布尔、整型、浮点、time.Duration 及其指针，标签值的写法与单元格一致（如 "yes"、"1,000"、"5s"）
+// 非指针字段填写 0 / false 同样会被默认值替换，需要区分"填了 0"与"没填"时使用指针字段（只有 nil 时填充）
+type fieldDefault struct {
+	index []int         // 字段在结构体中的索引路径（支持嵌入字段）
+	value reflect.Value // 解析后的默认值，类型为字段类型（指针字段为其元素类型）
+}
+
+// fieldDefaultCache 结构体类型 -> 字段默认值
+var fieldDefaultCache sync.Map
+
+// hasFieldDefault 判断字段是否声明了 config233_default 标签
+func hasFieldDefault(field reflect.StructField) bool {
+	_, ok := field.Tag.Lookup("config233_default")
+	return ok
+}
+
+// fieldDefaultsOf 解析结构体类型上声明的字段默认值（按类型缓存）
+// 字段类型不支持或标签值无法解析时记录错误日志并忽略
+func fieldDefaultsOf(t reflect.Type) []fieldDefault {
+	if cached, ok := fieldDefaultCache.Load(t); ok {
+		return cached.([]fieldDefault)
+	}
+
+	var defaults []fieldDefault
+	for _, field := range reflect.VisibleFields(t) {
+		if field.Anonymous || !field.IsExported() || !hasFieldDefault(field) {
+			continue
+		}
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		value, err := parseDefaultValue(fieldType, field.Tag.Get("config233_default"))
+		if err != nil {
+			getLogger().Error(err, "默认值标签无法解析，已忽略", "type", t.String(), "field", field.Name,
+				"value", field.Tag.Get("config233_default"))
+			continue
+		}
+		defaults = append(defaults, fieldDefault{index: field.Index, value: value})
+	}
+
+	fieldDefaultCache.Store(t, defaults)
+	return defaults
+}
+
+// parseDefaultValue 按字段类型解析默认值标签
+func parseDefaultValue(t reflect.Type, raw string) (reflect.Value, error) {
+	value := reflect.New(t).Elem()
+	switch {
+	case t == reflect.TypeOf(time.Duration(0)):
+		d, err := convert.ParseDuration(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetInt(int64(d))
+	case t.Kind() == reflect.String:
+		value.SetString(raw)
+	case t.Kind() == reflect.Bool:
+		b, err := convert.ParseBool(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetBool(b)
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		n, err := convert.ParseInt(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if value.OverflowInt(n) {
+			return reflect.Value{}, fmt.Errorf("默认值 %s 超出 %s 的范围", raw, t)
+		}
+		value.SetInt(n)
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64:
+		n, err := convert.ParseUint(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if value.OverflowUint(n) {
+			return reflect.Value{}, fmt.Errorf("默认值 %s 超出 %s 的范围", raw, t)
+		}
+		value.SetUint(n)
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		f, err := convert.ParseFloat(strings.TrimSpace(raw), t.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetFloat(f)
+	default:
+		return reflect.Value{}, fmt.Errorf("字段类型 %s 不支持默认值，只支持字符串、布尔、数值及其指针", t)
+	}
+	return value, nil
+}
+
+// applyFieldDefaults 为转换后仍为零值的字段填充默认值，指针字段为 nil 时分配并赋值
+// 在 AfterLoad 之前调用，AfterLoad 与 Check 看到的是填充后的值
+// 参数:
+//
+//	instance: 配置结构体（可设置的 reflect.Value）
+func applyFieldDefaults(instance reflect.Value) {
+	for _, d := range fieldDefaultsOf(instance.Type()) {
+		fieldValue := convert.FieldByIndexAlloc(instance, d.index)
+		if !fieldValue.IsValid() || !fieldValue.CanSet() {
+			continue
+		}
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				ptr := reflect.New(fieldValue.Type().Elem())
+				ptr.Elem().Set(d.value)
+				fieldValue.Set(ptr)
+			}
+			continue
+		}
+		if fieldValue.IsZero() {
+			fieldValue.Set(d.value)
+		}
+	}
+}
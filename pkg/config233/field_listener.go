@@ -8,12 +8,13 @@ import "reflect"
 type FieldUpdateListener struct {
 	obj       interface{}   // 要更新的对象
 	field     reflect.Value // 要更新的字段反射值
+	fieldName string        // 字段名，用于日志
 	fieldType reflect.Type  // 字段对应的配置类型
 }
 
 // OnConfigDataChange 配置数据变更时调用
 // 当配置数据发生变化时，此方法会被触发来更新对应的字段
-// 它会重新构建 UID 到配置对象的映射，并更新对象的字段
+// 它会重新构建 UID 到配置对象的映射，并按字段声明的 map 类型更新对象的字段
 // 参数:
 //
 //	typ: 发生变化的配置数据类型
@@ -24,23 +25,5 @@ func (l *FieldUpdateListener) OnConfigDataChange(typ reflect.Type, dataList []in
 	}
 
 	// 更新字段
-	uidMap := make(map[interface{}]interface{})
-	for _, item := range dataList {
-		val := reflect.ValueOf(item)
-		if val.Kind() == reflect.Ptr {
-			val = val.Elem()
-		}
-
-		// 查找 UID 字段
-		for i := 0; i < val.NumField(); i++ {
-			field := val.Type().Field(i)
-			if field.Tag.Get("config233") == "uid" {
-				fieldVal := val.Field(i)
-				uidMap[fieldVal.Interface()] = item
-				break
-			}
-		}
-	}
-
-	l.field.Set(reflect.ValueOf(uidMap))
+	l.field.Set(buildInjectMap(l.fieldName, l.field.Type(), buildUIDMap(dataList)))
 }
@@ -0,0 +1,199 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// fieldRule 结构体字段上通过标签声明的校验规则
+//
+// 支持的标签:
+//   - `config233_required:"true"` - 必填，字段为零值（空字符串、nil、空切片/map、数字 0、false）时报错
+//   - `config233_allow_zero:"true"` - 配合 required 使用，数字 0 与 false 视为合法值，只校验字符串、切片等是否为空
+//   - `config233_min:"1"` / `config233_max:"100"` - 数值字段（整型、浮点及其指针）的闭区间范围，可只设置一端
+//
+// 声明了 config233_default 的字段转换后总会被填充，required 对其不生效
+type fieldRule struct {
+	index     []int   // 字段在结构体中的索引路径（支持嵌入字段）
+	name      string  // 字段名
+	required  bool    // 是否必填
+	allowZero bool    // 必填时是否允许数字 0 / false
+	hasMin    bool    // 是否设置了最小值
+	min       float64 // 最小值（含）
+	hasMax    bool    // 是否设置了最大值
+	max       float64 // 最大值（含）
+}
+
+// fieldRuleCache 结构体类型 -> 字段校验规则
+var fieldRuleCache sync.Map
+
+// fieldRulesOf 解析结构体类型上声明的字段校验规则（按类型缓存）
+func fieldRulesOf(t reflect.Type) []fieldRule {
+	if cached, ok := fieldRuleCache.Load(t); ok {
+		return cached.([]fieldRule)
+	}
+
+	var rules []fieldRule
+	for _, field := range reflect.VisibleFields(t) {
+		if field.Anonymous || !field.IsExported() {
+			continue
+		}
+		rule := fieldRule{
+			index:     field.Index,
+			name:      field.Name,
+			required:  field.Tag.Get("config233_required") == "true" && !hasFieldDefault(field),
+			allowZero: field.Tag.Get("config233_allow_zero") == "true",
+		}
+		rule.hasMin, rule.min = parseRangeTag(t, field, "config233_min")
+		rule.hasMax, rule.max = parseRangeTag(t, field, "config233_max")
+		if rule.required || rule.hasMin || rule.hasMax {
+			rules = append(rules, rule)
+		}
+	}
+
+	fieldRuleCache.Store(t, rules)
+	return rules
+}
+
+// parseRangeTag 解析数值范围标签，标签不合法或字段不是数值类型时记录错误日志并忽略
+func parseRangeTag(t reflect.Type, field reflect.StructField, tagName string) (bool, float64) {
+	tag, ok := field.Tag.Lookup(tagName)
+	if !ok {
+		return false, 0
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if !isNumberKind(fieldType.Kind()) {
+		getLogger().Error(fmt.Errorf("字段 %s 不是数值类型", field.Name), "范围标签只支持数值字段，已忽略", "type", t.String(), "tag", tagName)
+		return false, 0
+	}
+	bound, err := strconv.ParseFloat(strings.TrimSpace(tag), 64)
+	if err != nil {
+		getLogger().Error(err, "范围标签的值不是合法数字，已忽略", "type", t.String(), "field", field.Name, "tag", tagName, "value", tag)
+		return false, 0
+	}
+	return true, bound
+}
+
+// isNumberKind 判断是否为整型或浮点类型
+func isNumberKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// check 校验单个字段值，通过时返回 nil
+func (r fieldRule) check(value reflect.Value) error {
+	if r.required && isMissingValue(value, r.allowZero) {
+		return fmt.Errorf("必填字段 %s 为空", r.name)
+	}
+	if !r.hasMin && !r.hasMax {
+		return nil
+	}
+
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			// 未填写的指针字段由 required 负责
+			return nil
+		}
+		value = value.Elem()
+	}
+	var number float64
+	var text string
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, text = float64(value.Int()), strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, text = float64(value.Uint()), strconv.FormatUint(value.Uint(), 10)
+	default:
+		number = value.Float()
+		text = strconv.FormatFloat(number, 'g', -1, 64)
+	}
+
+	if r.hasMin && number < r.min {
+		return fmt.Errorf("字段 %s 的值 %s 小于最小值 %s", r.name, text, strconv.FormatFloat(r.min, 'g', -1, 64))
+	}
+	if r.hasMax && number > r.max {
+		return fmt.Errorf("字段 %s 的值 %s 大于最大值 %s", r.name, text, strconv.FormatFloat(r.max, 'g', -1, 64))
+	}
+	return nil
+}
+
+// isMissingValue 判断必填字段是否视为未填写
+// allowZero 为 true 时数字 0 与 false 视为已填写；指针非 nil 即视为已填写
+func isMissingValue(value reflect.Value, allowZero bool) bool {
+	switch value.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Bool:
+		return !allowZero && !value.Bool()
+	default:
+		if isNumberKind(value.Kind()) {
+			return !allowZero && value.IsZero()
+		}
+		return value.IsZero()
+	}
+}
+
+// checkFieldRules 按字段标签声明的规则校验配置项
+// 参数:
+//
+//	configName: 配置名称
+//	configMap: ID -> 配置 映射，用于在错误中标注配置项 ID
+//	slice: 配置列表
+//
+// 返回值:
+//
+//	ConfigValidationErrors: 所有不满足规则的字段（每个字段一条），全部通过时返回 nil
+func checkFieldRules(configName string, configMap map[string]interface{}, slice []interface{}) ConfigValidationErrors {
+	var idOf map[interface{}]string
+	var errs ConfigValidationErrors
+	for i, item := range slice {
+		v := reflect.ValueOf(item)
+		if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		rules := fieldRulesOf(v.Elem().Type())
+		if len(rules) == 0 {
+			continue
+		}
+
+		if idOf == nil {
+			idOf = make(map[interface{}]string, len(configMap))
+			for id, mapped := range configMap {
+				if reflect.ValueOf(mapped).Kind() == reflect.Ptr {
+					idOf[mapped] = id
+				}
+			}
+		}
+		id, found := idOf[item]
+		if !found {
+			id = fmt.Sprintf("#%d", i)
+		}
+
+		for _, rule := range rules {
+			fieldValue, err := v.Elem().FieldByIndexErr(rule.index)
+			if err != nil {
+				// 嵌入的结构体指针为 nil，内部字段无法取值
+				continue
+			}
+			if err := rule.check(fieldValue); err != nil {
+				errs = append(errs, &ConfigValidationError{ConfigName: configName, Id: id, Field: rule.name, Err: err})
+			}
+		}
+	}
+	return errs
+}
@@ -9,6 +9,9 @@ func isHiddenDir(info os.FileInfo) bool {
 	if info == nil || !info.IsDir() {
 		return false
 	}
-	name := info.Name()
+	return isHiddenDirName(info.Name())
+}
+
+func isHiddenDirName(name string) bool {
 	return name != "." && name != ".." && strings.HasPrefix(name, ".")
 }
@@ -1,9 +1,11 @@
 package config233
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -12,35 +14,76 @@ import (
 )
 
 const (
-	// ReloadBatchDelay 批量重载延迟时间（收集变更文件）
+	// ReloadBatchDelay 默认批量重载延迟时间（收集变更文件），可通过 SetReloadBatchDelay 修改
 	ReloadBatchDelay = 500 * time.Millisecond
 
-	// ReloadCooldown 重载冷却时间（避免频繁重载）
+	// ReloadCooldown 默认重载冷却时间（避免频繁重载），可通过 SetReloadCooldown 修改
 	ReloadCooldown = 300 * time.Millisecond
+
+	// ReloadMaxRetries 重载失败后的最大重试次数（文件可能仍在写入中）
+	ReloadMaxRetries = 3
+
+	// FileStableCheckInterval 热重载前检测文件是否写完时两次 stat 的默认间隔，可通过 SetFileStableCheck 修改
+	FileStableCheckInterval = 50 * time.Millisecond
+
+	// FileStableMaxChecks 文件仍在变化时默认最多再检测的次数，超过后本轮跳过该文件并按重载失败重试
+	FileStableMaxChecks = 5
 )
 
 // hotReloadState 热重载状态管理
 type hotReloadState struct {
 	mutex          sync.Mutex
-	pendingReloads map[string]bool // 待重载的配置名集合
-	timer          *time.Timer     // 批量重载定时器
-	lastReloadTime time.Time       // 上次重载时间
-	isReloading    bool            // 是否正在重载
+	pendingReloads map[string]bool   // 待重载的配置名集合
+	pendingEvents  int               // 合并到待重载列表中的原始文件变更事件数
+	retryCounts    map[string]int    // 重载失败的配置名 -> 已重试次数
+	timer          *time.Timer       // 批量重载定时器
+	lastReloadTime time.Time         // 上次重载时间
+	isReloading    bool              // 是否正在重载
+	stopped        bool              // 是否已停止，停止后不再接受和触发重载
+	batchDelay     time.Duration     // 批量重载延迟时间
+	cooldown       time.Duration     // 重载冷却时间
+	manager        *ConfigManager233 // 执行重载的管理器，为 nil 时使用全局单例
 }
 
 func newHotReloadState() *hotReloadState {
 	return &hotReloadState{
 		pendingReloads: make(map[string]bool),
+		retryCounts:    make(map[string]int),
 		lastReloadTime: time.Time{},
+		batchDelay:     ReloadBatchDelay,
+		cooldown:       ReloadCooldown,
 	}
 }
 
-// addPendingReload 添加待重载的配置
+// setTimings 更新批量延迟与冷却时间，对之后收到的变更生效
+func (hrs *hotReloadState) setTimings(batchDelay, cooldown time.Duration) {
+	hrs.mutex.Lock()
+	defer hrs.mutex.Unlock()
+	hrs.batchDelay = batchDelay
+	hrs.cooldown = cooldown
+}
+
+// addPendingReload 文件变更时添加待重载的配置，计入本批次的文件变更事件数
 func (hrs *hotReloadState) addPendingReload(configName string) {
+	hrs.enqueueReload(configName, 1)
+}
+
+// addRetryReload 重载失败后重新加入待重载列表，不计入文件变更事件数
+func (hrs *hotReloadState) addRetryReload(configName string) {
+	hrs.enqueueReload(configName, 0)
+}
+
+// enqueueReload 添加待重载的配置并重新开始批量延迟计时
+func (hrs *hotReloadState) enqueueReload(configName string, fileEvents int) {
 	hrs.mutex.Lock()
 	defer hrs.mutex.Unlock()
 
+	if hrs.stopped {
+		return
+	}
+
 	hrs.pendingReloads[configName] = true
+	hrs.pendingEvents += fileEvents
 
 	// 如果定时器已存在，停止它
 	if hrs.timer != nil {
@@ -48,23 +91,27 @@ func (hrs *hotReloadState) addPendingReload(configName string) {
 	}
 
 	// 创建新的批量重载定时器
-	hrs.timer = time.AfterFunc(ReloadBatchDelay, func() {
+	hrs.timer = time.AfterFunc(hrs.batchDelay, func() {
 		hrs.triggerBatchReload()
 	})
 
-	getLogger().Info("添加待重载配置", "configName", configName, "pendingCount", len(hrs.pendingReloads))
-	fmt.Printf("[config233] 添加待重载配置: configName=%s, pendingCount=%d\n", configName, len(hrs.pendingReloads))
+	getLogger().Info("添加待重载配置", "configName", configName, "pendingCount", len(hrs.pendingReloads), "fileEvents", hrs.pendingEvents)
 }
 
 // triggerBatchReload 触发批量重载
 func (hrs *hotReloadState) triggerBatchReload() {
 	hrs.mutex.Lock()
 
+	if hrs.stopped {
+		hrs.mutex.Unlock()
+		return
+	}
+
 	// 检查冷却时间
 	timeSinceLastReload := time.Since(hrs.lastReloadTime)
-	if timeSinceLastReload < ReloadCooldown {
+	if timeSinceLastReload < hrs.cooldown {
 		// 还在冷却期，延迟重载
-		remainingCooldown := ReloadCooldown - timeSinceLastReload
+		remainingCooldown := hrs.cooldown - timeSinceLastReload
 		getLogger().Info("热重载冷却中，延迟重载", "remainingMs", remainingCooldown.Milliseconds())
 
 		hrs.timer = time.AfterFunc(remainingCooldown, func() {
@@ -90,126 +137,592 @@ func (hrs *hotReloadState) triggerBatchReload() {
 		configsToReload = append(configsToReload, configName)
 	}
 
+	// 只有重试的配置时本批次没有文件变更事件
+	info := reloadBatchInfo{trigger: ReloadTriggerFileWatch, fileEvents: hrs.pendingEvents}
+	if info.fileEvents == 0 {
+		info.trigger = ReloadTriggerRetry
+	}
+
 	// 清空待重载列表
 	hrs.pendingReloads = make(map[string]bool)
+	hrs.pendingEvents = 0
 	hrs.isReloading = true
 
 	hrs.mutex.Unlock()
 
 	// 执行批量重载
+	var failedConfigs []string
 	if len(configsToReload) > 0 {
-		getLogger().Info("开始批量热重载", "configCount", len(configsToReload), "configs", configsToReload)
-		fmt.Printf("[config233] 开始批量热重载: configCount=%d, configs=%v\n", len(configsToReload), configsToReload)
+		getLogger().Info("开始批量热重载", "configCount", len(configsToReload), "fileEvents", info.fileEvents, "trigger", info.trigger, "configs", configsToReload)
 		startTime := time.Now()
 
 		// 调用实际的重载逻辑
-		manager := GetInstance()
-		manager.batchReloadConfigs(configsToReload)
+		manager := hrs.manager
+		if manager == nil {
+			manager = GetInstance()
+		}
+		failedConfigs = manager.reloadBatch(configsToReload, info)
 
 		elapsed := time.Since(startTime)
 		getLogger().Info("批量热重载完成", "configCount", len(configsToReload), "elapsedMs", elapsed.Milliseconds())
-		fmt.Printf("[config233] 批量热重载完成: configCount=%d, elapsedMs=%d\n", len(configsToReload), elapsed.Milliseconds())
 	}
 
 	// 更新重载状态
 	hrs.mutex.Lock()
 	hrs.lastReloadTime = time.Now()
 	hrs.isReloading = false
+	retryConfigs := hrs.updateRetryCounts(configsToReload, failedConfigs)
 	hrs.mutex.Unlock()
+
+	// 失败的配置可能是文件尚未写完，延迟后重试
+	for _, configName := range retryConfigs {
+		hrs.addRetryReload(configName)
+	}
 }
 
-// batchReloadConfigs 批量重载指定的配置文件
-func (cm *ConfigManager233) batchReloadConfigs(configNames []string) {
-	if len(configNames) == 0 {
-		return
+// updateRetryCounts 根据本次重载结果更新重试计数（调用方需持有锁）
+// 返回值:
+//
+//	[]string: 需要再次重试的配置名
+func (hrs *hotReloadState) updateRetryCounts(reloaded []string, failed []string) []string {
+	failedSet := make(map[string]bool, len(failed))
+	for _, configName := range failed {
+		failedSet[configName] = true
+	}
+	for _, configName := range reloaded {
+		if !failedSet[configName] {
+			delete(hrs.retryCounts, configName)
+		}
+	}
+
+	retryConfigs := make([]string, 0, len(failed))
+	for _, configName := range failed {
+		if hrs.retryCounts[configName] >= ReloadMaxRetries {
+			delete(hrs.retryCounts, configName)
+			getLogger().Error(nil, "重载配置多次失败，放弃重试", "configName", configName, "retries", ReloadMaxRetries)
+			continue
+		}
+		hrs.retryCounts[configName]++
+		retryConfigs = append(retryConfigs, configName)
+	}
+	return retryConfigs
+}
+
+// stop 停止热重载，取消定时器并清空待重载列表
+// 已经开始执行的批量重载会继续完成，但不会再安排新的重载
+func (hrs *hotReloadState) stop() {
+	hrs.mutex.Lock()
+	defer hrs.mutex.Unlock()
+
+	hrs.stopped = true
+	if hrs.timer != nil {
+		hrs.timer.Stop()
+		hrs.timer = nil
+	}
+	hrs.pendingReloads = make(map[string]bool)
+	hrs.pendingEvents = 0
+	hrs.retryCounts = make(map[string]int)
+}
+
+// ReloadConfig 重载单个配置
+// 只重新读取该配置对应的文件，不影响其他配置，完成后触发 OnConfigLoadComplete
+// 参数:
+//
+//	name: 配置名称（文件名去掉扩展名）
+//
+// 返回值:
+//
+//	error: 找不到配置文件或重载失败时返回错误，失败时保留旧数据
+func (cm *ConfigManager233) ReloadConfig(name string) error {
+	return cm.ReloadConfigs([]string{name})
+}
+
+// ReloadConfigs 重载指定的多个配置
+// 与热重载共用同一套批量重载逻辑，成功的配置合并为一次 OnConfigLoadComplete 回调
+// 任一配置找不到对应文件时直接返回错误，不会重载任何配置
+// 参数:
+//
+//	names: 配置名称列表
+//
+// 返回值:
+//
+//	error: 找不到配置文件或有配置重载失败时返回错误
+func (cm *ConfigManager233) ReloadConfigs(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	configFiles, err := cm.findConfigFiles(names)
+	if err != nil {
+		return fmt.Errorf("查找待重载的配置文件失败: %w", err)
+	}
+
+	missing := make([]string, 0)
+	for _, name := range names {
+		if _, found := configFiles[name]; !found {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("找不到配置文件: %s", strings.Join(missing, ", "))
+	}
+
+	if failed := cm.reloadConfigFiles(names, configFiles, manualReload); len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("重载配置失败: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// TriggerReloadAndWait 立即重载指定的配置，完成后返回
+// 与文件监听触发的热重载走同一套逻辑（文件已删除的配置会被移除，新文件作为新配置加载），
+// 但跳过批量延迟与冷却时间，主要用于测试和运维脚本中替代 time.Sleep 等待热重载
+// 与正在进行的异步热重载共用同一把重载锁，两者依次执行不会交错
+// 注意: 不要在业务管理器的回调中调用，否则会等待自身所在的重载完成而死锁
+// 参数:
+//
+//	names: 配置名称列表
+//
+// 返回值:
+//
+//	error: 遍历配置目录失败或有配置重载失败时返回错误，失败的配置保留旧数据
+func (cm *ConfigManager233) TriggerReloadAndWait(names []string) error {
+	if len(names) == 0 {
+		return nil
 	}
 
-	// 构建配置名到文件路径的映射
+	configFiles, err := cm.findConfigFiles(names)
+	if err != nil {
+		return fmt.Errorf("查找待重载的配置文件失败: %w", err)
+	}
+	if failed := cm.reloadConfigFiles(names, configFiles, manualReload); len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("重载配置失败: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// findConfigFiles 查找指定配置对应的文件路径
+// 与全量加载使用同一套目录遍历与冲突处理规则，多个目录同名时取覆盖后的文件
+// 返回值:
+//
+//	map[string]string: 配置名 -> 文件路径，找不到文件的配置不在结果中
+//	error: 遍历配置目录失败，或要查找的配置存在同名文件冲突时返回错误（避免把冲突的配置当作已删除）
+func (cm *ConfigManager233) findConfigFiles(configNames []string) (map[string]string, error) {
+	allFiles, duplicates, err := cm.collectConfigFiles(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(configNames))
+	for _, configName := range configNames {
+		wanted[configName] = true
+	}
+	for _, duplicate := range duplicates {
+		if wanted[duplicate.ConfigName] {
+			return nil, duplicate
+		}
+	}
 	configFiles := make(map[string]string)
+	for _, f := range allFiles {
+		fileName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
+		if wanted[fileName] {
+			configFiles[fileName] = f.path
+		}
+	}
+	return configFiles, nil
+}
 
-	// 遍历配置目录，查找对应的配置文件
-	_ = filepath.Walk(cm.configDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+// batchReloadConfigs 批量重载指定的配置文件，按一次文件变更触发的热重载处理
+// 尚未加载过的配置文件会作为新配置加载，文件已不存在的配置会从内存中移除
+// 返回值:
+//
+//	[]string: 加载失败的配置名
+func (cm *ConfigManager233) batchReloadConfigs(configNames []string) []string {
+	return cm.reloadBatch(configNames, reloadBatchInfo{trigger: ReloadTriggerFileWatch, fileEvents: len(configNames)})
+}
+
+// reloadBatch 执行一批热重载，info 随重载完成事件发布
+// 返回值:
+//
+//	[]string: 加载失败的配置名
+func (cm *ConfigManager233) reloadBatch(configNames []string, info reloadBatchInfo) []string {
+	if len(configNames) == 0 {
+		return nil
+	}
+
+	configFiles, err := cm.findConfigFiles(configNames)
+	if err != nil {
+		getLogger().Error(err, "查找待重载的配置文件失败", "configNames", configNames)
+		return configNames
+	}
+
+	// 编辑器分多次写入保存时，Write 事件可能早于写入完成；仍在变化的文件本轮跳过，按失败进入重试
+	if cm.configFS() != nil {
+		return cm.reloadConfigFiles(configNames, configFiles, info)
+	}
+	interval, maxChecks := cm.fileStableCheck()
+	unstable := waitFilesStable(configFiles, interval, maxChecks)
+	if len(unstable) == 0 {
+		return cm.reloadConfigFiles(configNames, configFiles, info)
+	}
+	skipped := make(map[string]bool, len(unstable))
+	for _, configName := range unstable {
+		skipped[configName] = true
+		delete(configFiles, configName)
+		getLogger().Info("配置文件仍在写入，稍后重试", "configName", configName)
+	}
+	stableNames := make([]string, 0, len(configNames))
+	for _, configName := range configNames {
+		if !skipped[configName] {
+			stableNames = append(stableNames, configName)
 		}
-		if isHiddenDir(info) {
-			return filepath.SkipDir
+	}
+	return append(cm.reloadConfigFiles(stableNames, configFiles, info), unstable...)
+}
+
+// waitFilesStable 等待文件写入完成：间隔 interval 两次 stat，大小与修改时间都不变才认为写完，
+// 仍在变化的文件继续检测，最多再检测 maxChecks 次；所有文件共用同一轮等待。stat 失败（如文件已删除）的文件交给重载处理
+// 参数:
+//
+//	files: 配置名 -> 文件路径
+//	interval: 两次 stat 之间的间隔
+//	maxChecks: 最多检测的次数
+//
+// 返回值:
+//
+//	[]string: 检测次数用完仍在变化的配置名（已排序）
+func waitFilesStable(files map[string]string, interval time.Duration, maxChecks int) []string {
+	last := make(map[string]os.FileInfo, len(files))
+	for configName, path := range files {
+		if info, err := os.Stat(path); err == nil {
+			last[configName] = info
 		}
+	}
 
-		if info == nil || info.IsDir() {
-			return nil
+	for check := 0; check < maxChecks && len(last) > 0; check++ {
+		time.Sleep(interval)
+		for configName, prev := range last {
+			info, err := os.Stat(files[configName])
+			if err != nil || (info.Size() == prev.Size() && info.ModTime().Equal(prev.ModTime())) {
+				delete(last, configName)
+				continue
+			}
+			last[configName] = info
 		}
+	}
+
+	unstable := make([]string, 0, len(last))
+	for configName := range last {
+		unstable = append(unstable, configName)
+	}
+	sort.Strings(unstable)
+	return unstable
+}
 
-		fileName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+// SetFileStableCheck 设置热重载前的文件稳定性检测（链式调用）
+// 热重载读取文件前间隔 interval 两次 stat，大小与修改时间一致才认为写完，否则继续检测，
+// 最多 maxChecks 次后本轮跳过该文件，按重载失败最多重试 ReloadMaxRetries 次；
+// 只作用于文件监听触发的热重载，ReloadConfigs、TriggerReloadAndWait 与 fs.FS 模式不做检测
+// 参数:
+//
+//	interval: 两次 stat 的间隔，小于等于 0 时恢复默认值 FileStableCheckInterval
+//	maxChecks: 最多检测的次数，小于等于 0 时恢复默认值 FileStableMaxChecks
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetFileStableCheck(interval time.Duration, maxChecks int) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.stableInterval = interval
+	cm.stableChecks = maxChecks
+	return cm
+}
+
+// fileStableCheck 获取文件稳定性检测的间隔与次数，未设置时使用默认值
+func (cm *ConfigManager233) fileStableCheck() (time.Duration, int) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	interval := FileStableCheckInterval
+	if cm.stableInterval > 0 {
+		interval = cm.stableInterval
+	}
+	maxChecks := FileStableMaxChecks
+	if cm.stableChecks > 0 {
+		maxChecks = cm.stableChecks
+	}
+	return interval, maxChecks
+}
+
+// reloadConfigFiles 重载已定位到文件的配置，并通知业务管理器
+// configNames 中不在 configFiles 里的配置视为文件已删除
+// 参数:
+//
+//	configNames: 待重载的配置名
+//	configFiles: 配置名 -> 文件路径
+//	info: 本批次的触发原因与合并的文件变更事件数
+//
+// 返回值:
+//
+//	[]string: 加载失败的配置名
+func (cm *ConfigManager233) reloadConfigFiles(configNames []string, configFiles map[string]string, info reloadBatchInfo) []string {
+	failed := cm.reloadConfigFilesWithErrors(configNames, configFiles, info)
+	failedConfigs := make([]string, 0, len(failed))
+	for _, f := range failed {
+		failedConfigs = append(failedConfigs, f.ConfigName)
+	}
+	return failedConfigs
+}
+
+// reloadConfigFilesWithErrors 与 reloadConfigFiles 相同，返回每个加载失败的配置及其错误
+// 通过 LoadExcelAllSheets 加载过的 Excel 文件按工作表重载，每个工作表作为独立配置通知，删除时移除所有工作表的配置
+func (cm *ConfigManager233) reloadConfigFilesWithErrors(configNames []string, configFiles map[string]string, info reloadBatchInfo) ConfigValidationErrors {
+	// 异步热重载与手动触发的重载依次执行，避免两批重载交错写入和重复通知
+	cm.reloadMu.Lock()
+	defer cm.reloadMu.Unlock()
+
+	// 有 IConfigItemChangeListener 时记录重载前的 ID 索引，重载后对比出配置项变更
+	// 多工作表文件记录上次加载的各工作表配置，新出现的工作表在写入前补记
+	var beforeMaps map[string]map[string]interface{}
+	sheetCommit := cm.commitConfig
+	if cm.hasItemChangeListener() {
+		var captureNames []string
 		for _, configName := range configNames {
-			if fileName == configName {
-				configFiles[configName] = path
-				break
+			captureNames = append(captureNames, cm.fileConfigNames(configName)...)
+		}
+		beforeMaps = cm.captureConfigMaps(captureNames)
+		sheetCommit = func(configName string, dataList interface{}, configMap map[string]interface{}, slice []interface{}) error {
+			if _, captured := beforeMaps[configName]; !captured {
+				beforeMaps[configName] = cm.captureConfigMaps([]string{configName})[configName]
 			}
+			return cm.commitConfig(configName, dataList, configMap, slice)
 		}
+	}
 
-		return nil
-	})
+	// 文件已不存在的配置视为被删除
+	// 编辑器"先删后建"的原子替换在批量延迟内会重新出现文件，此时按正常重载处理
+	removedConfigs := make([]string, 0)
+	for _, fileName := range configNames {
+		if _, found := configFiles[fileName]; found {
+			continue
+		}
+		for _, configName := range cm.fileConfigNames(fileName) {
+			if cm.removeConfig(configName) {
+				removedConfigs = append(removedConfigs, configName)
+				getLogger().Info("配置文件已删除，移除配置", "configName", configName)
+			}
+		}
+	}
 
 	// 串行重载每个配置文件（避免并发冲突）
 	successCount := 0
 	successConfigs := make([]string, 0, len(configFiles))
+	var failedConfigs ConfigValidationErrors
 	for configName, filePath := range configFiles {
 		ext := strings.ToLower(filepath.Ext(filePath))
+		loaded := []string{configName} // 本文件成功加载的配置名，多工作表文件为各工作表的配置名
 		var err error
 
 		switch ext {
 		case ".xlsx", ".xls":
-			err = cm.loadExcelConfig(filePath)
-		case ".json":
+			if cm.isSheetFile(configName) {
+				loaded, err = cm.readExcelAllSheets(filePath, sheetCommit)
+			} else {
+				err = cm.loadExcelConfig(filePath)
+			}
+		case ".json", ".jsonl":
 			err = cm.loadJsonConfig(filePath)
-		case ".tsv":
+		case ".tsv", ".csv":
 			err = cm.loadTsvConfig(filePath)
+		case ".yaml", ".yml":
+			err = cm.loadYamlConfig(filePath)
+		case ".xml":
+			err = cm.loadXmlConfig(filePath)
 		default:
 			continue
 		}
 
 		if err != nil {
 			getLogger().Error(err, "重载配置失败", "configName", configName, "path", filePath)
-			fmt.Printf("\033[31m[config233] 重载配置失败: configName=%s, path=%s, error=%v\033[0m\n", configName, filePath, err)
+			failedConfigs = append(failedConfigs, &ConfigValidationError{ConfigName: configName, FilePath: filePath, Err: err})
+			if !cm.isSheetFile(configName) {
+				continue
+			}
+			// 多工作表文件中加载成功的工作表照常通知
 		} else {
 			successCount++
-			successConfigs = append(successConfigs, configName)
 			getLogger().Info("重载配置成功", "configName", configName, "path", filePath)
-			fmt.Printf("[config233] 重载配置成功: configName=%s, path=%s\n", configName, filePath)
+		}
+		successConfigs = append(successConfigs, loaded...)
+	}
+
+	// 实现了 IConfigRemovalListener 的管理器先单独收到删除通知
+	if len(removedConfigs) > 0 {
+		for _, manager := range cm.businessManagers {
+			if listener, ok := manager.(IConfigRemovalListener); ok {
+				removedCopy := make([]string, len(removedConfigs))
+				copy(removedCopy, removedConfigs)
+				callBusinessManager(manager, "OnConfigRemoved", func() { listener.OnConfigRemoved(removedCopy) })
+			}
 		}
 	}
 
 	// 通知业务管理器（批量，每个管理器收到独立副本）
-	if len(successConfigs) > 0 {
+	// 被删除的配置同样作为变更通知，此时该配置已查询不到
+	changedConfigs := append(successConfigs, removedConfigs...)
+	if len(changedConfigs) > 0 {
+		cm.resolveConfigRefs()
+		cm.Snapshot()
+		if beforeMaps != nil {
+			cm.notifyConfigItemsChanged(cm.diffConfigMaps(beforeMaps))
+		}
+		cm.publishHotUpdate(changedConfigs)
 		for _, manager := range cm.businessManagers {
 			// 为每个管理器创建独立副本，防止数据污染
-			configsCopy := make([]string, len(successConfigs))
-			copy(configsCopy, successConfigs)
-			manager.OnConfigLoadComplete(configsCopy)
+			configsCopy := make([]string, len(changedConfigs))
+			copy(configsCopy, changedConfigs)
+			callBusinessManager(manager, "OnConfigLoadComplete", func() { manager.OnConfigLoadComplete(configsCopy) })
 		}
+		cm.publishReloadEvent(changedConfigs, removedConfigs, len(configNames), info)
 		// 更新最后一次加载配置的时间戳
 		cm.lastLoadTimeMs.Store(time.Now().UnixMilli())
 	}
 
-	getLogger().Info("批量重载完成", "total", len(configNames), "success", successCount, "failed", len(configNames)-successCount)
-	fmt.Printf("[config233] 批量重载完成: total=%d, success=%d, failed=%d\n", len(configNames), successCount, len(configNames)-successCount)
+	getLogger().Info("批量重载完成", "total", len(configNames), "success", successCount, "removed", len(removedConfigs), "failed", len(failedConfigs), "trigger", info.trigger, "fileEvents", info.fileEvents)
+	return failedConfigs
+}
+
+// isWatchedConfigFile 判断文件是否为需要监听的配置文件（跳过临时文件和不支持的格式）
+func isWatchedConfigFile(path string) bool {
+	baseName := filepath.Base(path)
+	if strings.HasPrefix(baseName, "~$") ||
+		strings.Contains(baseName, "~") ||
+		strings.Contains(baseName, "#") {
+		return false
+	}
+
+	switch strings.ToLower(filepath.Ext(baseName)) {
+	case ".json", ".jsonl", ".xlsx", ".xls", ".tsv", ".csv", ".yaml", ".yml", ".xml":
+		return true
+	default:
+		return false
+	}
+}
+
+// watchNewDir 将运行时新建的目录（含子目录）加入监听，并把其中已有的配置文件加入待重载队列
+// 参数:
+//
+//	watcher: 文件监听器
+//	hotReload: 热重载状态
+//	dir: 新建的目录路径
+func (cm *ConfigManager233) watchNewDir(watcher *fsnotify.Watcher, hotReload *hotReloadState, dir string) {
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// 目录可能在遍历过程中被删除，忽略即可
+			return nil
+		}
+		if isHiddenDir(info) {
+			return filepath.SkipDir
+		}
+		if info.IsDir() {
+			if addErr := watcher.Add(path); addErr != nil {
+				getLogger().Error(addErr, "添加监听目录失败", "path", path)
+				return filepath.SkipDir
+			}
+			getLogger().Info("新增监听目录", "path", path)
+			return nil
+		}
+		if isWatchedConfigFile(path) {
+			baseName := filepath.Base(path)
+			hotReload.addPendingReload(strings.TrimSuffix(baseName, filepath.Ext(baseName)))
+		}
+		return nil
+	})
+}
+
+// SetReloadBatchDelay 设置热重载的批量延迟时间（链式调用）
+// 文件变更后等待该时间收集更多变更，再一次性重载；StartWatching 之后修改同样生效
+// 参数:
+//
+//	d: 批量延迟时间，小于等于 0 时恢复默认值 ReloadBatchDelay
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetReloadBatchDelay(d time.Duration) *ConfigManager233 {
+	if d <= 0 {
+		d = ReloadBatchDelay
+	}
+	cm.watchMu.Lock()
+	defer cm.watchMu.Unlock()
+	cm.reloadBatchDelay = d
+	if cm.hotReload != nil {
+		cm.hotReload.setTimings(cm.reloadTimingsLocked())
+	}
+	return cm
+}
+
+// SetReloadCooldown 设置两次热重载之间的冷却时间（链式调用）
+// StartWatching 之后修改同样生效
+// 参数:
+//
+//	d: 冷却时间，小于等于 0 时恢复默认值 ReloadCooldown
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetReloadCooldown(d time.Duration) *ConfigManager233 {
+	if d <= 0 {
+		d = ReloadCooldown
+	}
+	cm.watchMu.Lock()
+	defer cm.watchMu.Unlock()
+	cm.reloadCooldown = d
+	if cm.hotReload != nil {
+		cm.hotReload.setTimings(cm.reloadTimingsLocked())
+	}
+	return cm
+}
+
+// reloadTimingsLocked 获取当前的批量延迟与冷却时间（调用方需持有 watchMu），未设置时使用默认值
+func (cm *ConfigManager233) reloadTimingsLocked() (time.Duration, time.Duration) {
+	batchDelay := ReloadBatchDelay
+	if cm.reloadBatchDelay > 0 {
+		batchDelay = cm.reloadBatchDelay
+	}
+	cooldown := ReloadCooldown
+	if cm.reloadCooldown > 0 {
+		cooldown = cm.reloadCooldown
+	}
+	return batchDelay, cooldown
 }
 
 // StartWatching 启动文件监听（带批量重载和冷却机制）
 // 启动对配置目录的文件监听，当配置文件发生变化时自动批量重载配置
 // 特性：
-// - 批量重载：收集 500ms 内的所有变更，一次性重载
-// - 冷却机制：两次重载之间至少间隔 300ms
-// - 智能过滤：只监听已加载的配置文件，忽略临时文件
-// - 递归监听：自动监听所有子目录
+// - 批量重载：收集 500ms（可通过 SetReloadBatchDelay 修改）内的所有变更，一次性重载
+// - 冷却机制：两次重载之间至少间隔 300ms（可通过 SetReloadCooldown 修改）
+// - 智能过滤：只监听支持的配置格式，忽略临时文件
+// - 新增文件：运行时新放入目录的配置文件会自动加载，写入未完成导致失败时会重试
+// - 写入检测：重载前确认文件大小与修改时间不再变化（见 SetFileStableCheck），避免读到写了一半的文件
+// - 删除文件：配置文件被删除或重命名后，从内存中移除对应配置
+// - 递归监听：自动监听所有子目录，包括运行时新建的子目录
 // 返回值:
 //
 //	error: 启动监听过程中的错误
 func (cm *ConfigManager233) StartWatching() error {
+	cm.watchMu.Lock()
+	defer cm.watchMu.Unlock()
+
+	// fs.FS（如 embed.FS）中的配置不会变化，无需监听
+	if cm.configFS() != nil {
+		getLogger().Info("配置来自 fs.FS，跳过文件监听", "dir", cm.configDir)
+		return nil
+	}
+
 	if cm.watcher != nil {
 		getLogger().Info("文件监听已启动")
-		fmt.Printf("\033[33m[config233] 文件监听已启动\033[0m\n")
 		return nil
 	}
 
@@ -218,74 +731,105 @@ func (cm *ConfigManager233) StartWatching() error {
 		return fmt.Errorf("创建文件监听器失败: %w", err)
 	}
 
-	// 递归添加所有目录到监听器（包括子目录）
+	// 递归添加所有配置目录到监听器（包括子目录）
+	configDirs := cm.GetConfigDirs()
 	watchedDirs := []string{}
-	err = filepath.Walk(cm.configDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if isHiddenDir(info) {
-			return filepath.SkipDir
-		}
-		if info.IsDir() {
-			if addErr := watcher.Add(path); addErr != nil {
-				getLogger().Error(addErr, "添加监听目录失败", "path", path)
-				fmt.Printf("\033[31m[config233] 添加监听目录失败: %s, 错误: %v\033[0m\n", path, addErr)
-				return addErr
+	for _, configDir := range configDirs {
+		err = filepath.Walk(configDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if isHiddenDir(info) {
+				return filepath.SkipDir
 			}
-			watchedDirs = append(watchedDirs, path)
+			if info.IsDir() {
+				if addErr := watcher.Add(path); addErr != nil {
+					getLogger().Error(addErr, "添加监听目录失败", "path", path)
+					return addErr
+				}
+				watchedDirs = append(watchedDirs, path)
+			}
+			return nil
+		})
+		if err != nil {
+			break
 		}
-		return nil
-	})
+	}
 	if err != nil {
 		_ = watcher.Close()
 		return fmt.Errorf("添加监听目录失败: %w", err)
 	}
 
-	cm.watcher = watcher
-
 	// 初始化热重载状态
+	batchDelay, cooldown := cm.reloadTimingsLocked()
 	hotReload := newHotReloadState()
+	hotReload.setTimings(batchDelay, cooldown)
+	hotReload.manager = cm
+	done := make(chan struct{})
+	exited := make(chan struct{})
+
+	cm.watcher = watcher
+	cm.watchDone = done
+	cm.watchExited = exited
+	cm.hotReload = hotReload
 
 	go func() {
 		defer func() {
 			_ = watcher.Close()
+			close(exited)
 		}()
 
 		for {
 			select {
+			case <-done:
+				return
+
 			case event, ok := <-watcher.Events:
 				if !ok {
 					return
 				}
 
+				// 新建目录：加入监听，并加载其中已有的配置文件
+				if event.Has(fsnotify.Create) {
+					if info, statErr := os.Stat(event.Name); statErr == nil && info.IsDir() {
+						cm.watchNewDir(watcher, hotReload, event.Name)
+						continue
+					}
+				}
+
 				// 只处理写和创建事件
-				if event.Has(fsnotify.Write) || event.Has(fsnotify.Create) {
+				if (event.Has(fsnotify.Write) || event.Has(fsnotify.Create)) && isWatchedConfigFile(event.Name) {
 					baseName := filepath.Base(event.Name)
+					configName := strings.TrimSuffix(baseName, filepath.Ext(baseName))
 
-					// 跳过临时文件
-					if strings.HasPrefix(baseName, "~$") ||
-						strings.Contains(baseName, "~") ||
-						strings.Contains(baseName, "#") {
-						continue
+					// 检查是否是已加载的配置
+					cm.mutex.RLock()
+					_, exists := cm.configs[configName]
+					cm.mutex.RUnlock()
+
+					if exists {
+						getLogger().Info("检测到已加载配置变化", "file", event.Name, "configName", configName)
+					} else {
+						getLogger().Info("检测到新增配置文件", "file", event.Name, "configName", configName)
 					}
 
-					ext := strings.ToLower(filepath.Ext(event.Name))
-					if ext == ".json" || ext == ".xlsx" || ext == ".xls" || ext == ".tsv" {
-						// 检查是否是已加载的配置
-						configName := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+					// 添加到待重载队列（触发批量重载），新文件同样通过重载加载
+					hotReload.addPendingReload(configName)
+				}
 
-						cm.mutex.RLock()
-						_, exists := cm.configs[configName]
-						cm.mutex.RUnlock()
+				// 删除和重命名事件：延迟到批量重载时确认文件是否仍然存在
+				// 避免编辑器"先删后建"的原子保存导致配置被误清
+				if (event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename)) && isWatchedConfigFile(event.Name) {
+					baseName := filepath.Base(event.Name)
+					configName := strings.TrimSuffix(baseName, filepath.Ext(baseName))
 
-						if exists {
-							getLogger().Info("检测到已加载配置变化", "file", event.Name, "configName", configName)
-							fmt.Printf("[config233] 检测到已加载配置变化: file=%s, configName=%s\n", event.Name, configName)
+					cm.mutex.RLock()
+					_, exists := cm.configs[configName]
+					cm.mutex.RUnlock()
 
-							// 添加到待重载队列（触发批量重载）
-							hotReload.addPendingReload(configName)
-						}
+					if exists {
+						getLogger().Info("检测到配置文件删除或重命名", "file", event.Name, "configName", configName)
+						hotReload.addPendingReload(configName)
 					}
 				}
 
@@ -294,16 +838,45 @@ func (cm *ConfigManager233) StartWatching() error {
 					return
 				}
 				getLogger().Error(err, "文件监听错误")
-				fmt.Printf("\033[31m[config233] 文件监听错误: %v\033[0m\n", err)
 			}
 		}
 	}()
 
 	getLogger().Info("文件监听已启动（批量重载模式）",
-		"dir", cm.configDir,
-		"batchDelay", ReloadBatchDelay.Milliseconds(),
-		"cooldown", ReloadCooldown.Milliseconds())
-	fmt.Printf("[config233] 文件监听已启动（批量重载模式）: dir=%s, batchDelay=%dms, cooldown=%dms, watchedDirs=%d\n",
-		cm.configDir, ReloadBatchDelay.Milliseconds(), ReloadCooldown.Milliseconds(), len(watchedDirs))
+		"dirs", configDirs,
+		"batchDelay", batchDelay.Milliseconds(),
+		"cooldown", cooldown.Milliseconds(),
+		"watchedDirs", len(watchedDirs))
+	return nil
+}
+
+// StopWatching 停止文件监听
+// 关闭文件监听器并等待后台 goroutine 退出，同时取消尚未执行的热重载
+// 停止后可以再次调用 StartWatching 重新启动监听，重复调用是安全的
+// 返回值:
+//
+//	error: 关闭文件监听器过程中的错误
+func (cm *ConfigManager233) StopWatching() error {
+	cm.watchMu.Lock()
+	defer cm.watchMu.Unlock()
+
+	if cm.watcher == nil {
+		return nil
+	}
+
+	close(cm.watchDone)
+	err := cm.watcher.Close()
+	<-cm.watchExited
+	cm.hotReload.stop()
+
+	cm.watcher = nil
+	cm.watchDone = nil
+	cm.watchExited = nil
+	cm.hotReload = nil
+
+	if err != nil {
+		return fmt.Errorf("关闭文件监听器失败: %w", err)
+	}
+	getLogger().Info("文件监听已停止", "dir", cm.configDir)
 	return nil
 }
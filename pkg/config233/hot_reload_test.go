@@ -1,8 +1,12 @@
 package config233
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -31,9 +35,7 @@ func TestHotReload_BatchingAndCooldown(t *testing.T) {
 		t.Fatalf("启动文件监听失败: %v", err)
 	}
 	defer func() {
-		if manager.watcher != nil {
-			_ = manager.watcher.Close()
-		}
+		_ = manager.StopWatching()
 	}()
 
 	// 验证初始配置已加载
@@ -179,9 +181,7 @@ func TestHotReload_FileModificationTrigger(t *testing.T) {
 		t.Fatalf("启动文件监听失败: %v", err)
 	}
 	defer func() {
-		if manager.watcher != nil {
-			_ = manager.watcher.Close()
-		}
+		_ = manager.StopWatching()
 	}()
 
 	// 验证初始配置
@@ -268,9 +268,7 @@ func TestHotReload_SubdirectoryWatching(t *testing.T) {
 		t.Fatalf("启动文件监听失败: %v", err)
 	}
 	defer func() {
-		if manager.watcher != nil {
-			_ = manager.watcher.Close()
-		}
+		_ = manager.StopWatching()
 	}()
 
 	// 验证子目录配置已加载
@@ -302,3 +300,915 @@ func TestHotReload_SubdirectoryWatching(t *testing.T) {
 
 	t.Log("子目录监听测试通过")
 }
+
+// TestStopWatching 测试停止文件监听后不再热重载，且可以重复停止和重新启动
+func TestStopWatching(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "StopConfig.json")
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","name":"initial"}]`), 0644); err != nil {
+		t.Fatalf("创建测试文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	hotReload := manager.hotReload
+
+	// 触发一次待重载后立即停止，定时器应被取消
+	hotReload.addPendingReload("StopConfig")
+	if err := manager.StopWatching(); err != nil {
+		t.Fatalf("停止文件监听失败: %v", err)
+	}
+	if manager.watcher != nil || manager.hotReload != nil {
+		t.Fatal("停止后 watcher 和热重载状态应被清空")
+	}
+
+	hotReload.mutex.Lock()
+	if hotReload.timer != nil || len(hotReload.pendingReloads) != 0 {
+		t.Error("停止后应清空定时器和待重载列表")
+	}
+	hotReload.mutex.Unlock()
+
+	// 停止后修改文件不应触发重载
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","name":"modified"}]`), 0644); err != nil {
+		t.Fatalf("修改测试文件失败: %v", err)
+	}
+	time.Sleep(ReloadBatchDelay + 200*time.Millisecond)
+
+	data, _ := manager.getConfig("StopConfig", "1")
+	if item, ok := data.(map[string]interface{}); !ok || item["name"] != "initial" {
+		t.Errorf("停止监听后不应再热重载，实际: %v", data)
+	}
+
+	// 重复停止是安全的
+	if err := manager.StopWatching(); err != nil {
+		t.Errorf("重复停止不应返回错误: %v", err)
+	}
+
+	// 可以重新启动监听
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("重新启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+	if manager.watcher == nil {
+		t.Fatal("重新启动后 watcher 不应为 nil")
+	}
+}
+
+// waitForCondition 轮询等待条件成立，超时返回 false
+func waitForCondition(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return cond()
+}
+
+// hasLoadedConfig 判断配置是否已加载
+func hasLoadedConfig(manager *ConfigManager233, configName string) bool {
+	manager.mutex.RLock()
+	defer manager.mutex.RUnlock()
+	_, exists := manager.configs[configName]
+	return exists
+}
+
+// TestHotReload_NewFileLoaded 测试运行时新增的配置文件会被自动加载并通知业务管理器
+func TestHotReload_NewFileLoaded(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestConfigs(t, tempDir, 1)
+
+	manager := NewConfigManager233(tempDir)
+	mockManager := newMockBusinessManager()
+	manager.RegisterBusinessManager(mockManager)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	newFile := filepath.Join(tempDir, "NewConfig.json")
+	if err := os.WriteFile(newFile, []byte(`[{"id":"1","name":"new"}]`), 0644); err != nil {
+		t.Fatalf("创建新配置文件失败: %v", err)
+	}
+
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool { return hasLoadedConfig(manager, "NewConfig") }) {
+		t.Fatal("新增的配置文件未被加载")
+	}
+
+	received := mockManager.getReceivedConfigNames()
+	last := received[len(received)-1]
+	if len(last) != 1 || last[0] != "NewConfig" {
+		t.Errorf("期望 OnConfigLoadComplete 收到 [NewConfig]，实际 %v", last)
+	}
+}
+
+// TestHotReload_NewSubdirectoryWatched 测试运行时新建的子目录会加入监听
+func TestHotReload_NewSubdirectoryWatched(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestConfigs(t, tempDir, 1)
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	subDir := filepath.Join(tempDir, "newdir")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("创建子目录失败: %v", err)
+	}
+	// 等待目录加入监听后再写入文件
+	time.Sleep(200 * time.Millisecond)
+
+	if err := os.WriteFile(filepath.Join(subDir, "NewSubConfig.json"), []byte(`[{"id":"1","name":"sub"}]`), 0644); err != nil {
+		t.Fatalf("创建子目录配置文件失败: %v", err)
+	}
+
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool { return hasLoadedConfig(manager, "NewSubConfig") }) {
+		t.Fatal("新建子目录中的配置文件未被加载")
+	}
+}
+
+// TestHotReload_RetryIncompleteNewFile 测试新文件写入未完成导致加载失败时会重试
+func TestHotReload_RetryIncompleteNewFile(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestConfigs(t, tempDir, 1)
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	newFile := filepath.Join(tempDir, "SlowConfig.json")
+	if err := os.WriteFile(newFile, []byte(`[{"id":"1",`), 0644); err != nil {
+		t.Fatalf("创建配置文件失败: %v", err)
+	}
+
+	// 等待第一次加载失败
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool {
+		manager.hotReload.mutex.Lock()
+		defer manager.hotReload.mutex.Unlock()
+		return manager.hotReload.retryCounts["SlowConfig"] > 0
+	}) {
+		t.Fatal("加载失败后应安排重试")
+	}
+
+	// 直接补全文件内容（不经过监听事件），依靠重试完成加载
+	manager.watchMu.Lock()
+	_ = manager.watcher.Remove(tempDir)
+	manager.watchMu.Unlock()
+	if err := os.WriteFile(newFile, []byte(`[{"id":"1","name":"slow"}]`), 0644); err != nil {
+		t.Fatalf("补全配置文件失败: %v", err)
+	}
+
+	if !waitForCondition(ReloadMaxRetries*(ReloadBatchDelay+ReloadCooldown)+time.Second, func() bool {
+		return hasLoadedConfig(manager, "SlowConfig")
+	}) {
+		t.Fatal("重试后配置文件应被加载")
+	}
+}
+
+// TestHotReload_FileRemoved 测试配置文件删除后从内存移除并通知业务管理器
+func TestHotReload_FileRemoved(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestConfigs(t, tempDir, 2)
+
+	manager := NewConfigManager233(tempDir)
+	mockManager := newMockBusinessManager()
+	manager.RegisterBusinessManager(mockManager)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	if err := os.Remove(filepath.Join(tempDir, "Config000.json")); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool { return !hasLoadedConfig(manager, "Config000") }) {
+		t.Fatal("删除的配置文件应从内存中移除")
+	}
+	if _, exists := manager.getConfigMap("Config000"); exists {
+		t.Error("删除的配置应从全局缓存中移除")
+	}
+	if !hasLoadedConfig(manager, "Config001") {
+		t.Error("未删除的配置不应受影响")
+	}
+
+	received := mockManager.getReceivedConfigNames()
+	last := received[len(received)-1]
+	if len(last) != 1 || last[0] != "Config000" {
+		t.Errorf("期望 OnConfigLoadComplete 收到 [Config000]，实际 %v", last)
+	}
+}
+
+// removalListenerManager 额外实现 IConfigRemovalListener 的业务管理器
+type removalListenerManager struct {
+	*mockBusinessManager
+	removedMu sync.Mutex
+	removed   [][]string
+}
+
+func (m *removalListenerManager) OnConfigRemoved(removedConfigNameList []string) {
+	m.removedMu.Lock()
+	defer m.removedMu.Unlock()
+	m.removed = append(m.removed, removedConfigNameList)
+}
+
+// TestBatchReload_OnConfigRemoved 测试删除配置时只通知实现了 IConfigRemovalListener 的管理器
+func TestBatchReload_OnConfigRemoved(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestConfigs(t, tempDir, 2)
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	plain := newMockBusinessManager()
+	listener := &removalListenerManager{mockBusinessManager: newMockBusinessManager()}
+	manager.RegisterBusinessManager(plain)
+	manager.RegisterBusinessManager(listener)
+
+	if err := os.Remove(filepath.Join(tempDir, "Config000.json")); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+	manager.batchReloadConfigs([]string{"Config000", "Config001"})
+
+	listener.removedMu.Lock()
+	removed := listener.removed
+	listener.removedMu.Unlock()
+	if len(removed) != 1 || len(removed[0]) != 1 || removed[0][0] != "Config000" {
+		t.Errorf("期望 OnConfigRemoved 收到 [[Config000]]，实际 %v", removed)
+	}
+	if plain.getCallCount() != 1 || listener.getCallCount() != 1 {
+		t.Errorf("两个管理器都应收到一次 OnConfigLoadComplete，实际 %d 和 %d", plain.getCallCount(), listener.getCallCount())
+	}
+
+	// 没有删除的配置时不触发 OnConfigRemoved
+	manager.batchReloadConfigs([]string{"Config001"})
+	listener.removedMu.Lock()
+	defer listener.removedMu.Unlock()
+	if len(listener.removed) != 1 {
+		t.Errorf("没有删除配置时不应调用 OnConfigRemoved，实际调用 %d 次", len(listener.removed))
+	}
+}
+
+// itemChangeRecord 一次 OnConfigItemsChanged 回调的参数
+type itemChangeRecord struct {
+	configName               string
+	added, modified, removed []string
+}
+
+// itemChangeListenerManager 额外实现 IConfigItemChangeListener 的业务管理器
+type itemChangeListenerManager struct {
+	*mockBusinessManager
+	changesMu sync.Mutex
+	changes   []itemChangeRecord
+}
+
+func (m *itemChangeListenerManager) OnConfigItemsChanged(configName string, added, modified, removed []string) {
+	m.changesMu.Lock()
+	defer m.changesMu.Unlock()
+	m.changes = append(m.changes, itemChangeRecord{configName, added, modified, removed})
+}
+
+// takeChanges 取出并清空已记录的回调
+func (m *itemChangeListenerManager) takeChanges() []itemChangeRecord {
+	m.changesMu.Lock()
+	defer m.changesMu.Unlock()
+	changes := m.changes
+	m.changes = nil
+	return changes
+}
+
+// TestBatchReload_OnConfigItemsChanged 测试热重载后按 ID 通知新增、修改、删除的配置项
+func TestBatchReload_OnConfigItemsChanged(t *testing.T) {
+	tempDir := t.TempDir()
+	writeItems := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("写入配置文件失败: %v", err)
+		}
+	}
+	writeItems("ItemConfig.json", `[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}, {"id": 3, "name": "c"}]`)
+	writeItems("ShopConfig.json", `[{"id": 1, "price": 10}]`)
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	listener := &itemChangeListenerManager{mockBusinessManager: newMockBusinessManager()}
+	manager.RegisterBusinessManager(listener)
+
+	// 修改 2、删除 3、新增 4；ShopConfig 重载但内容不变
+	writeItems("ItemConfig.json", `[{"id": 1, "name": "a"}, {"id": 2, "name": "b2"}, {"id": 4, "name": "d"}]`)
+	writeItems("NewConfig.json", `[{"id": 7}]`)
+	manager.batchReloadConfigs([]string{"ShopConfig", "ItemConfig", "NewConfig"})
+
+	want := []itemChangeRecord{
+		{"ItemConfig", []string{"4"}, []string{"2"}, []string{"3"}},
+		{"NewConfig", []string{"7"}, nil, nil},
+	}
+	if changes := listener.takeChanges(); !reflect.DeepEqual(changes, want) {
+		t.Errorf("期望配置项变更 %v，实际 %v", want, changes)
+	}
+	if listener.getCallCount() != 1 {
+		t.Errorf("OnConfigLoadComplete 应照常调用一次，实际 %d", listener.getCallCount())
+	}
+
+	// 删除配置文件时全部 ID 视为删除
+	if err := os.Remove(filepath.Join(tempDir, "NewConfig.json")); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+	manager.batchReloadConfigs([]string{"NewConfig"})
+	want = []itemChangeRecord{{"NewConfig", nil, nil, []string{"7"}}}
+	if changes := listener.takeChanges(); !reflect.DeepEqual(changes, want) {
+		t.Errorf("期望配置项变更 %v，实际 %v", want, changes)
+	}
+}
+
+// TestHotReload_FileRenamed 测试配置文件重命名后旧配置移除、新配置加载
+func TestHotReload_FileRenamed(t *testing.T) {
+	tempDir := t.TempDir()
+	createTestConfigs(t, tempDir, 1)
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	if err := os.Rename(filepath.Join(tempDir, "Config000.json"), filepath.Join(tempDir, "RenamedConfig.json")); err != nil {
+		t.Fatalf("重命名配置文件失败: %v", err)
+	}
+
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool {
+		return !hasLoadedConfig(manager, "Config000") && hasLoadedConfig(manager, "RenamedConfig")
+	}) {
+		t.Fatal("重命名后应移除旧配置并加载新配置")
+	}
+}
+
+// TestHotReload_AtomicReplaceKeepsConfig 测试"先删后建"的原子保存不会误删配置
+func TestHotReload_AtomicReplaceKeepsConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "AtomicConfig.json")
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","name":"old"}]`), 0644); err != nil {
+		t.Fatalf("创建测试文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	if err := os.Remove(testFile); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","name":"new"}]`), 0644); err != nil {
+		t.Fatalf("重建配置文件失败: %v", err)
+	}
+
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool {
+		data, ok := manager.getConfig("AtomicConfig", "1")
+		item, isMap := data.(map[string]interface{})
+		return ok && isMap && item["name"] == "new"
+	}) {
+		t.Fatal("原子替换后配置应更新为新内容而不是被移除")
+	}
+}
+
+// TestBatchReload_RollbackOnBrokenJson 测试重载读到残缺 JSON 时保留旧数据
+func TestBatchReload_RollbackOnBrokenJson(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "ValidatorConfig.json")
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","shouldFail":false}]`), 0644); err != nil {
+		t.Fatalf("创建测试文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	manager.RegisterType(reflect.TypeOf(ValidatorConfig{}))
+	mockManager := newMockBusinessManager()
+	manager.RegisterBusinessManager(mockManager)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	before := mockManager.getCallCount()
+
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","shouldFail":false},{"id":"2",`), 0644); err != nil {
+		t.Fatalf("写入残缺文件失败: %v", err)
+	}
+
+	failed := manager.batchReloadConfigs([]string{"ValidatorConfig"})
+	if len(failed) != 1 || failed[0] != "ValidatorConfig" {
+		t.Fatalf("期望重载失败的配置为 [ValidatorConfig]，实际 %v", failed)
+	}
+
+	if _, ok := GetConfigById[ValidatorConfig]("1"); !ok {
+		t.Error("重载失败后应保留旧数据")
+	}
+	if count := GetConfigListCount[ValidatorConfig](); count != 1 {
+		t.Errorf("重载失败后配置数量应保持 1，实际 %d", count)
+	}
+	if mockManager.getCallCount() != before {
+		t.Error("重载失败时不应通知配置变更")
+	}
+}
+
+// TestBatchReload_RollbackOnCheckFailure 测试重载后 Check 校验不通过时保留旧数据
+func TestBatchReload_RollbackOnCheckFailure(t *testing.T) {
+	tempDir := t.TempDir()
+	testFile := filepath.Join(tempDir, "ValidatorConfig.json")
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","shouldFail":false}]`), 0644); err != nil {
+		t.Fatalf("创建测试文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	manager.RegisterType(reflect.TypeOf(ValidatorConfig{}))
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","shouldFail":false},{"id":"2","shouldFail":true}]`), 0644); err != nil {
+		t.Fatalf("写入校验失败的文件失败: %v", err)
+	}
+
+	failed := manager.batchReloadConfigs([]string{"ValidatorConfig"})
+	if len(failed) != 1 {
+		t.Fatalf("期望校验失败导致重载失败，实际失败列表 %v", failed)
+	}
+
+	if _, ok := GetConfigById[ValidatorConfig]("2"); ok {
+		t.Error("校验失败的新数据不应写入")
+	}
+	manager.mutex.RLock()
+	_, inConfigMaps := manager.configMaps["ValidatorConfig"]["2"]
+	manager.mutex.RUnlock()
+	if inConfigMaps {
+		t.Error("校验失败的新数据不应写入 configMaps")
+	}
+	if count := GetConfigListCount[ValidatorConfig](); count != 1 {
+		t.Errorf("校验失败后配置数量应保持 1，实际 %d", count)
+	}
+
+	// 修复后可以正常重载
+	if err := os.WriteFile(testFile, []byte(`[{"id":"1","shouldFail":false},{"id":"2","shouldFail":false}]`), 0644); err != nil {
+		t.Fatalf("写入修复后的文件失败: %v", err)
+	}
+	if failed := manager.batchReloadConfigs([]string{"ValidatorConfig"}); len(failed) != 0 {
+		t.Fatalf("修复后重载不应失败，实际 %v", failed)
+	}
+	if _, ok := GetConfigById[ValidatorConfig]("2"); !ok {
+		t.Error("修复后应加载新数据")
+	}
+}
+
+// TestHotReload_CustomBatchDelay 测试不同批量延迟下的合并效果，且 StartWatching 之后修改仍生效
+func TestHotReload_CustomBatchDelay(t *testing.T) {
+	cases := []struct {
+		name          string
+		batchDelay    time.Duration
+		writeInterval time.Duration
+		wantCalls     int
+	}{
+		{name: "长延迟合并为一次", batchDelay: 400 * time.Millisecond, writeInterval: 100 * time.Millisecond, wantCalls: 1},
+		{name: "短延迟分多次", batchDelay: 50 * time.Millisecond, writeInterval: 300 * time.Millisecond, wantCalls: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			configNames := createTestConfigs(t, tempDir, 3)
+
+			manager := NewConfigManager233(tempDir)
+			mockManager := newMockBusinessManager()
+			manager.RegisterBusinessManager(mockManager)
+			if err := manager.LoadAllConfigs(); err != nil {
+				t.Fatalf("加载配置失败: %v", err)
+			}
+			if err := manager.StartWatching(); err != nil {
+				t.Fatalf("启动文件监听失败: %v", err)
+			}
+			defer func() {
+				_ = manager.StopWatching()
+				manager.SetReloadBatchDelay(0).SetReloadCooldown(0)
+			}()
+
+			// 启动监听之后再修改，验证立即生效
+			manager.SetReloadBatchDelay(tc.batchDelay).SetReloadCooldown(10 * time.Millisecond)
+			before := mockManager.getCallCount()
+
+			for i, name := range configNames {
+				content := fmt.Sprintf(`[{"id":"%d","name":"%s_modified"}]`, i+1, name)
+				if err := os.WriteFile(filepath.Join(tempDir, name+".json"), []byte(content), 0644); err != nil {
+					t.Fatalf("修改配置文件失败: %v", err)
+				}
+				time.Sleep(tc.writeInterval)
+			}
+
+			if !waitForCondition(tc.batchDelay+2*time.Second, func() bool {
+				return mockManager.getTotalConfigCount()-len(configNames) >= len(configNames)
+			}) {
+				t.Fatalf("等待热重载超时，收到 %v", mockManager.getReceivedConfigNames())
+			}
+
+			if calls := mockManager.getCallCount() - before; calls != tc.wantCalls {
+				t.Errorf("期望回调 %d 次，实际 %d 次: %v", tc.wantCalls, calls, mockManager.getReceivedConfigNames())
+			}
+		})
+	}
+}
+
+// TestSetReloadTimings_Default 测试非正数恢复默认的批量延迟与冷却时间
+func TestSetReloadTimings_Default(t *testing.T) {
+	manager := NewConfigManager233(t.TempDir())
+	defer manager.SetReloadBatchDelay(0).SetReloadCooldown(0)
+
+	manager.SetReloadBatchDelay(2 * time.Second).SetReloadCooldown(time.Second)
+	manager.watchMu.Lock()
+	batchDelay, cooldown := manager.reloadTimingsLocked()
+	manager.watchMu.Unlock()
+	if batchDelay != 2*time.Second || cooldown != time.Second {
+		t.Errorf("期望 2s/1s，实际 %v/%v", batchDelay, cooldown)
+	}
+
+	manager.SetReloadBatchDelay(-1).SetReloadCooldown(0)
+	manager.watchMu.Lock()
+	batchDelay, cooldown = manager.reloadTimingsLocked()
+	manager.watchMu.Unlock()
+	if batchDelay != ReloadBatchDelay || cooldown != ReloadCooldown {
+		t.Errorf("非正数应恢复默认值，实际 %v/%v", batchDelay, cooldown)
+	}
+}
+
+// TestHotReload_MultipleConfigDirs 测试监听所有配置目录，删除覆盖文件后回退到基础目录中的文件
+func TestHotReload_MultipleConfigDirs(t *testing.T) {
+	baseDir := t.TempDir()
+	overrideDir := t.TempDir()
+	writeJsonFile := func(dir, name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("写入配置文件失败: %v", err)
+		}
+	}
+	writeJsonFile(baseDir, "MultiDirConfig.json", `[{"id": 1, "name": "base"}]`)
+	writeJsonFile(overrideDir, "MultiDirConfig.json", `[{"id": 1, "name": "override"}]`)
+
+	manager := NewConfigManager233(baseDir)
+	if _, err := manager.AddConfigDir(overrideDir); err != nil {
+		t.Fatalf("添加配置目录失败: %v", err)
+	}
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() {
+		_ = manager.StopWatching()
+	}()
+
+	nameOf := func() string {
+		item, ok := manager.getConfig("MultiDirConfig", "1")
+		if !ok {
+			return ""
+		}
+		return fmt.Sprint(item.(map[string]interface{})["name"])
+	}
+	if nameOf() != "override" {
+		t.Fatalf("初始加载应使用覆盖目录中的文件，实际 %q", nameOf())
+	}
+
+	writeJsonFile(overrideDir, "MultiDirConfig.json", `[{"id": 1, "name": "override-v2"}]`)
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool { return nameOf() == "override-v2" }) {
+		t.Fatalf("修改覆盖目录中的文件后应重载，实际 %q", nameOf())
+	}
+
+	if err := os.Remove(filepath.Join(overrideDir, "MultiDirConfig.json")); err != nil {
+		t.Fatalf("删除覆盖文件失败: %v", err)
+	}
+	if !waitForCondition(ReloadBatchDelay+2*time.Second, func() bool { return nameOf() == "base" }) {
+		t.Fatalf("删除覆盖文件后应回退到基础目录中的文件，实际 %q", nameOf())
+	}
+}
+
+// ReloadCacheConfig 重载缓存测试使用的配置
+type ReloadCacheConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// TestBatchReload_ReadsNewValueImmediately 测试批量重载成功后立即读到新值，缓存、二级索引与持有器均已重建
+func TestBatchReload_ReadsNewValueImmediately(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "ReloadCacheConfig.json")
+	if err := os.WriteFile(path, []byte(`[{"id": 1, "name": "old", "kind": "a"}]`), 0644); err != nil {
+		t.Fatalf("写入配置文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	RegisterType[ReloadCacheConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	var holder atomic.Pointer[map[string]*ReloadCacheConfig]
+	RegisterHotUpdateHolderFrom[ReloadCacheConfig](manager, &holder)
+	defer UnregisterHotUpdateHolderFrom[ReloadCacheConfig](manager, &holder)
+
+	// 预热列表与二级索引
+	if list := GetConfigListFrom[ReloadCacheConfig](manager); len(list) != 1 || list[0].Name != "old" {
+		t.Fatalf("首次加载数据不正确: %+v", list)
+	}
+	if byKind := GetConfigByIndexFrom[ReloadCacheConfig](manager, "Kind", "a"); len(byKind) != 1 {
+		t.Fatalf("二级索引应命中 1 条，实际 %d 条", len(byKind))
+	}
+	rebuilds := manager.GetCacheStats().Rebuilds
+
+	if err := os.WriteFile(path, []byte(`[{"id": 1, "name": "new", "kind": "b"}]`), 0644); err != nil {
+		t.Fatalf("写入配置文件失败: %v", err)
+	}
+	if failed := manager.batchReloadConfigs([]string{"ReloadCacheConfig"}); len(failed) != 0 {
+		t.Fatalf("重载不应失败: %v", failed)
+	}
+
+	if item, ok := GetConfigByIdFrom[ReloadCacheConfig](manager, 1); !ok || item.Name != "new" {
+		t.Errorf("重载后按 ID 应读到新值: %+v", item)
+	}
+	if list := GetConfigListFrom[ReloadCacheConfig](manager); len(list) != 1 || list[0].Name != "new" {
+		t.Errorf("重载后列表应读到新值: %+v", list)
+	}
+	if byKind := GetConfigByIndexFrom[ReloadCacheConfig](manager, "Kind", "a"); len(byKind) != 0 {
+		t.Errorf("重载后旧索引应失效，实际命中 %d 条", len(byKind))
+	}
+	if byKind := GetConfigByIndexFrom[ReloadCacheConfig](manager, "Kind", "b"); len(byKind) != 1 {
+		t.Errorf("重载后新索引应命中 1 条，实际 %d 条", len(byKind))
+	}
+	if m := holder.Load(); m == nil || (*m)["1"] == nil || (*m)["1"].Name != "new" {
+		t.Errorf("重载后持有器应指向新数据")
+	}
+	if got := manager.GetCacheStats().Rebuilds; got != rebuilds+1 {
+		t.Errorf("重载一个配置应重建一次缓存，期望 %d，实际 %d", rebuilds+1, got)
+	}
+}
+
+// inFlightReloadManager 记录同时处于 OnConfigLoadComplete 中的重载数
+type inFlightReloadManager struct {
+	*mockBusinessManager
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (m *inFlightReloadManager) OnConfigLoadComplete(changedConfigNameList []string) {
+	current := m.inFlight.Add(1)
+	for {
+		max := m.maxInFlight.Load()
+		if current <= max || m.maxInFlight.CompareAndSwap(max, current) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	m.inFlight.Add(-1)
+	m.mockBusinessManager.OnConfigLoadComplete(changedConfigNameList)
+}
+
+// TestTriggerReloadAndWait_SerializedWithBatchReload 测试手动触发的重载与异步批量重载共用重载锁，不会交错执行
+func TestTriggerReloadAndWait_SerializedWithBatchReload(t *testing.T) {
+	tempDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tempDir, "Config1.json"), []byte(`[{"id":"1","name":"config1"}]`), 0644); err != nil {
+		t.Fatalf("创建测试文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	listener := &inFlightReloadManager{mockBusinessManager: newMockBusinessManager()}
+	manager.RegisterBusinessManager(listener)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := manager.TriggerReloadAndWait([]string{"Config1"}); err != nil {
+				t.Errorf("手动重载失败: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			manager.batchReloadConfigs([]string{"Config1"})
+		}()
+	}
+	wg.Wait()
+
+	if got := listener.getCallCount(); got != 10 {
+		t.Errorf("期望 10 次重载回调，实际 %d 次", got)
+	}
+	if max := listener.maxInFlight.Load(); max != 1 {
+		t.Errorf("重载不应交错执行，最大并发 %d", max)
+	}
+}
+
+// writeFileInSegments 分 segments 段逐步写入文件，每段之间间隔 gap，模拟编辑器分多次保存
+func writeFileInSegments(t *testing.T, path, content string, segments int, gap time.Duration) <-chan struct{} {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("创建文件失败: %v", err)
+	}
+	size := (len(content) + segments - 1) / segments
+	if _, err := f.WriteString(content[:size]); err != nil {
+		t.Fatalf("写入文件失败: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer f.Close()
+		for offset := size; offset < len(content); offset += size {
+			time.Sleep(gap)
+			end := min(offset+size, len(content))
+			if _, err := f.WriteString(content[offset:end]); err != nil {
+				t.Errorf("写入文件失败: %v", err)
+				return
+			}
+		}
+	}()
+	return done
+}
+
+// TestWaitFilesStable_SegmentedWrite 测试分段写入的文件在写完后才被认为稳定，此时读到完整内容
+func TestWaitFilesStable_SegmentedWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "SegmentedConfig.json")
+	content := `[{"id":"1","name":"first"},{"id":"2","name":"second"},{"id":"3","name":"third"}]`
+	done := writeFileInSegments(t, path, content, 8, 10*time.Millisecond)
+
+	unstable := waitFilesStable(map[string]string{"SegmentedConfig": path}, 30*time.Millisecond, 20)
+	if len(unstable) != 0 {
+		t.Fatalf("写入完成后文件应被认为稳定: %v", unstable)
+	}
+	<-done
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("读取文件失败: %v", err)
+	}
+	if string(data) != content {
+		t.Errorf("稳定后应读到完整内容，实际 %q", data)
+	}
+}
+
+// TestWaitFilesStable_StillWriting 测试检测次数用完时仍在写入的文件被报告为不稳定，未变化的文件不受影响
+func TestWaitFilesStable_StillWriting(t *testing.T) {
+	tempDir := t.TempDir()
+	stablePath := filepath.Join(tempDir, "StableConfig.json")
+	if err := os.WriteFile(stablePath, []byte(`[{"id":"1"}]`), 0644); err != nil {
+		t.Fatalf("写入文件失败: %v", err)
+	}
+	writingPath := filepath.Join(tempDir, "WritingConfig.json")
+	done := writeFileInSegments(t, writingPath, fmt.Sprintf("[%0200d]", 0), 40, 5*time.Millisecond)
+	defer func() { <-done }()
+
+	unstable := waitFilesStable(map[string]string{
+		"StableConfig":  stablePath,
+		"WritingConfig": writingPath,
+		"MissingConfig": filepath.Join(tempDir, "MissingConfig.json"),
+	}, 20*time.Millisecond, 2)
+	if !reflect.DeepEqual(unstable, []string{"WritingConfig"}) {
+		t.Errorf("只有仍在写入的文件应被报告为不稳定: %v", unstable)
+	}
+}
+
+// TestHotReload_WaitsForSegmentedWrite 测试热重载等待分段写入完成，最终加载完整内容
+func TestHotReload_WaitsForSegmentedWrite(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "SegmentedConfig.json")
+	if err := os.WriteFile(path, []byte(`[{"id":"1","name":"v1"}]`), 0644); err != nil {
+		t.Fatalf("写入文件失败: %v", err)
+	}
+
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	manager.SetReloadBatchDelay(20*time.Millisecond).SetFileStableCheck(40*time.Millisecond, 10)
+	defer manager.SetReloadBatchDelay(0).SetFileStableCheck(0, 0)
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer func() { _ = manager.StopWatching() }()
+
+	content := `[{"id":"1","name":"v2"},{"id":"2","name":"v2"},{"id":"3","name":"v2"}]`
+	<-writeFileInSegments(t, path, content, 6, 15*time.Millisecond)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		manager.mutex.RLock()
+		count := len(manager.configMaps["SegmentedConfig"])
+		manager.mutex.RUnlock()
+		if count == 3 {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("热重载后应加载分段写入完成后的完整内容")
+}
+
+// TestHotReloadState_MergedEventStats 测试快速连续的文件变更被合并为一次重载时，完成事件中的统计正确
+func TestHotReloadState_MergedEventStats(t *testing.T) {
+	tempDir := t.TempDir()
+	for _, name := range []string{"MergeConfig1", "MergeConfig2"} {
+		if err := os.WriteFile(filepath.Join(tempDir, name+".json"), []byte(`[{"id":"1"}]`), 0644); err != nil {
+			t.Fatalf("创建测试文件失败: %v", err)
+		}
+	}
+	manager := NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	events := manager.SubscribeReload()
+	defer manager.Unsubscribe(events)
+
+	hrs := newHotReloadState()
+	hrs.manager = manager
+	hrs.setTimings(50*time.Millisecond, 0)
+	defer hrs.stop()
+
+	// 批量延迟内对两个配置共产生 5 次文件变更
+	for _, name := range []string{"MergeConfig1", "MergeConfig1", "MergeConfig2", "MergeConfig1", "MergeConfig2"} {
+		hrs.addPendingReload(name)
+	}
+
+	select {
+	case event := <-events:
+		if event.Trigger != ReloadTriggerFileWatch {
+			t.Errorf("触发原因应为 %s，实际 %s", ReloadTriggerFileWatch, event.Trigger)
+		}
+		if event.FileEvents != 5 {
+			t.Errorf("应合并 5 次文件变更，实际 %d 次", event.FileEvents)
+		}
+		if event.BatchConfigs != 2 || !reflect.DeepEqual(event.ConfigNames, []string{"MergeConfig1", "MergeConfig2"}) {
+			t.Errorf("应合并为 2 个配置的一次重载，实际 %d 个: %v", event.BatchConfigs, event.ConfigNames)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("等待重载完成事件超时")
+	}
+
+	select {
+	case event := <-events:
+		t.Errorf("连续变更应只触发一次重载，又收到事件: %+v", event)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// 下一批重新计数
+	hrs.addPendingReload("MergeConfig2")
+	select {
+	case event := <-events:
+		if event.FileEvents != 1 || event.BatchConfigs != 1 {
+			t.Errorf("新一批应重新计数，实际 FileEvents=%d BatchConfigs=%d", event.FileEvents, event.BatchConfigs)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("等待重载完成事件超时")
+	}
+}
@@ -0,0 +1,54 @@
+package config233
+
+import "reflect"
+
+// RegisterForHotUpdate 注册对象用于热更新，字段注入与方法约定与 Config233.RegisterForHotUpdate 相同:
+//   - `config233:"inject"` 的 map 字段注入对应类型的配置，key 为 config233:"uid" 字段（没有时为 Id 字段）
+//   - OnHotUpdate() 在任一 inject 字段对应的配置变更后调用，On<配置名>HotUpdate() 在该配置变更后调用
+//
+// 内部复用 Config233 的仓库与注入实现，数据来自 GetConfigById[T] 等泛型查询读取的同一份缓存：
+// 每次加载、热重载、删除或回滚完成后（配置引用回填之后、OnConfigLoadComplete 之前），
+// 变更的已注册配置写入仓库并重新注入字段、调用热更新方法。注册时立即注入当前已加载的数据，
+// On<配置名>HotUpdate 按注册对象时已 RegisterType 的配置名匹配
+// 参数:
+//
+//	obj: 需要注册热更新的对象指针，同一类型只注册一次
+func (cm *ConfigManager233) RegisterForHotUpdate(obj interface{}) {
+	cm.bridgeMu.Lock()
+	defer cm.bridgeMu.Unlock()
+
+	cm.registerTypeMu.RLock()
+	for configName, typ := range cm.registeredTypes {
+		cm.bridge.configClasses[configName] = typ
+	}
+	cm.registerTypeMu.RUnlock()
+
+	cm.bridge.RegisterForHotUpdate(obj)
+}
+
+// publishHotUpdate 把变更的已注册配置写入 RegisterForHotUpdate 使用的仓库，触发字段注入与热更新方法
+// 已删除的配置写入空列表，注入的 map 随之清空；转换失败保留为 map 的配置项不写入
+// 参数:
+//
+//	configNames: 变更的配置名
+func (cm *ConfigManager233) publishHotUpdate(configNames []string) {
+	cm.bridgeMu.Lock()
+	repository := cm.bridge.configRepository
+	cm.bridgeMu.Unlock()
+
+	slices := getGlobalSliceCache(cm)
+	for _, configName := range configNames {
+		typ, registered := cm.getRegisteredType(configName)
+		if !registered {
+			continue
+		}
+		ptrType := reflect.PointerTo(typ)
+		dataList := make([]interface{}, 0, len(slices[configName]))
+		for _, item := range slices[configName] {
+			if reflect.TypeOf(item) == ptrType {
+				dataList = append(dataList, item)
+			}
+		}
+		repository.Put(typ, dataList)
+	}
+}
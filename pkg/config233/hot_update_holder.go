@@ -0,0 +1,105 @@
+package config233
+
+import (
+	"sync/atomic"
+)
+
+// RegisterHotUpdateHolder 注册热更新持有器：该类型配置每次加载、热重载、删除或回滚后，
+// config233 都会构建新的 ID -> 配置 map 并原子替换 holder 指向的 map
+// 业务层在热点路径上直接 holder.Load() 读取，无锁也不经过 GetConfigById 的 map 查找链路，
+// 比 Config233 的字段注入更适合泛型场景
+// 注册时立即写入当前已加载的数据（尚未加载时为空 map），之后 holder.Load() 不会返回 nil
+// holder 指向的 map 为只读快照，调用方不应修改；需要停止更新时调用 UnregisterHotUpdateHolder
+// 参数:
+//
+//	holder: 业务持有的原子指针，通常为包级变量
+//
+// 示例:
+//
+//	var itemHolder atomic.Pointer[map[string]*ItemConfig]
+//
+//	config233.RegisterHotUpdateHolder[ItemConfig](&itemHolder)
+//	item := (*itemHolder.Load())["1001"]
+func RegisterHotUpdateHolder[T any](holder *atomic.Pointer[map[string]*T]) {
+	RegisterHotUpdateHolderFrom[T](GetInstance(), holder)
+}
+
+// RegisterHotUpdateHolderFrom 与 RegisterHotUpdateHolder 相同，但注册到指定的管理器 cm
+func RegisterHotUpdateHolderFrom[T any](cm *ConfigManager233, holder *atomic.Pointer[map[string]*T]) {
+	if holder == nil {
+		return
+	}
+	configName := configNameOf[T](cm)
+	refresh := func() {
+		configMap := GetConfigMapFrom[T](cm)
+		if configMap == nil {
+			configMap = make(map[string]*T)
+		}
+		holder.Store(&configMap)
+	}
+
+	cm.hotUpdateHolderMu.Lock()
+	if cm.hotUpdateHolders == nil {
+		cm.hotUpdateHolders = make(map[string][]hotUpdateHolder)
+	}
+	cm.hotUpdateHolders[configName] = append(cm.hotUpdateHolders[configName], hotUpdateHolder{key: holder, refresh: refresh})
+	cm.hotUpdateHolderMu.Unlock()
+
+	refresh()
+}
+
+// UnregisterHotUpdateHolder 取消注册热更新持有器，holder 保留最后一次写入的数据
+func UnregisterHotUpdateHolder[T any](holder *atomic.Pointer[map[string]*T]) {
+	UnregisterHotUpdateHolderFrom[T](GetInstance(), holder)
+}
+
+// UnregisterHotUpdateHolderFrom 与 UnregisterHotUpdateHolder 相同，但从指定的管理器 cm 取消注册
+func UnregisterHotUpdateHolderFrom[T any](cm *ConfigManager233, holder *atomic.Pointer[map[string]*T]) {
+	configName := configNameOf[T](cm)
+
+	cm.hotUpdateHolderMu.Lock()
+	defer cm.hotUpdateHolderMu.Unlock()
+	holders := cm.hotUpdateHolders[configName]
+	kept := holders[:0:0]
+	for _, h := range holders {
+		if h.key != holder {
+			kept = append(kept, h)
+		}
+	}
+	if len(kept) == 0 {
+		delete(cm.hotUpdateHolders, configName)
+	} else {
+		cm.hotUpdateHolders[configName] = kept
+	}
+}
+
+// hotUpdateHolder 已注册的热更新持有器
+type hotUpdateHolder struct {
+	key     interface{} // 业务传入的 holder 指针，用于取消注册
+	refresh func()      // 按当前缓存重建 map 并写入 holder
+}
+
+// refreshHotUpdateHolders 指定配置的缓存替换后刷新对应的持有器
+func (cm *ConfigManager233) refreshHotUpdateHolders(configName string) {
+	cm.hotUpdateHolderMu.Lock()
+	holders := append([]hotUpdateHolder(nil), cm.hotUpdateHolders[configName]...)
+	cm.hotUpdateHolderMu.Unlock()
+
+	for _, h := range holders {
+		h.refresh()
+	}
+}
+
+// refreshAllHotUpdateHolders 整体替换缓存（清空、回滚）后刷新所有持有器
+func (cm *ConfigManager233) refreshAllHotUpdateHolders() {
+	cm.hotUpdateHolderMu.Lock()
+	var holders []hotUpdateHolder
+	for _, list := range cm.hotUpdateHolders {
+		holders = append(holders, list...)
+	}
+	cm.hotUpdateHolderMu.Unlock()
+
+	for _, h := range holders {
+		h.refresh()
+	}
+}
@@ -0,0 +1,27 @@
+// Package convert 提供各配置处理器共用的单元格值转换函数
+package convert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseBool 解析策划表中常见的布尔写法
+// 接受 1/0、true/false、t/f、yes/no、y/n、on/off、enabled/disabled、是/否，大小写不敏感并忽略首尾空白
+// 参数:
+//
+//	s: 单元格内容
+//
+// 返回值:
+//
+//	bool: 解析结果
+//	error: 无法识别时返回错误，由调用方记录
+func ParseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "true", "t", "yes", "y", "on", "enabled", "是":
+		return true, nil
+	case "0", "false", "f", "no", "n", "off", "disabled", "否":
+		return false, nil
+	}
+	return false, fmt.Errorf("无法识别的布尔值 %q", s)
+}
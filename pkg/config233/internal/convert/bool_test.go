@@ -0,0 +1,48 @@
+package convert
+
+import "testing"
+
+// TestParseBool 测试各种布尔写法
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    bool
+		wantErr bool
+	}{
+		{"1", true, false},
+		{"0", false, false},
+		{"true", true, false},
+		{"TRUE", true, false},
+		{"True", true, false},
+		{"false", false, false},
+		{"FALSE", false, false},
+		{"yes", true, false},
+		{"YES", true, false},
+		{"no", false, false},
+		{"y", true, false},
+		{"Y", true, false},
+		{"n", false, false},
+		{"N", false, false},
+		{"是", true, false},
+		{"否", false, false},
+		{"on", true, false},
+		{"off", false, false},
+		{"  true  ", true, false},
+		{"\t是\n", true, false},
+		{"", false, true},
+		{"2", false, true},
+		{"maybe", false, true},
+		{"对", false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBool(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseBool(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseBool(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+)
+
+// CellUnmarshaler 自定义字段解码接口，与 config233.IConfigCellUnmarshaler 一致
+type CellUnmarshaler interface {
+	UnmarshalConfigCell(raw string) error
+}
+
+var cellUnmarshalerType = reflect.TypeOf((*CellUnmarshaler)(nil)).Elem()
+
+// IsCellUnmarshaler 判断字段类型是否实现了 CellUnmarshaler
+// 支持指针接收者（字段为 T 时 *T 实现）与指针字段（字段为 *T 时 *T 实现）
+func IsCellUnmarshaler(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Interface {
+		return false
+	}
+	if typ.Kind() == reflect.Ptr {
+		return typ.Implements(cellUnmarshalerType)
+	}
+	return reflect.PointerTo(typ).Implements(cellUnmarshalerType)
+}
+
+// UnmarshalCell 用字段实现的 CellUnmarshaler 解析单元格内容
+// 单元格为空时不调用，字段保持零值；指针字段解码成功后才赋值，失败时保持 nil
+func UnmarshalCell(field reflect.Value, raw string) error {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	if field.Kind() == reflect.Ptr {
+		target := reflect.New(field.Type().Elem())
+		if err := target.Interface().(CellUnmarshaler).UnmarshalConfigCell(raw); err != nil {
+			return err
+		}
+		field.Set(target)
+		return nil
+	}
+
+	// 先解码到新值，失败时字段保持零值
+	target := reflect.New(field.Type())
+	if err := target.Interface().(CellUnmarshaler).UnmarshalConfigCell(raw); err != nil {
+		return err
+	}
+	field.Set(target.Elem())
+	return nil
+}
@@ -0,0 +1,54 @@
+package convert
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type cellTestLevel struct {
+	Value string
+}
+
+func (l *cellTestLevel) UnmarshalConfigCell(raw string) error {
+	if raw == "bad" {
+		return errors.New("bad level")
+	}
+	l.Value = "lv:" + raw
+	return nil
+}
+
+type cellTestConfig struct {
+	Level cellTestLevel
+	Ptr   *cellTestLevel
+	Name  string
+}
+
+// TestIsCellUnmarshaler 测试值字段与指针字段都能识别指针接收者实现的解码接口
+func TestIsCellUnmarshaler(t *testing.T) {
+	typ := reflect.TypeOf(cellTestConfig{})
+	for i, want := range []bool{true, true, false} {
+		if got := IsCellUnmarshaler(typ.Field(i).Type); got != want {
+			t.Errorf("IsCellUnmarshaler(%s) = %v, want %v", typ.Field(i).Name, got, want)
+		}
+	}
+}
+
+// TestUnmarshalCell 测试解码成功赋值、空单元格跳过、解码失败保持零值
+func TestUnmarshalCell(t *testing.T) {
+	var cfg cellTestConfig
+	v := reflect.ValueOf(&cfg).Elem()
+
+	if err := UnmarshalCell(v.Field(0), "3"); err != nil || cfg.Level.Value != "lv:3" {
+		t.Errorf("值字段应被解码，实际 %+v, err=%v", cfg.Level, err)
+	}
+	if err := UnmarshalCell(v.Field(1), " "); err != nil || cfg.Ptr != nil {
+		t.Errorf("空单元格应跳过，实际 %+v, err=%v", cfg.Ptr, err)
+	}
+	if err := UnmarshalCell(v.Field(1), "bad"); err == nil || cfg.Ptr != nil {
+		t.Errorf("解码失败时指针字段应保持 nil，实际 %+v, err=%v", cfg.Ptr, err)
+	}
+	if err := UnmarshalCell(v.Field(1), "5"); err != nil || cfg.Ptr == nil || cfg.Ptr.Value != "lv:5" {
+		t.Errorf("指针字段应被解码，实际 %+v, err=%v", cfg.Ptr, err)
+	}
+}
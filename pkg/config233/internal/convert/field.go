@@ -0,0 +1,118 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+)
+
+// FieldMatcher 判断结构体字段是否与列名（点分路径中的一段）匹配
+type FieldMatcher func(field reflect.StructField, name string) bool
+
+// FindField 按列名查找结构体字段，返回字段定义与相对 typ 的索引路径
+// 匹配顺序:
+//   - 整个列名匹配当前结构体的字段，或匿名嵌入结构体中提升的字段（外层优先）
+//   - 列名包含 "." 时（如 reward.itemId）按段逐级匹配嵌套的结构体或结构体指针字段
+func FindField(typ reflect.Type, name string, match FieldMatcher) (reflect.StructField, []int, bool) {
+	if field, index, ok := findPromotedField(typ, name, match); ok {
+		return field, index, true
+	}
+	if !strings.Contains(name, ".") {
+		return reflect.StructField{}, nil, false
+	}
+
+	var field reflect.StructField
+	var index []int
+	current := typ
+	for _, segment := range strings.Split(name, ".") {
+		if current.Kind() == reflect.Ptr {
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct || IsTimeType(current) {
+			return reflect.StructField{}, nil, false
+		}
+		f, idx, ok := findPromotedField(current, segment, match)
+		if !ok {
+			return reflect.StructField{}, nil, false
+		}
+		field = f
+		index = append(index, idx...)
+		current = f.Type
+	}
+	return field, index, true
+}
+
+// findPromotedField 在 typ 的字段中查找 name，找不到时按层级查找匿名嵌入结构体提升的字段
+func findPromotedField(typ reflect.Type, name string, match FieldMatcher) (reflect.StructField, []int, bool) {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return reflect.StructField{}, nil, false
+	}
+
+	var embedded []int
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			embedded = append(embedded, i)
+			continue
+		}
+		if field.IsExported() && match(field, name) {
+			return field, []int{i}, true
+		}
+	}
+	for _, i := range embedded {
+		if field, index, ok := findPromotedField(typ.Field(i).Type, name, match); ok {
+			return field, append([]int{i}, index...), true
+		}
+	}
+	return reflect.StructField{}, nil, false
+}
+
+// FieldByIndexAlloc 沿索引路径取字段值，途经的 nil 结构体指针会自动初始化
+// 途经的指针不可设置（如未导出的嵌入指针）时返回无效的 reflect.Value
+func FieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// ColumnAliases 解析字段 config233_column 标签中的列名，多个别名用逗号分隔，如 `config233_column:"itemId,item_id"`
+// 没有标签时返回 nil
+func ColumnAliases(field reflect.StructField) []string {
+	tag := field.Tag.Get("config233_column")
+	if tag == "" {
+		return nil
+	}
+	var aliases []string
+	for _, alias := range strings.Split(tag, ",") {
+		if alias = strings.TrimSpace(alias); alias != "" {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+// MatchColumn 判断字段是否与列名（或点分列名中的一段）匹配，不区分大小写
+// 设置了 config233_column 标签时任一别名命中即可，否则按字段名匹配（itemId、ItemId、ITEMID 都能对应 ItemId 字段）
+func MatchColumn(field reflect.StructField, name string) bool {
+	if aliases := ColumnAliases(field); len(aliases) > 0 {
+		for _, alias := range aliases {
+			if strings.EqualFold(alias, name) {
+				return true
+			}
+		}
+		return false
+	}
+	return strings.EqualFold(field.Name, name)
+}
@@ -0,0 +1,83 @@
+package convert
+
+import (
+	"reflect"
+	"sync"
+)
+
+// CellKind 单元格写入字段的方式，按字段类型与标签预先确定
+type CellKind int
+
+const (
+	CellKindScalar      CellKind = iota // 按类型行或字段类型转换标量
+	CellKindUnmarshaler                 // 字段类型实现了 UnmarshalConfigCell
+	CellKindTime                        // time.Time / time.Duration
+	CellKindSlice                       // 切片，按分隔符拆分
+)
+
+// CellField 列名映射到的 struct 字段，以及按字段类型与标签预先解析的转换方式
+type CellField struct {
+	StructField reflect.StructField // 字段定义
+	Index       []int               // 相对配置结构体的字段索引路径
+	TypeName    string              // 字段类型名，用于转换错误
+	Kind        CellKind            // 写入方式
+	TimeFormat  string              // config233_timefmt 标签
+	Separator   string              // 切片分隔符
+	JSON        JSONCellRule        // 按内嵌 JSON 解析的规则
+}
+
+// cellFieldKey FieldCache 的 key
+type cellFieldKey struct {
+	typ    reflect.Type
+	column string
+}
+
+// FieldCache (结构体类型, 列名) -> *CellField 的缓存，nil 表示该列没有映射到字段
+// 字段查找（含嵌入与嵌套路径）与标签解析只在某类型首次出现某列时执行一次，
+// 之后各文件、各行直接按缓存的索引取字段；每个缓存固定使用一个 FieldMatcher
+type FieldCache struct {
+	match  FieldMatcher
+	fields sync.Map
+}
+
+// NewFieldCache 创建按 match 匹配列名的字段缓存
+func NewFieldCache(match FieldMatcher) *FieldCache {
+	return &FieldCache{match: match}
+}
+
+// Lookup 查找列名映射到的字段，结果按类型缓存
+func (c *FieldCache) Lookup(typ reflect.Type, column string) *CellField {
+	key := cellFieldKey{typ: typ, column: column}
+	if cached, ok := c.fields.Load(key); ok {
+		return cached.(*CellField)
+	}
+
+	var mapped *CellField
+	if structField, index, ok := FindField(typ, column, c.match); ok {
+		mapped = NewCellField(structField, index)
+	}
+	c.fields.Store(key, mapped)
+	return mapped
+}
+
+// NewCellField 解析字段的类型与标签，确定单元格的写入方式
+func NewCellField(structField reflect.StructField, index []int) *CellField {
+	mapped := &CellField{
+		StructField: structField,
+		Index:       index,
+		TypeName:    structField.Type.String(),
+		TimeFormat:  structField.Tag.Get("config233_timefmt"),
+		Separator:   SliceSeparator(structField),
+		JSON:        NewJSONCellRule(structField),
+	}
+
+	switch {
+	case IsCellUnmarshaler(structField.Type):
+		mapped.Kind = CellKindUnmarshaler
+	case IsTimeType(structField.Type):
+		mapped.Kind = CellKindTime
+	case structField.Type.Kind() == reflect.Slice:
+		mapped.Kind = CellKindSlice
+	}
+	return mapped
+}
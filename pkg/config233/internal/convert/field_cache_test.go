@@ -0,0 +1,39 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type fieldCacheTestConfig struct {
+	Id     int
+	Tags   []string `config233_sep:";"`
+	Reward struct{ ItemId int }
+}
+
+// TestFieldCache 测试列名映射的写入方式、嵌套路径与未映射列的缓存结果
+func TestFieldCache(t *testing.T) {
+	calls := 0
+	cache := NewFieldCache(func(field reflect.StructField, name string) bool {
+		calls++
+		return strings.EqualFold(field.Name, name)
+	})
+	typ := reflect.TypeOf(fieldCacheTestConfig{})
+
+	tags := cache.Lookup(typ, "tags")
+	if tags == nil || tags.Kind != CellKindSlice || tags.Separator != ";" {
+		t.Fatalf("tags 应映射为按 ; 拆分的切片字段，实际 %+v", tags)
+	}
+	if nested := cache.Lookup(typ, "reward.itemId"); nested == nil || !reflect.DeepEqual(nested.Index, []int{2, 0}) {
+		t.Errorf("reward.itemId 应映射到嵌套字段，实际 %+v", nested)
+	}
+	if cache.Lookup(typ, "missing") != nil {
+		t.Error("没有对应字段的列应返回 nil")
+	}
+
+	before := calls
+	if cache.Lookup(typ, "tags") != tags || cache.Lookup(typ, "missing") != nil || calls != before {
+		t.Errorf("重复查找应命中缓存，匹配函数调用次数 %d -> %d", before, calls)
+	}
+}
@@ -0,0 +1,109 @@
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fieldTestInner struct {
+	Level int
+}
+
+type fieldTestBase struct {
+	Id   int
+	Name string
+}
+
+type fieldTestConfig struct {
+	fieldTestBase
+	Name   string // 外层字段优先于嵌入结构体中的同名字段
+	Inner  fieldTestInner
+	Ptr    *fieldTestInner
+	Expire time.Time
+}
+
+// TestFindField 测试查找提升字段与点分路径的嵌套字段
+func TestFindField(t *testing.T) {
+	match := func(field reflect.StructField, name string) bool {
+		return strings.EqualFold(field.Name, name)
+	}
+	typ := reflect.TypeOf(fieldTestConfig{})
+
+	tests := []struct {
+		name      string
+		wantIndex []int
+		wantOk    bool
+	}{
+		{"id", []int{0, 0}, true},
+		{"name", []int{1}, true},
+		{"inner.level", []int{2, 0}, true},
+		{"ptr.level", []int{3, 0}, true},
+		{"inner.missing", nil, false},
+		{"expire.wall", nil, false},
+		{"missing", nil, false},
+	}
+	for _, tt := range tests {
+		_, index, ok := FindField(typ, tt.name, match)
+		if ok != tt.wantOk || !reflect.DeepEqual(index, tt.wantIndex) {
+			t.Errorf("FindField(%q) = %v, %v, want %v, %v", tt.name, index, ok, tt.wantIndex, tt.wantOk)
+		}
+	}
+}
+
+// TestFieldByIndexAlloc 测试沿索引路径取字段时自动初始化 nil 指针
+func TestFieldByIndexAlloc(t *testing.T) {
+	var cfg fieldTestConfig
+	v := reflect.ValueOf(&cfg).Elem()
+
+	FieldByIndexAlloc(v, []int{3, 0}).SetInt(5)
+	if cfg.Ptr == nil || cfg.Ptr.Level != 5 {
+		t.Errorf("nil 指针应被初始化并赋值，实际 %+v", cfg.Ptr)
+	}
+	FieldByIndexAlloc(v, []int{0, 0}).SetInt(7)
+	if cfg.Id != 7 {
+		t.Errorf("嵌入字段应被赋值，实际 %d", cfg.Id)
+	}
+}
+
+type columnTestConfig struct {
+	ItemId int `config233_column:"itemId, item_id,"`
+	Name   string
+	Count  int `config233_column:""`
+}
+
+// TestMatchColumn 测试列名匹配不区分大小写，以及 config233_column 的多个别名
+func TestMatchColumn(t *testing.T) {
+	typ := reflect.TypeOf(columnTestConfig{})
+	itemId, _ := typ.FieldByName("ItemId")
+	name, _ := typ.FieldByName("Name")
+	count, _ := typ.FieldByName("Count")
+
+	if aliases := ColumnAliases(itemId); !reflect.DeepEqual(aliases, []string{"itemId", "item_id"}) {
+		t.Errorf("ColumnAliases 应去掉空白与空别名，实际 %v", aliases)
+	}
+	if aliases := ColumnAliases(name); aliases != nil {
+		t.Errorf("没有标签时应返回 nil，实际 %v", aliases)
+	}
+
+	tests := []struct {
+		field reflect.StructField
+		name  string
+		want  bool
+	}{
+		{itemId, "itemId", true},
+		{itemId, "ITEM_ID", true},
+		{itemId, "ItemId", true},
+		{itemId, "id", false},
+		{name, "NAME", true},
+		{name, "name", true},
+		{name, "nick", false},
+		{count, "count", true},
+	}
+	for _, tt := range tests {
+		if got := MatchColumn(tt.field, tt.name); got != tt.want {
+			t.Errorf("MatchColumn(%s, %q) = %v, want %v", tt.field.Name, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// DefaultSliceSeparator 单元格拆分为切片时的默认分隔符
+const DefaultSliceSeparator = ","
+
+// SliceSeparator 获取字段的切片分隔符，通过 `config233_sep:";"` 标签自定义，未设置时使用逗号
+func SliceSeparator(field reflect.StructField) string {
+	if sep := field.Tag.Get("config233_sep"); sep != "" {
+		return sep
+	}
+	return DefaultSliceSeparator
+}
+
+// JSONCellRule 字段按内嵌 JSON 解析的规则，由 NewJSONCellRule 按字段类型与标签预先确定
+type JSONCellRule struct {
+	Always bool // 标记了 config233_json:"true"，总是按 JSON 解析
+	Object bool // struct / map 字段（或其指针），内容以 { 或 [ 开头时按 JSON 解析
+}
+
+// NewJSONCellRule 解析字段的 config233_json 标签与类型
+func NewJSONCellRule(field reflect.StructField) JSONCellRule {
+	kind := field.Type.Kind()
+	if kind == reflect.Ptr {
+		kind = field.Type.Elem().Kind()
+	}
+	return JSONCellRule{
+		Always: field.Tag.Get("config233_json") == "true",
+		Object: kind == reflect.Struct || kind == reflect.Map,
+	}
+}
+
+// Match 判断单元格是否按内嵌 JSON 解析进字段
+// 标记了 `config233_json:"true"` 的字段总是按 JSON 解析；
+// 未标记时，struct / map 字段在内容以 { 或 [ 开头时尝试解析（切片字段由切片转换自行尝试 JSON）
+func (r JSONCellRule) Match(raw string) bool {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return false
+	}
+	return r.Always || (r.Object && (raw[0] == '{' || raw[0] == '['))
+}
+
+// IsJSONCell 判断单元格是否按内嵌 JSON 解析进字段，规则见 JSONCellRule.Match
+func IsJSONCell(field reflect.StructField, raw string) bool {
+	return NewJSONCellRule(field).Match(raw)
+}
+
+// SetJSONCellValue 用 json.Unmarshal 将单元格内容解析进字段，失败时字段保持零值
+func SetJSONCellValue(field reflect.Value, raw string) error {
+	parsed := reflect.New(field.Type())
+	if err := json.Unmarshal([]byte(strings.TrimSpace(raw)), parsed.Interface()); err != nil {
+		return err
+	}
+	field.Set(parsed.Elem())
+	return nil
+}
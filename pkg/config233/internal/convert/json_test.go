@@ -0,0 +1,63 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+)
+
+type jsonTestConfig struct {
+	Tags    []string `config233_sep:";"`
+	Reward  *struct{ Id int }
+	Attrs   map[string]int
+	Raw     string `config233_json:"true"`
+	Name    string
+	Numbers []int
+}
+
+// TestSliceSeparator 测试 config233_sep 标签与默认逗号
+func TestSliceSeparator(t *testing.T) {
+	typ := reflect.TypeOf(jsonTestConfig{})
+	if sep := SliceSeparator(typ.Field(0)); sep != ";" {
+		t.Errorf("SliceSeparator(Tags) = %q, want %q", sep, ";")
+	}
+	if sep := SliceSeparator(typ.Field(5)); sep != DefaultSliceSeparator {
+		t.Errorf("SliceSeparator(Numbers) = %q, want %q", sep, DefaultSliceSeparator)
+	}
+}
+
+// TestIsJSONCell 测试标签强制 JSON、struct / map 字段按首字符识别，其他字段不按 JSON 解析
+func TestIsJSONCell(t *testing.T) {
+	typ := reflect.TypeOf(jsonTestConfig{})
+	tests := []struct {
+		field int
+		raw   string
+		want  bool
+	}{
+		{1, ` {"Id": 1}`, true},
+		{1, "1", false},
+		{2, `{"atk": 1}`, true},
+		{3, "12", true},
+		{3, "  ", false},
+		{4, `{"a": 1}`, false},
+		{5, "[1, 2]", false},
+	}
+	for _, tt := range tests {
+		field := typ.Field(tt.field)
+		if got := IsJSONCell(field, tt.raw); got != tt.want {
+			t.Errorf("IsJSONCell(%s, %q) = %v, want %v", field.Name, tt.raw, got, tt.want)
+		}
+	}
+}
+
+// TestSetJSONCellValue 测试解析成功赋值，失败时字段保持零值
+func TestSetJSONCellValue(t *testing.T) {
+	var cfg jsonTestConfig
+	v := reflect.ValueOf(&cfg).Elem()
+
+	if err := SetJSONCellValue(v.Field(1), ` {"Id": 7} `); err != nil || cfg.Reward == nil || cfg.Reward.Id != 7 {
+		t.Errorf("SetJSONCellValue(Reward) = %v, Reward=%+v", err, cfg.Reward)
+	}
+	if err := SetJSONCellValue(v.Field(2), `{"atk": `); err == nil || cfg.Attrs != nil {
+		t.Errorf("非法 JSON 应返回错误且保持零值, err=%v, Attrs=%v", err, cfg.Attrs)
+	}
+}
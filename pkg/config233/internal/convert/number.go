@@ -0,0 +1,82 @@
+package convert
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// thousandsPattern 带千分位逗号的数字，如 "1,000,000" 或 "-12,345.67"
+var thousandsPattern = regexp.MustCompile(`^[+-]?\d{1,3}(,\d{3})+(\.\d+)?$`)
+
+// NormalizeNumber 规整数字单元格：去掉首尾空白和合法的千分位逗号
+// 分组不合法的逗号（如 "1,2"）保持不变，交由后续解析报错
+func NormalizeNumber(s string) string {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, ",") && thousandsPattern.MatchString(s) {
+		s = strings.ReplaceAll(s, ",", "")
+	}
+	return s
+}
+
+// ParseInt 解析整数单元格，兼容千分位逗号、科学计数法（如 "1.001E+09"）和 "1001.0" 这类整数值的小数写法
+// 科学计数法按十进制精确解析，int64 大值不会因 float64 精度丢失而改变
+// 参数:
+//
+//	s: 单元格内容
+//
+// 返回值:
+//
+//	int64: 解析结果
+//	error: 不是整数或超出 int64 范围时返回错误
+func ParseInt(s string) (int64, error) {
+	s = NormalizeNumber(s)
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return v, nil
+	}
+	n, ok := parseIntegral(s)
+	if !ok {
+		return 0, err
+	}
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("数值 %q 超出 int64 范围", s)
+	}
+	return n.Int64(), nil
+}
+
+// ParseUint 解析无符号整数单元格，规整规则同 ParseInt
+func ParseUint(s string) (uint64, error) {
+	s = NormalizeNumber(s)
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		return v, nil
+	}
+	n, ok := parseIntegral(s)
+	if !ok {
+		return 0, err
+	}
+	if !n.IsUint64() {
+		return 0, fmt.Errorf("数值 %q 超出 uint64 范围", s)
+	}
+	return n.Uint64(), nil
+}
+
+// ParseFloat 解析浮点数单元格，兼容千分位逗号，科学计数法由 strconv.ParseFloat 原生支持
+func ParseFloat(s string, bitSize int) (float64, error) {
+	return strconv.ParseFloat(NormalizeNumber(s), bitSize)
+}
+
+// parseIntegral 将科学计数法或小数写法精确解析为整数，值带有小数部分时返回 false
+func parseIntegral(s string) (*big.Int, bool) {
+	if !strings.ContainsAny(s, ".eE") {
+		return nil, false
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || !r.IsInt() {
+		return nil, false
+	}
+	return r.Num(), true
+}
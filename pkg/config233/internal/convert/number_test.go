@@ -0,0 +1,74 @@
+package convert
+
+import "testing"
+
+// TestParseInt 测试整数单元格的规整
+func TestParseInt(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"1001", 1001, false},
+		{"  1001 ", 1001, false},
+		{"-42", -42, false},
+		{"1,000,000", 1000000, false},
+		{"-12,345", -12345, false},
+		{"1.001E+09", 1001000000, false},
+		{"1.001e9", 1001000000, false},
+		{"9.007199254740993E+15", 9007199254740993, false},
+		{"1001.0", 1001, false},
+		{"9223372036854775807", 9223372036854775807, false},
+		{"1.5", 0, true},
+		{"1.0001E+2", 0, true},
+		{"1,2", 0, true},
+		{"1E+19", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseInt(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseInt(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("ParseInt(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestParseUint 测试无符号整数单元格的规整
+func TestParseUint(t *testing.T) {
+	if got, err := ParseUint("1.8446744073709551615E+19"); err != nil || got != 18446744073709551615 {
+		t.Errorf("ParseUint 科学计数法解析错误: %d, %v", got, err)
+	}
+	if got, err := ParseUint("65,535"); err != nil || got != 65535 {
+		t.Errorf("ParseUint 千分位解析错误: %d, %v", got, err)
+	}
+	if _, err := ParseUint("-1"); err == nil {
+		t.Error("ParseUint 负数应返回错误")
+	}
+}
+
+// TestParseFloat 测试浮点数单元格的规整
+func TestParseFloat(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"1,234.5", 1234.5},
+		{" 3.14 ", 3.14},
+		{"1.5E+03", 1500},
+	}
+	for _, tt := range tests {
+		got, err := ParseFloat(tt.input, 64)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseFloat(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+	}
+	if _, err := ParseFloat("1,23", 64); err == nil {
+		t.Error("分组不合法的逗号应返回错误")
+	}
+}
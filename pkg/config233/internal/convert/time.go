@@ -0,0 +1,100 @@
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTimeLayouts 未通过 config233_timefmt 标签指定 layout 时依次尝试的时间格式
+var DefaultTimeLayouts = []string{"2006-01-02 15:04:05", time.RFC3339}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// IsTimeType 判断字段类型是否为 time.Time、time.Duration 或它们的指针
+func IsTimeType(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ == timeType || typ == durationType
+}
+
+// ParseTime 按 layout 解析时间，layout 为空时依次尝试 DefaultTimeLayouts
+// 不带时区的格式按本地时区解析
+func ParseTime(s, layout string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if layout != "" {
+		return time.ParseInLocation(layout, s, time.Local)
+	}
+
+	var firstErr error
+	for _, l := range DefaultTimeLayouts {
+		t, err := time.ParseInLocation(l, s, time.Local)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
+
+// ParseDuration 解析时长，支持 "5s"、"3m"、"1h30m" 等写法，纯数字按毫秒处理
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(ms) * time.Millisecond, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// SetTimeValue 按字段类型将 raw 解析为 time.Time 或 time.Duration 并设置字段值
+// 空字符串或解析失败时不修改字段，解析失败时返回错误
+// 参数:
+//
+//	field: time.Time、time.Duration 或它们的指针类型的字段
+//	raw: 单元格内容
+//	layout: 时间格式，为空时使用 DefaultTimeLayouts
+func SetTimeValue(field reflect.Value, raw, layout string) error {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+
+	target := field.Type()
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+
+	var parsed reflect.Value
+	switch target {
+	case timeType:
+		t, err := ParseTime(raw, layout)
+		if err != nil {
+			return fmt.Errorf("无法将 '%s' 解析为 time.Time: %w", raw, err)
+		}
+		parsed = reflect.ValueOf(t)
+	case durationType:
+		d, err := ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("无法将 '%s' 解析为 time.Duration: %w", raw, err)
+		}
+		parsed = reflect.ValueOf(d)
+	default:
+		return fmt.Errorf("不支持的时间类型: %v", field.Type())
+	}
+
+	if field.Kind() == reflect.Ptr {
+		ptr := reflect.New(target)
+		ptr.Elem().Set(parsed)
+		field.Set(ptr)
+		return nil
+	}
+	field.Set(parsed)
+	return nil
+}
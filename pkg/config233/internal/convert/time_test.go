@@ -0,0 +1,94 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestParseTime 测试默认 layout 与自定义 layout
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		input   string
+		layout  string
+		want    time.Time
+		wantErr bool
+	}{
+		{"2024-05-01 12:30:00", "", time.Date(2024, 5, 1, 12, 30, 0, 0, time.Local), false},
+		{"2024-05-01T12:30:00+08:00", "", time.Date(2024, 5, 1, 12, 30, 0, 0, time.FixedZone("", 8*3600)), false},
+		{"2024/05/01", "2006/01/02", time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local), false},
+		{"2024-05-01 12:30:00", "2006/01/02", time.Time{}, true},
+		{"not a time", "", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTime(tt.input, tt.layout)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTime(%q, %q) error = %v, wantErr %v", tt.input, tt.layout, err, tt.wantErr)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q, %q) = %v, want %v", tt.input, tt.layout, got, tt.want)
+		}
+	}
+}
+
+// TestParseDuration 测试时长写法
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"5s", 5 * time.Second, false},
+		{"3m", 3 * time.Minute, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"1500", 1500 * time.Millisecond, false},
+		{" 200ms ", 200 * time.Millisecond, false},
+		{"5 seconds", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestSetTimeValue 测试按字段类型设置值
+func TestSetTimeValue(t *testing.T) {
+	var holder struct {
+		At       time.Time
+		Cooldown time.Duration
+		Expire   *time.Time
+	}
+	v := reflect.ValueOf(&holder).Elem()
+
+	if err := SetTimeValue(v.Field(0), "2024-05-01 00:00:00", ""); err != nil {
+		t.Fatalf("设置 time.Time 失败: %v", err)
+	}
+	if err := SetTimeValue(v.Field(1), "3m", ""); err != nil {
+		t.Fatalf("设置 time.Duration 失败: %v", err)
+	}
+	if err := SetTimeValue(v.Field(2), "20240501", "20060102"); err != nil {
+		t.Fatalf("设置 *time.Time 失败: %v", err)
+	}
+	if holder.At.Year() != 2024 || holder.Cooldown != 3*time.Minute || holder.Expire == nil || holder.Expire.Day() != 1 {
+		t.Errorf("设置结果错误: %+v", holder)
+	}
+
+	if err := SetTimeValue(v.Field(1), "abc", ""); err == nil {
+		t.Error("无法解析的时长应返回错误")
+	}
+	if holder.Cooldown != 3*time.Minute {
+		t.Error("解析失败时不应修改字段")
+	}
+	if !IsTimeType(v.Field(2).Type()) || IsTimeType(reflect.TypeOf(0)) {
+		t.Error("IsTimeType 判断错误")
+	}
+}
@@ -0,0 +1,111 @@
+// Package logging 保存 config233 各子包共用的全局日志实现
+// 根包的 SetLogger 写入这里，json / excel 等处理器子包从这里读取，保证所有日志走同一个 Logger
+package logging
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Logger 日志接口，方法签名与 logr.Logger 一致，logr.Logger 可直接作为实现
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+	Error(err error, msg string, keysAndValues ...interface{})
+}
+
+// ConsoleLogger 默认的控制台日志实现
+type ConsoleLogger struct{}
+
+// Info 输出普通日志
+func (l *ConsoleLogger) Info(msg string, keysAndValues ...interface{}) {
+	kvStr := ""
+	if len(keysAndValues) > 0 {
+		kvStr = fmt.Sprintf(" %v", keysAndValues)
+	}
+	fmt.Printf("[INFO] %s%s\n", msg, kvStr)
+}
+
+// Error 输出红色错误日志，err 可以为 nil
+func (l *ConsoleLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	kvStr := ""
+	if len(keysAndValues) > 0 {
+		kvStr = fmt.Sprintf(" %v", keysAndValues)
+	}
+	if err != nil {
+		fmt.Printf("\033[31m[ERROR] %s: %v%s\033[0m\n", msg, err, kvStr)
+	} else {
+		fmt.Printf("\033[31m[ERROR] %s%s\033[0m\n", msg, kvStr)
+	}
+}
+
+// Level 日志级别
+type Level int
+
+const (
+	// LevelInfo 输出 Info 和 Error 日志（默认）
+	LevelInfo Level = iota
+	// LevelError 只输出 Error 日志
+	LevelError
+	// LevelSilent 不输出任何日志
+	LevelSilent
+)
+
+// levelLogger 按级别过滤的日志包装
+type levelLogger struct {
+	inner Logger
+	level Level
+}
+
+func (l *levelLogger) Info(msg string, keysAndValues ...interface{}) {
+	if l.level <= LevelInfo {
+		l.inner.Info(msg, keysAndValues...)
+	}
+}
+
+func (l *levelLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	if l.level <= LevelError {
+		l.inner.Error(err, msg, keysAndValues...)
+	}
+}
+
+var (
+	mu     sync.RWMutex
+	level         = LevelInfo
+	global Logger = &ConsoleLogger{}
+	// effective 按 level 过滤后的 global，Set / SetLevel 时重新计算
+	effective = wrap(global, level)
+)
+
+// wrap 按级别包装日志实现，LevelInfo 时直接返回原实现
+func wrap(logger Logger, lvl Level) Logger {
+	if lvl <= LevelInfo {
+		return logger
+	}
+	return &levelLogger{inner: logger, level: lvl}
+}
+
+// Set 设置全局日志实现，传入 nil 时恢复默认的 ConsoleLogger
+func Set(logger Logger) {
+	if logger == nil {
+		logger = &ConsoleLogger{}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	global = logger
+	effective = wrap(global, level)
+}
+
+// SetLevel 设置日志级别，对所有日志实现生效
+func SetLevel(lvl Level) {
+	mu.Lock()
+	defer mu.Unlock()
+	level = lvl
+	effective = wrap(global, level)
+}
+
+// Get 获取按当前级别过滤后的日志实现
+func Get() Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return effective
+}
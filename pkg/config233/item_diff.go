@@ -0,0 +1,112 @@
+package config233
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// configItemDiff 一个配置重载前后的配置项变更
+type configItemDiff struct {
+	configName string
+	added      []string
+	modified   []string
+	removed    []string
+}
+
+// hasItemChangeListener 是否有业务管理器实现了 IConfigItemChangeListener
+// 没有监听者时跳过重载前后的对比
+func (cm *ConfigManager233) hasItemChangeListener() bool {
+	for _, manager := range cm.businessManagers {
+		if _, ok := manager.(IConfigItemChangeListener); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// captureConfigMaps 记录指定配置当前的 ID 索引，用于重载后对比
+// commitConfig 整体替换 configMaps 中的 map，因此只需保存引用
+func (cm *ConfigManager233) captureConfigMaps(configNames []string) map[string]map[string]interface{} {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	captured := make(map[string]map[string]interface{}, len(configNames))
+	for _, configName := range configNames {
+		captured[configName] = cm.configMaps[configName]
+	}
+	return captured
+}
+
+// diffConfigMaps 对比重载前后的 ID 索引，返回所有有变更的配置（按配置名排序）
+func (cm *ConfigManager233) diffConfigMaps(before map[string]map[string]interface{}) []configItemDiff {
+	configNames := make([]string, 0, len(before))
+	for configName := range before {
+		configNames = append(configNames, configName)
+	}
+	sort.Strings(configNames)
+	after := cm.captureConfigMaps(configNames)
+
+	var diffs []configItemDiff
+	for _, configName := range configNames {
+		diff := diffConfigItems(configName, before[configName], after[configName])
+		if len(diff.added)+len(diff.modified)+len(diff.removed) > 0 {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
+
+// diffConfigItems 对比一个配置重载前后的配置项，ID 列表均为升序
+func diffConfigItems(configName string, oldMap, newMap map[string]interface{}) configItemDiff {
+	diff := configItemDiff{configName: configName}
+	for id, newItem := range newMap {
+		oldItem, existed := oldMap[id]
+		switch {
+		case !existed:
+			diff.added = append(diff.added, id)
+		case !configItemEqual(oldItem, newItem):
+			diff.modified = append(diff.modified, id)
+		}
+	}
+	for id := range oldMap {
+		if _, exists := newMap[id]; !exists {
+			diff.removed = append(diff.removed, id)
+		}
+	}
+	sort.Strings(diff.added)
+	sort.Strings(diff.modified)
+	sort.Strings(diff.removed)
+	return diff
+}
+
+// configItemEqual 判断两条配置内容是否相同
+// 按 JSON 序列化后的字节比较（与配置文件的字段一致，忽略未导出字段），无法序列化时回退为 reflect.DeepEqual
+func configItemEqual(a, b interface{}) bool {
+	aBytes, aErr := json.Marshal(a)
+	bBytes, bErr := json.Marshal(b)
+	if aErr != nil || bErr != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return bytes.Equal(aBytes, bBytes)
+}
+
+// notifyConfigItemsChanged 将配置项变更通知给实现了 IConfigItemChangeListener 的业务管理器
+// 每个管理器收到独立副本
+func (cm *ConfigManager233) notifyConfigItemsChanged(diffs []configItemDiff) {
+	for _, manager := range cm.businessManagers {
+		listener, ok := manager.(IConfigItemChangeListener)
+		if !ok {
+			continue
+		}
+		for _, diff := range diffs {
+			d := diff
+			added := append([]string(nil), d.added...)
+			modified := append([]string(nil), d.modified...)
+			removed := append([]string(nil), d.removed...)
+			callBusinessManager(manager, "OnConfigItemsChanged", func() {
+				listener.OnConfigItemsChanged(d.configName, added, modified, removed)
+			})
+		}
+	}
+}
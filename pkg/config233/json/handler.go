@@ -4,17 +4,39 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"log/slog"
 	"os"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/logging"
 )
 
 // JsonConfigHandler JSON 配置处理器
 // 负责处理 JSON 格式的配置文件，读取并解析为配置对象
-type JsonConfigHandler struct{}
+//
+// 支持三种顶层结构：
+//   - 数组：每个元素为一条配置
+//   - 单个对象：整个对象为一条配置
+//   - id 映射对象：形如 {"1001": {...}, "1002": {...}}，所有值都是对象时，
+//     每个 key 作为配置 id、value 作为一条配置，记录中缺少 id 时自动补上
+//
+// 顶层对象可以用 __version 字段声明 schema 版本号，如 {"__version": 2, "data": [...]}，
+// 版本号记录在 FrontEndConfigDto.SchemaVersion 中，不作为配置数据
+//
+// Lines 为 true 时按 JSON Lines（.jsonl）读取：每行一个独立的 JSON 对象
+type JsonConfigHandler struct {
+	// Lines 是否按 JSON Lines 格式读取，空行跳过，解析失败的行记录行号后跳过，其余行照常读取
+	Lines bool
+	// AllowComments 是否启用容错模式：解析前去掉 // 行注释、/* */ 块注释以及对象和数组末尾的尾逗号
+	// 字符串内的内容不受影响；默认关闭，保持标准 JSON 的严格解析
+	AllowComments bool
+}
+
+// bytesSource 从内存内容解析时错误信息中代替文件路径的占位符
+const bytesSource = "<bytes>"
 
 func jsonTopLevelKind(data []byte) byte {
 	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
@@ -34,9 +56,104 @@ func jsonContentPreview(data []byte, limit int) string {
 	return text
 }
 
+// decodeJSONIdMap 判断顶层对象是否为 id 映射结构
+// 对象非空且所有值都是 JSON 对象时返回各 key 对应的原始内容
+func decodeJSONIdMap(data []byte) (map[string]json.RawMessage, bool) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || len(raw) == 0 {
+		return nil, false
+	}
+	for _, value := range raw {
+		if jsonTopLevelKind(value) != '{' {
+			return nil, false
+		}
+	}
+	return raw, true
+}
+
+// sortedIdKeys 返回排序后的 id 列表，全部为整数时按数值排序，否则按字典序
+func sortedIdKeys(idMap map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(idMap))
+	allNumeric := true
+	for key := range idMap {
+		keys = append(keys, key)
+		if _, err := strconv.ParseInt(key, 10, 64); err != nil {
+			allNumeric = false
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if allNumeric {
+			a, _ := strconv.ParseInt(keys[i], 10, 64)
+			b, _ := strconv.ParseInt(keys[j], 10, 64)
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// hasIdKey 判断记录中是否已有 id/ID/Id 字段
+func hasIdKey(item map[string]interface{}) bool {
+	for _, key := range []string{"id", "ID", "Id"} {
+		if _, ok := item[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fillIdFieldFromKey 当结构体的 id 字段为零值时，用 id 映射的 key 填充
+// id 字段通过 json 标签或字段名（不区分大小写）为 "id" 识别
+func fillIdFieldFromKey(obj reflect.Value, key string) {
+	typ := obj.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		fieldType := typ.Field(i)
+		name := strings.Split(fieldType.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = fieldType.Name
+		}
+		if !strings.EqualFold(name, "id") {
+			continue
+		}
+
+		field := obj.Field(i)
+		if !field.CanSet() || !field.IsZero() {
+			return
+		}
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(key)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if v, err := strconv.ParseInt(key, 10, 64); err == nil {
+				field.SetInt(v)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if v, err := strconv.ParseUint(key, 10, 64); err == nil {
+				field.SetUint(v)
+			}
+		}
+		return
+	}
+}
+
 func unmarshalJSONDataList(configName, configFileFullPath string, data []byte) ([]map[string]interface{}, string, error) {
 	switch jsonTopLevelKind(data) {
 	case '{':
+		if idMap, ok := decodeJSONIdMap(data); ok {
+			dataList := make([]map[string]interface{}, 0, len(idMap))
+			for _, key := range sortedIdKeys(idMap) {
+				var item map[string]interface{}
+				if err := json.Unmarshal(idMap[key], &item); err != nil {
+					return nil, "idMap", fmt.Errorf("parse json config %q (%s) id %q failed: %w", configName, configFileFullPath, key, err)
+				}
+				if !hasIdKey(item) {
+					item["id"] = key
+				}
+				dataList = append(dataList, item)
+			}
+			return dataList, "idMap", nil
+		}
+
 		var item map[string]interface{}
 		if err := json.Unmarshal(data, &item); err != nil {
 			return nil, "object", fmt.Errorf("parse json config %q (%s) as object failed: %w", configName, configFileFullPath, err)
@@ -73,28 +190,73 @@ func (h *JsonConfigHandler) TypeName() string {
 // 返回值:
 //
 //	interface{}: 包含解析后数据的传输对象
-func (h *JsonConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) interface{} {
+//	error: 文件读取或解析失败时返回错误
+func (h *JsonConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) (interface{}, error) {
 	data, err := os.ReadFile(configFileFullPath)
 	if err != nil {
 		err = fmt.Errorf("read json config %q (%s) failed: %w", configName, configFileFullPath, err)
-		slog.Error("读取JSON配置文件失败", "configName", configName, "path", configFileFullPath, "error", err)
-		panic(err)
+		logging.Get().Error(err, "读取JSON配置文件失败", "configName", configName, "path", configFileFullPath)
+		return nil, err
+	}
+	return h.parseFrontEndDataList(configName, configFileFullPath, data)
+}
+
+// ReadToFrontEndDataListFromBytes 从内存中的 JSON 内容读取配置并转为前端数据列表
+// 用于 embed.FS 等无法直接按路径读取文件的场景
+// 参数:
+//
+//	configName: 配置名称
+//	data: JSON 文件内容
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 解析失败时返回错误
+func (h *JsonConfigHandler) ReadToFrontEndDataListFromBytes(configName string, data []byte) (interface{}, error) {
+	return h.parseFrontEndDataList(configName, bytesSource, data)
+}
+
+// prepare 解析前的预处理，开启 AllowComments 时去掉注释与尾逗号
+func (h *JsonConfigHandler) prepare(data []byte) []byte {
+	if h.AllowComments {
+		return relaxJSON(data)
 	}
+	return data
+}
 
+// parseFrontEndDataList 将 JSON 内容解析为前端数据传输对象，configFileFullPath 仅用于错误信息
+func (h *JsonConfigHandler) parseFrontEndDataList(configName, configFileFullPath string, data []byte) (interface{}, error) {
+	data = h.prepare(data)
 	if len(bytes.TrimSpace(data)) == 0 {
 		return &dto.FrontEndConfigDto{
 			DataList:         nil,
 			Type:             h.TypeName(),
 			Suffix:           "json",
 			ConfigNameSimple: configName,
-		}
+		}, nil
+	}
+
+	if h.Lines {
+		return &dto.FrontEndConfigDto{
+			DataList:         unmarshalJSONLinesDataList(configName, configFileFullPath, data),
+			Type:             h.TypeName(),
+			Suffix:           "jsonl",
+			ConfigNameSimple: configName,
+		}, nil
+	}
+
+	schemaVersion, data, err := splitSchemaVersion(data)
+	if err != nil {
+		err = fmt.Errorf("parse json config %q (%s) failed: %w", configName, configFileFullPath, err)
+		logging.Get().Error(err, "解析JSON配置版本号失败", "configName", configName, "path", configFileFullPath)
+		return nil, err
 	}
 
 	dataList, topLevelKind, err := unmarshalJSONDataList(configName, configFileFullPath, data)
 	if err != nil {
 		err = fmt.Errorf("parse json config %q (%s) into data list failed: %w", configName, configFileFullPath, err)
-		slog.Error("解析JSON配置失败", "configName", configName, "path", configFileFullPath, "error", err, "topLevelKind", topLevelKind, "contentPreview", jsonContentPreview(data, 4096))
-		panic(err)
+		logging.Get().Error(err, "解析JSON配置失败", "configName", configName, "path", configFileFullPath, "topLevelKind", topLevelKind, "contentPreview", jsonContentPreview(data, 4096))
+		return nil, err
 	}
 
 	return &dto.FrontEndConfigDto{
@@ -102,7 +264,8 @@ func (h *JsonConfigHandler) ReadToFrontEndDataList(configName, configFileFullPat
 		Type:             h.TypeName(),
 		Suffix:           "json",
 		ConfigNameSimple: configName,
-	}
+		SchemaVersion:    schemaVersion,
+	}, nil
 }
 
 // ReadConfigAndORM 读取配置并转换为对象列表
@@ -116,27 +279,74 @@ func (h *JsonConfigHandler) ReadToFrontEndDataList(configName, configFileFullPat
 // 返回值:
 //
 //	[]interface{}: 配置对象实例列表
-func (h *JsonConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) []interface{} {
+//	error: 文件读取或解析失败时返回错误
+func (h *JsonConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) ([]interface{}, error) {
 	data, err := os.ReadFile(configFileFullPath)
 	if err != nil {
 		err = fmt.Errorf("read json config %q (%s) failed: %w", configName, configFileFullPath, err)
-		slog.Error("读取JSON配置文件失败", "configName", configName, "path", configFileFullPath, "error", err)
-		panic(err)
+		logging.Get().Error(err, "读取JSON配置文件失败", "configName", configName, "path", configFileFullPath)
+		return nil, err
 	}
+	return h.parseConfigAndORM(typ, configName, configFileFullPath, data)
+}
 
+// ReadConfigAndORMFromBytes 从内存中的 JSON 内容读取配置并转换为对象列表
+// 参数:
+//
+//	typ: 目标配置对象的类型
+//	configName: 配置名称
+//	data: JSON 文件内容
+//
+// 返回值:
+//
+//	[]interface{}: 配置对象实例列表
+//	error: 解析失败时返回错误
+func (h *JsonConfigHandler) ReadConfigAndORMFromBytes(typ reflect.Type, configName string, data []byte) ([]interface{}, error) {
+	return h.parseConfigAndORM(typ, configName, bytesSource, data)
+}
+
+// parseConfigAndORM 将 JSON 内容解析为 typ 类型的对象列表，configFileFullPath 仅用于错误信息
+func (h *JsonConfigHandler) parseConfigAndORM(typ reflect.Type, configName, configFileFullPath string, data []byte) ([]interface{}, error) {
+	data = h.prepare(data)
 	if len(bytes.TrimSpace(data)) == 0 {
-		return nil
+		return nil, nil
+	}
+	if h.Lines {
+		return parseJSONLinesAndORM(typ, configName, configFileFullPath, data)
+	}
+	_, data, err := splitSchemaVersion(data)
+	if err != nil {
+		err = fmt.Errorf("parse json config %q (%s) failed: %w", configName, configFileFullPath, err)
+		logging.Get().Error(err, "解析JSON配置版本号失败", "configName", configName, "path", configFileFullPath)
+		return nil, err
 	}
 
 	switch jsonTopLevelKind(data) {
 	case '{':
+		if idMap, ok := decodeJSONIdMap(data); ok {
+			result := make([]interface{}, 0, len(idMap))
+			for _, key := range sortedIdKeys(idMap) {
+				instancePtr := reflect.New(typ)
+				if err := json.Unmarshal(idMap[key], instancePtr.Interface()); err != nil {
+					err = fmt.Errorf("parse json config %q (%s) id %q into %s failed: %w", configName, configFileFullPath, key, typ.String(), err)
+					logging.Get().Error(err, "解析JSON配置失败", "configName", configName, "path", configFileFullPath, "targetType", typ.String(), "topLevelKind", "idMap", "contentPreview", jsonContentPreview(idMap[key], 4096))
+					return nil, err
+				}
+				if typ.Kind() == reflect.Struct {
+					fillIdFieldFromKey(instancePtr.Elem(), key)
+				}
+				result = append(result, instancePtr.Elem().Interface())
+			}
+			return result, nil
+		}
+
 		instancePtr := reflect.New(typ)
 		if err := json.Unmarshal(data, instancePtr.Interface()); err != nil {
 			err = fmt.Errorf("parse json config %q (%s) into %s failed: %w", configName, configFileFullPath, typ.String(), err)
-			slog.Error("解析JSON配置失败", "configName", configName, "path", configFileFullPath, "error", err, "targetType", typ.String(), "topLevelKind", "object", "contentPreview", jsonContentPreview(data, 4096))
-			panic(err)
+			logging.Get().Error(err, "解析JSON配置失败", "configName", configName, "path", configFileFullPath, "targetType", typ.String(), "topLevelKind", "object", "contentPreview", jsonContentPreview(data, 4096))
+			return nil, err
 		}
-		return []interface{}{instancePtr.Elem().Interface()}
+		return []interface{}{instancePtr.Elem().Interface()}, nil
 	case '[':
 		// 创建切片类型
 		sliceType := reflect.SliceOf(typ)
@@ -145,8 +355,8 @@ func (h *JsonConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, confi
 
 		if err := json.Unmarshal(data, slicePtr.Interface()); err != nil {
 			err = fmt.Errorf("parse json config %q (%s) into []%s failed: %w", configName, configFileFullPath, typ.String(), err)
-			slog.Error("解析JSON配置失败", "configName", configName, "path", configFileFullPath, "error", err, "targetType", typ.String(), "topLevelKind", "array", "contentPreview", jsonContentPreview(data, 4096))
-			panic(err)
+			logging.Get().Error(err, "解析JSON配置失败", "configName", configName, "path", configFileFullPath, "targetType", typ.String(), "topLevelKind", "array", "contentPreview", jsonContentPreview(data, 4096))
+			return nil, err
 		}
 
 		result := make([]interface{}, sliceVal.Len())
@@ -154,10 +364,10 @@ func (h *JsonConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, confi
 			result[i] = sliceVal.Index(i).Interface()
 		}
 
-		return result
+		return result, nil
 	default:
-		err = fmt.Errorf("json config %q (%s) must start with object or array, got %q", configName, configFileFullPath, jsonTopLevelKind(data))
-		slog.Error("JSON配置格式不正确", "configName", configName, "path", configFileFullPath, "error", err, "contentPreview", jsonContentPreview(data, 4096))
-		panic(err)
+		err := fmt.Errorf("json config %q (%s) must start with object or array, got %q", configName, configFileFullPath, jsonTopLevelKind(data))
+		logging.Get().Error(err, "JSON配置格式不正确", "configName", configName, "path", configFileFullPath, "contentPreview", jsonContentPreview(data, 4096))
+		return nil, err
 	}
 }
@@ -0,0 +1,77 @@
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/logging"
+)
+
+// jsonLine JSON Lines 中的一行非空内容
+type jsonLine struct {
+	number  int    // 行号，从 1 开始
+	content []byte // 去掉首尾空白后的内容
+}
+
+// splitJSONLines 按行拆分 JSON Lines 内容，跳过空行，兼容 \r\n 换行与 UTF-8 BOM
+func splitJSONLines(data []byte) []jsonLine {
+	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+	var lines []jsonLine
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		lines = append(lines, jsonLine{number: i + 1, content: line})
+	}
+	return lines
+}
+
+// unmarshalJSONLinesDataList 逐行解析 JSON Lines 为数据列表
+// 每行必须是一个 JSON 对象，解析失败的行记录行号后跳过，不影响其他行
+func unmarshalJSONLinesDataList(configName, configFileFullPath string, data []byte) []map[string]interface{} {
+	var dataList []map[string]interface{}
+	for _, line := range splitJSONLines(data) {
+		var item map[string]interface{}
+		if err := json.Unmarshal(line.content, &item); err != nil || item == nil {
+			if err == nil {
+				err = fmt.Errorf("line must be a json object")
+			}
+			err = fmt.Errorf("parse jsonl config %q (%s) line %d failed: %w", configName, configFileFullPath, line.number, err)
+			logging.Get().Error(err, "解析JSONL配置行失败，已跳过", "configName", configName, "path", configFileFullPath, "line", line.number, "contentPreview", jsonContentPreview(line.content, 256))
+			continue
+		}
+		dataList = append(dataList, item)
+	}
+	return dataList
+}
+
+// parseJSONLinesAndORM 逐行解析 JSON Lines 为 typ 类型的对象列表
+// 解析失败的行跳过，失败信息（含行号）收集到返回的 dto.ConversionErrors 中，此时对象列表仍然有效
+func parseJSONLinesAndORM(typ reflect.Type, configName, configFileFullPath string, data []byte) ([]interface{}, error) {
+	var result []interface{}
+	var convErrs dto.ConversionErrors
+	for _, line := range splitJSONLines(data) {
+		instancePtr := reflect.New(typ)
+		if err := json.Unmarshal(line.content, instancePtr.Interface()); err != nil {
+			logging.Get().Error(err, "解析JSONL配置行失败，已跳过", "configName", configName, "path", configFileFullPath, "line", line.number, "targetType", typ.String())
+			convErrs = append(convErrs, &dto.ConversionError{
+				ConfigName: configName,
+				RowIndex:   line.number,
+				ColumnName: "(整行)",
+				TargetType: typ.String(),
+				RawValue:   jsonContentPreview(line.content, 256),
+				Err:        err,
+			})
+			continue
+		}
+		result = append(result, instancePtr.Elem().Interface())
+	}
+	if len(convErrs) > 0 {
+		return result, convErrs
+	}
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package json
+
+import "bytes"
+
+// relaxJSON 容错预处理：去掉 // 行注释、/* */ 块注释与 } ] 前的尾逗号
+// 字符串内的 //、/* 与逗号保持不变；注释替换为空格（行注释保留换行），JSON Lines 与错误中的行号不受影响
+func relaxJSON(data []byte) []byte {
+	if bytes.IndexByte(data, '/') < 0 && bytes.IndexByte(data, ',') < 0 {
+		return data
+	}
+
+	out := make([]byte, 0, len(data))
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out = append(out, c)
+			switch c {
+			case '\\':
+				if i+1 < len(data) {
+					i++
+					out = append(out, data[i])
+				}
+			case '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case c == '"':
+			inString = true
+			out = append(out, c)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			// 行注释：跳到行尾，保留换行符
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				out = append(out, '\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			// 块注释：保留其中的换行符，行号不变
+			i += 2
+			for i < len(data) && !(data[i] == '*' && i+1 < len(data) && data[i+1] == '/') {
+				if data[i] == '\n' {
+					out = append(out, '\n')
+				}
+				i++
+			}
+			i++
+			out = append(out, ' ')
+		case c == '}' || c == ']':
+			out = dropTrailingComma(out)
+			out = append(out, c)
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// dropTrailingComma 去掉 out 末尾（忽略空白）的一个逗号
+func dropTrailingComma(out []byte) []byte {
+	j := len(out) - 1
+	for j >= 0 && (out[j] == ' ' || out[j] == '\t' || out[j] == '\r' || out[j] == '\n') {
+		j--
+	}
+	if j >= 0 && out[j] == ',' {
+		return append(out[:j], out[j+1:]...)
+	}
+	return out
+}
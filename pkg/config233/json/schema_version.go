@@ -0,0 +1,51 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SchemaVersionKey 顶层对象中声明 schema 版本号的字段名
+const SchemaVersionKey = "__version"
+
+// schemaVersionDataKey 声明版本号时存放数组形式配置数据的字段名
+const schemaVersionDataKey = "data"
+
+// splitSchemaVersion 从顶层对象中取出 __version 声明的 schema 版本号，返回去掉版本号后的配置内容
+// 支持两种写法：
+//   - {"__version": 2, "data": [...]}：只有 __version 与 data 两个字段时，data 的内容作为配置
+//   - {"__version": 2, "1001": {...}}：其余字段按 id 映射或单个对象照常解析，没有其他字段时视为空配置
+//
+// 没有声明版本号时返回 0 与原始内容
+func splitSchemaVersion(data []byte) (int, []byte, error) {
+	if jsonTopLevelKind(data) != '{' {
+		return 0, data, nil
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		// 交给后续解析报告格式错误
+		return 0, data, nil
+	}
+	rawVersion, ok := raw[SchemaVersionKey]
+	if !ok {
+		return 0, data, nil
+	}
+
+	var version int
+	if err := json.Unmarshal(rawVersion, &version); err != nil || version <= 0 {
+		return 0, nil, fmt.Errorf("%s must be a positive integer, got %s", SchemaVersionKey, string(rawVersion))
+	}
+
+	delete(raw, SchemaVersionKey)
+	if body, ok := raw[schemaVersionDataKey]; ok && len(raw) == 1 {
+		return version, body, nil
+	}
+	if len(raw) == 0 {
+		return version, []byte("[]"), nil
+	}
+	body, err := json.Marshal(raw)
+	if err != nil {
+		return 0, nil, err
+	}
+	return version, body, nil
+}
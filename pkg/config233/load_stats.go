@@ -0,0 +1,105 @@
+package config233
+
+import (
+	"sort"
+	"time"
+)
+
+// ConfigLoadStat 单个配置文件最近一次加载的统计
+type ConfigLoadStat struct {
+	FileName        string        // 配置文件路径
+	Format          string        // 文件格式：excel / json / tsv / csv / yaml / xml
+	RecordCount     int           // 记录条数
+	StructConverted bool          // 是否转换为已注册的结构体（未注册类型时保留为 map）
+	ParseDuration   time.Duration // 读取与解析耗时（不含写入内存与导出）
+	LastLoadTime    time.Time     // 最近一次加载完成的时间
+}
+
+// ConfigLoadSummary 所有已加载配置的统计汇总
+type ConfigLoadSummary struct {
+	FileCount          int           // 配置文件数
+	RecordCount        int           // 总记录条数
+	TotalParseDuration time.Duration // 各文件解析耗时之和（并行加载时大于实际墙钟耗时）
+	SlowestConfigs     []string      // 按解析耗时从高到低排序的配置名
+}
+
+// recordLoadStat 记录配置文件的加载统计
+// 参数:
+//
+//	configName: 配置名称
+//	filePath: 配置文件路径
+//	format: 文件格式
+//	recordCount: 记录条数
+//	parseDuration: 读取与解析耗时
+func (cm *ConfigManager233) recordLoadStat(configName, filePath, format string, recordCount int, parseDuration time.Duration) {
+	_, structConverted := cm.getRegisteredType(configName)
+
+	cm.loadStatsMu.Lock()
+	defer cm.loadStatsMu.Unlock()
+	if cm.loadStats == nil {
+		cm.loadStats = make(map[string]ConfigLoadStat)
+	}
+	cm.loadStats[configName] = ConfigLoadStat{
+		FileName:        filePath,
+		Format:          format,
+		RecordCount:     recordCount,
+		StructConverted: structConverted,
+		ParseDuration:   parseDuration,
+		LastLoadTime:    time.Now(),
+	}
+}
+
+// removeLoadStat 移除配置的加载统计（配置文件被删除时调用）
+func (cm *ConfigManager233) removeLoadStat(configName string) {
+	cm.loadStatsMu.Lock()
+	defer cm.loadStatsMu.Unlock()
+	delete(cm.loadStats, configName)
+}
+
+// clearLoadStats 清空所有加载统计
+func (cm *ConfigManager233) clearLoadStats() {
+	cm.loadStatsMu.Lock()
+	defer cm.loadStatsMu.Unlock()
+	cm.loadStats = make(map[string]ConfigLoadStat)
+}
+
+// GetLoadStats 获取每个配置最近一次加载的统计，用于定位拖慢启动的大表
+// 热重载后对应配置的统计会被更新，配置文件被删除后对应统计会被移除
+// 返回值:
+//
+//	map[string]ConfigLoadStat: 配置名 -> 加载统计（副本，可自由修改）
+func (cm *ConfigManager233) GetLoadStats() map[string]ConfigLoadStat {
+	cm.loadStatsMu.RLock()
+	defer cm.loadStatsMu.RUnlock()
+	result := make(map[string]ConfigLoadStat, len(cm.loadStats))
+	for configName, stat := range cm.loadStats {
+		result[configName] = stat
+	}
+	return result
+}
+
+// GetLoadStatsSummary 获取所有已加载配置的统计汇总
+// 返回值:
+//
+//	ConfigLoadSummary: 总文件数、总条数、总解析耗时，以及按耗时排序的配置名
+func (cm *ConfigManager233) GetLoadStatsSummary() ConfigLoadSummary {
+	stats := cm.GetLoadStats()
+
+	summary := ConfigLoadSummary{
+		FileCount:      len(stats),
+		SlowestConfigs: make([]string, 0, len(stats)),
+	}
+	for configName, stat := range stats {
+		summary.RecordCount += stat.RecordCount
+		summary.TotalParseDuration += stat.ParseDuration
+		summary.SlowestConfigs = append(summary.SlowestConfigs, configName)
+	}
+	sort.Slice(summary.SlowestConfigs, func(i, j int) bool {
+		a, b := stats[summary.SlowestConfigs[i]], stats[summary.SlowestConfigs[j]]
+		if a.ParseDuration != b.ParseDuration {
+			return a.ParseDuration > b.ParseDuration
+		}
+		return summary.SlowestConfigs[i] < summary.SlowestConfigs[j]
+	})
+	return summary
+}
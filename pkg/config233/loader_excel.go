@@ -1,70 +1,93 @@
 package config233
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
 	"github.com/neko233-com/config233-go/pkg/config233/excel"
 )
 
+// excelFormat Excel 配置在加载统计中的格式名，与 ExcelConfigHandler.TypeName 一致
+const excelFormat = "excel"
+
 // loadExcelConfigThreadSafe 线程安全的 Excel 配置加载（用于并行加载）
 func (cm *ConfigManager233) loadExcelConfigThreadSafe(filePath string) error {
+	return cm.readExcelConfig(filePath, cm.commitConfig)
+}
+
+// readExcelConfig 读取并解析 Excel 配置文件，解析结果交给 commit 写入
+func (cm *ConfigManager233) readExcelConfig(filePath string, commit configCommitFunc) error {
 	// 创建 Excel 处理器
-	handler := &excel.ExcelConfigHandler{}
+	handler := &excel.ExcelConfigHandler{ColumnNameNormalizer: cm.columnNameNormalizer()}
 
 	// 获取文件名（不含扩展名）作为配置名
 	fileName := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	startTime := time.Now()
 
 	// 读取前端数据格式（不需要锁）
-	configDto := handler.ReadToFrontEndDataList(fileName, filePath).(*dto.FrontEndConfigDto)
+	data, err := cm.readConfigBytes(filePath)
+	if err != nil {
+		return fmt.Errorf("load excel config %q (%s) failed: %w", fileName, filePath, err)
+	}
+	result, err := handler.ReadToFrontEndDataListFromBytes(fileName, data)
+	if err != nil {
+		return fmt.Errorf("load excel config %q (%s) failed: %w", fileName, filePath, err)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
 	if configDto.DataList == nil {
 		return nil // 空文件，跳过
 	}
+	return cm.commitExcelDto(fileName, filePath, configDto, startTime, commit)
+}
+
+// commitExcelDto 将一个工作表解析出的数据转换为已注册的结构体，交给 commit 写入并记录加载统计
+// 参数:
+//
+//	configName: 配置名称
+//	filePath: Excel 文件路径
+//	configDto: 工作表数据
+//	startTime: 开始读取文件的时间，用于统计解析耗时
+//	commit: 写入函数
+func (cm *ConfigManager233) commitExcelDto(configName, filePath string, configDto *dto.FrontEndConfigDto, startTime time.Time, commit configCommitFunc) error {
+	// 类型转换失败的单元格保留原始字符串，记录后照常加载
+	logConversionErrors(configDto.ConversionErrors)
+
+	if err := cm.checkSchemaVersion(configName, filePath, configDto.SchemaVersion); err != nil {
+		return err
+	}
 
-	// 转换为配置映射与切片（每条数据只转换一次，避免 AfterLoad 重复触发）
-	configMap := make(map[string]interface{})
-	slice := make([]interface{}, 0, len(configDto.DataList))
+	// 转换为配置映射与切片，重复 ID 按 SetDuplicateIdPolicy 的策略处理（每条数据只转换一次，避免 AfterLoad 重复触发）
+	items := cm.newConfigItemSet(configName, len(configDto.DataList))
 	for i, item := range configDto.DataList {
 		converted := any(item)
-		if c, err := cm.convertMapToRegisteredStruct(fileName, item); err == nil {
+		if c, err := cm.convertMapToRegisteredStruct(configName, item); err == nil {
 			converted = c
 		} else {
-			getLogger().Error(err, "转换配置项失败", "index", i, "configName", fileName, "data", item)
-		}
-
-		// 优先使用 id/ID/Id/itemId 字段作为配置 ID
-		var id string
-		if idVal, ok := item["id"]; ok && idVal != "" {
-			id = fmt.Sprintf("%v", idVal)
-		} else if idVal, ok := item["ID"]; ok && idVal != "" {
-			id = fmt.Sprintf("%v", idVal)
-		} else if idVal, ok := item["Id"]; ok && idVal != "" {
-			id = fmt.Sprintf("%v", idVal)
-		} else if idVal, ok := item["itemId"]; ok && idVal != "" {
-			id = fmt.Sprintf("%v", idVal)
-		}
-		if id != "" {
-			configMap[id] = converted
+			getLogger().Error(err, "转换配置项失败", "index", i, "configName", configName, "data", item)
 		}
 
-		slice = append(slice, converted)
+		// 优先使用 config233:"uid" 字段作为配置 ID，其次为 id/ID/Id 字段，找不到时使用第一列
+		items.add(extractItemId(converted, item, configDto.ColumnNames), converted)
 	}
 
-	// 加锁更新共享数据
-	cm.mutex.Lock()
-	cm.configs[fileName] = configDto.DataList
-	cm.configMaps[fileName] = configMap
-	cm.mutex.Unlock()
+	configMap, slice, err := items.result()
+	if err != nil {
+		return err
+	}
 
-	// 更新缓存（内部已有锁保护）
-	cm.setConfigCache(fileName, configMap, slice)
+	parseDuration := time.Since(startTime)
 
-	getLogger().Info("Excel配置加载完成", "configName", fileName, "count", len(slice))
+	// 校验通过后原子替换共享数据与缓存
+	if err := commit(configName, configDto.DataList, configMap, slice); err != nil {
+		return err
+	}
+	cm.recordLoadStat(configName, filePath, excelFormat, len(slice), parseDuration)
 
-	// 导出配置到文件（如果开启）
-	cm.ExportConfigToJSON(fileName, slice)
+	getLogger().Info("Excel配置加载完成", "configName", configName, "count", len(slice))
 
 	return nil
 }
@@ -82,3 +105,108 @@ func (cm *ConfigManager233) loadExcelConfig(filePath string) error {
 	// 直接调用线程安全版本
 	return cm.loadExcelConfigThreadSafe(filePath)
 }
+
+// LoadExcelAllSheets 把一个 Excel 文件中的每个工作表作为独立配置加载
+// 适用于把多个小配置表放在同一个 xlsx 不同工作表中的项目；隐藏的工作表与没有数据的工作表被跳过。
+// 配置名按已注册的类型确定：工作表名注册过类型（RegisterType / RegisterTypeWithName）时使用工作表名，
+// 否则使用 "文件名_工作表名"，例如 Tables.xlsx 中的 Shop 工作表未注册 Shop 时配置名为 Tables_Shop。
+// 每个工作表单独校验与写入，某个工作表失败不影响其他工作表。
+//
+// 加载与 ReloadConfigs 走同一套批量重载逻辑：回填配置引用、保存快照，对成功加载的配置触发 OnConfigLoadComplete、
+// 配置项变更通知与 SubscribeReload 事件。调用后该文件被记为多工作表文件，位于配置目录中时，
+// 之后的 LoadAllConfigs 与热重载同样按工作表加载，文件被删除时移除其中所有工作表的配置
+// 参数:
+//
+//	path: Excel 文件路径（设置了 fs.FS 时为 fs.FS 中的路径）
+//
+// 返回值:
+//
+//	error: 文件无法读取或解析时返回错误；部分工作表加载失败时返回汇总的错误
+func (cm *ConfigManager233) LoadExcelAllSheets(path string) error {
+	fileName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	cm.mutex.Lock()
+	if cm.sheetFiles == nil {
+		cm.sheetFiles = make(map[string][]string)
+	}
+	if _, ok := cm.sheetFiles[fileName]; !ok {
+		cm.sheetFiles[fileName] = nil
+	}
+	cm.mutex.Unlock()
+
+	failed := cm.reloadConfigFilesWithErrors([]string{fileName}, map[string]string{fileName: path}, manualReload)
+	errs := make([]error, 0, len(failed))
+	for _, f := range failed {
+		errs = append(errs, f.Err)
+	}
+	return errors.Join(errs...)
+}
+
+// isSheetFile 判断配置文件是否通过 LoadExcelAllSheets 按工作表加载
+func (cm *ConfigManager233) isSheetFile(fileName string) bool {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	_, ok := cm.sheetFiles[fileName]
+	return ok
+}
+
+// fileConfigNames 返回配置文件对应的配置名：多工作表文件为上次解析出的各工作表配置名，其他文件为文件名本身
+func (cm *ConfigManager233) fileConfigNames(fileName string) []string {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	if names, ok := cm.sheetFiles[fileName]; ok {
+		return append([]string(nil), names...)
+	}
+	return []string{fileName}
+}
+
+// readExcelAllSheets 读取 Excel 文件的所有可见工作表，每个工作表作为独立配置交给 commit 写入
+// 返回值:
+//
+//	[]string: 成功写入的配置名
+//	error: 文件无法读取或解析时返回错误；部分工作表失败时返回汇总的错误
+func (cm *ConfigManager233) readExcelAllSheets(path string, commit configCommitFunc) ([]string, error) {
+	fileName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	startTime := time.Now()
+
+	data, err := cm.readConfigBytes(path)
+	if err != nil {
+		return nil, fmt.Errorf("load excel config %q (%s) failed: %w", fileName, path, err)
+	}
+	handler := &excel.ExcelConfigHandler{ColumnNameNormalizer: cm.columnNameNormalizer()}
+	sheets, err := handler.ReadAllSheetsFromBytes(fileName, data)
+	if err != nil {
+		return nil, fmt.Errorf("load excel config %q (%s) failed: %w", fileName, path, err)
+	}
+
+	names := make([]string, 0, len(sheets))
+	loaded := make([]string, 0, len(sheets))
+	var errs []error
+	for _, sheet := range sheets {
+		configName := cm.sheetConfigName(fileName, sheet.SheetName)
+		names = append(names, configName)
+		if err := cm.commitExcelDto(configName, path, sheet.Config, startTime, commit); err != nil {
+			getLogger().Error(err, "加载Excel工作表失败", "path", path, "sheet", sheet.SheetName, "configName", configName)
+			errs = append(errs, fmt.Errorf("工作表 %s: %w", sheet.SheetName, err))
+			continue
+		}
+		loaded = append(loaded, configName)
+	}
+
+	// 记录文件中的工作表配置名，文件删除时据此移除配置
+	cm.mutex.Lock()
+	if cm.sheetFiles == nil {
+		cm.sheetFiles = make(map[string][]string)
+	}
+	cm.sheetFiles[fileName] = names
+	cm.mutex.Unlock()
+	return loaded, errors.Join(errs...)
+}
+
+// sheetConfigName 确定工作表对应的配置名：工作表名注册过类型时使用工作表名，否则为 "文件名_工作表名"
+func (cm *ConfigManager233) sheetConfigName(fileName, sheetName string) string {
+	if _, registered := cm.getRegisteredType(sheetName); registered {
+		return sheetName
+	}
+	return fileName + "_" + sheetName
+}
@@ -2,24 +2,34 @@ package config233
 
 import (
 	"fmt"
-	"log/slog"
-	"os"
 	"path/filepath"
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
 	jsonhandler "github.com/neko233-com/config233-go/pkg/config233/json"
 )
 
 // loadJsonConfigThreadSafe 线程安全的 JSON 配置加载（用于并行加载）
-func (cm *ConfigManager233) loadJsonConfigThreadSafe(filePath string) (err error) {
-	// 创建 JSON 处理器
-	handler := &jsonhandler.JsonConfigHandler{}
+func (cm *ConfigManager233) loadJsonConfigThreadSafe(filePath string) error {
+	return cm.readJsonConfig(filePath, cm.commitConfig)
+}
+
+// readJsonConfig 读取并解析 JSON 配置文件，解析结果交给 commit 写入
+func (cm *ConfigManager233) readJsonConfig(filePath string, commit configCommitFunc) (err error) {
+	// 创建 JSON 处理器，.jsonl 文件按 JSON Lines 逐行读取
+	handler := &jsonhandler.JsonConfigHandler{AllowComments: cm.isJsonAllowComments()}
+	jsonFormat := handler.TypeName()
+	if strings.EqualFold(filepath.Ext(filePath), ".jsonl") {
+		handler.Lines = true
+		jsonFormat = "jsonl"
+	}
 
 	// 获取文件名（不含扩展名）作为配置名
 	fileName := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
-	slog.Info("开始加载JSON配置", "configName", fileName, "path", filePath)
+	startTime := time.Now()
+	getLogger().Info("开始加载JSON配置", "configName", fileName, "path", filePath)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -29,7 +39,7 @@ func (cm *ConfigManager233) loadJsonConfigThreadSafe(filePath string) (err error
 			}
 			contentPreview := ""
 			topLevelKind := ""
-			if raw, readErr := os.ReadFile(filePath); readErr == nil {
+			if raw, readErr := cm.readConfigBytes(filePath); readErr == nil {
 				text := strings.TrimSpace(string(raw))
 				if len(text) > 4096 {
 					contentPreview = text[:4096] + "...(truncated)"
@@ -43,10 +53,9 @@ func (cm *ConfigManager233) loadJsonConfigThreadSafe(filePath string) (err error
 				contentPreview = fmt.Sprintf("<failed to read content: %v>", readErr)
 			}
 			err = fmt.Errorf("load json config %q (%s) failed: %w", fileName, filePath, panicErr)
-			slog.Error("JSON配置加载失败",
+			getLogger().Error(err, "JSON配置加载失败",
 				"configName", fileName,
 				"path", filePath,
-				"error", err,
 				"panic", panicErr,
 				"topLevelKind", topLevelKind,
 				"contentPreview", contentPreview,
@@ -56,15 +65,26 @@ func (cm *ConfigManager233) loadJsonConfigThreadSafe(filePath string) (err error
 	}()
 
 	// 读取前端数据格式（不需要锁）
-	configDto := handler.ReadToFrontEndDataList(fileName, filePath).(*dto.FrontEndConfigDto)
+	data, readErr := cm.readConfigBytes(filePath)
+	if readErr != nil {
+		return fmt.Errorf("load json config %q (%s) failed: %w", fileName, filePath, readErr)
+	}
+	result, readErr := handler.ReadToFrontEndDataListFromBytes(fileName, data)
+	if readErr != nil {
+		return fmt.Errorf("load json config %q (%s) failed: %w", fileName, filePath, readErr)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
 	if configDto.DataList == nil {
-		slog.Info("JSON配置为空，已跳过", "configName", fileName, "path", filePath)
+		getLogger().Info("JSON配置为空，已跳过", "configName", fileName, "path", filePath)
 		return nil // 空文件，跳过
 	}
 
-	// 转换为配置映射与切片（每条数据只转换一次，避免 AfterLoad 重复触发）
-	configMap := make(map[string]interface{})
-	slice := make([]interface{}, 0, len(configDto.DataList))
+	if err := cm.checkSchemaVersion(fileName, filePath, configDto.SchemaVersion); err != nil {
+		return err
+	}
+
+	// 转换为配置映射与切片，重复 ID 按 SetDuplicateIdPolicy 的策略处理（每条数据只转换一次，避免 AfterLoad 重复触发）
+	items := cm.newConfigItemSet(fileName, len(configDto.DataList))
 	for i, item := range configDto.DataList {
 		converted := any(item)
 		if c, err := cm.convertMapToRegisteredStruct(fileName, item); err == nil {
@@ -73,36 +93,25 @@ func (cm *ConfigManager233) loadJsonConfigThreadSafe(filePath string) (err error
 			getLogger().Error(err, "转换JSON配置项失败", "index", i, "configName", fileName, "data", item)
 		}
 
-		// 尝试从原始 map 中提取 ID（支持 "id", "ID", "Id" 等字段）
-		var id string
-		if idVal, ok := item["id"]; ok {
-			id = fmt.Sprintf("%v", idVal)
-		} else if idVal, ok := item["ID"]; ok {
-			id = fmt.Sprintf("%v", idVal)
-		} else if idVal, ok := item["Id"]; ok {
-			id = fmt.Sprintf("%v", idVal)
-		}
-		if id != "" {
-			configMap[id] = converted
-		}
+		// 提取 ID：优先使用 config233:"uid" 字段，其次从原始 map 中提取（支持 "id", "ID", "Id" 等字段）
+		items.add(extractItemId(converted, item, configDto.ColumnNames), converted)
+	}
 
-		slice = append(slice, converted)
+	configMap, slice, err := items.result()
+	if err != nil {
+		return err
 	}
 
-	// 加锁更新共享数据
-	cm.mutex.Lock()
-	cm.configs[fileName] = configDto.DataList
-	cm.configMaps[fileName] = configMap
-	cm.mutex.Unlock()
+	parseDuration := time.Since(startTime)
 
-	// 更新缓存（内部已有锁保护）
-	cm.setConfigCache(fileName, configMap, slice)
+	// 校验通过后原子替换共享数据与缓存
+	if err := commit(fileName, configDto.DataList, configMap, slice); err != nil {
+		return err
+	}
+	cm.recordLoadStat(fileName, filePath, jsonFormat, len(slice), parseDuration)
 
 	getLogger().Info("JSON配置加载完成", "configName", fileName, "count", len(slice))
 
-	// 导出配置到文件（如果开启）
-	cm.ExportConfigToJSON(fileName, slice)
-
 	return nil
 }
 
@@ -119,3 +128,27 @@ func (cm *ConfigManager233) loadJsonConfig(filePath string) error {
 	// 直接调用线程安全版本
 	return cm.loadJsonConfigThreadSafe(filePath)
 }
+
+// SetJsonAllowComments 设置 JSON 配置是否启用容错解析（链式调用）
+// 开启后 .json / .jsonl 文件中的 // 行注释、/* */ 块注释以及对象、数组末尾的尾逗号会在解析前去掉，
+// 字符串内的 // 不受影响；默认关闭，按标准 JSON 严格解析
+// 参数:
+//
+//	allow: 是否允许注释与尾逗号
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetJsonAllowComments(allow bool) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.jsonAllowComments = allow
+	return cm
+}
+
+// isJsonAllowComments 获取 JSON 配置是否启用容错解析
+func (cm *ConfigManager233) isJsonAllowComments() bool {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	return cm.jsonAllowComments
+}
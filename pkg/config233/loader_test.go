@@ -1,12 +1,16 @@
 package config233
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
 	"github.com/neko233-com/config233-go/pkg/config233/excel"
@@ -161,7 +165,11 @@ func TestLoaderExcel_GodLvUpConfig_ArrayField(t *testing.T) {
 	}
 
 	handler := &excel.ExcelConfigHandler{}
-	raw := handler.ReadToFrontEndDataList("GodLvUpConfig", destFile).(*dto.FrontEndConfigDto)
+	rawResult, err := handler.ReadToFrontEndDataList("GodLvUpConfig", destFile)
+	if err != nil {
+		t.Fatalf("读取 Excel 失败: %v", err)
+	}
+	raw := rawResult.(*dto.FrontEndConfigDto)
 	if len(raw.DataList) == 0 {
 		t.Fatal("Excel 解析结果为空")
 	}
@@ -295,3 +303,217 @@ func TestLoaderTSV_ThreadSafe(t *testing.T) {
 
 	t.Log("TSV 加载器线程安全测试通过")
 }
+
+// TestLoaderTSV_IdExtractionStable 测试 TSV 多次加载时 ID 映射稳定一致
+func TestLoaderTSV_IdExtractionStable(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// id 列不在第一列时应使用 id 列
+	withIdFile := filepath.Join(tempDir, "WithIdConfig.tsv")
+	withIdContent := "name\tvalue\tid\ttag\nsword\t100\t1\ta\nshield\t200\t2\tb\n"
+	if err := os.WriteFile(withIdFile, []byte(withIdContent), 0644); err != nil {
+		t.Fatalf("写入测试文件失败: %v", err)
+	}
+
+	// 没有 id 列时应使用第一列
+	noIdFile := filepath.Join(tempDir, "NoIdConfig.tsv")
+	noIdContent := "key\tvalue\tdesc\tremark\nmax_level\t100\tx\ty\nserver_name\tdemo\tx\ty\n"
+	if err := os.WriteFile(noIdFile, []byte(noIdContent), 0644); err != nil {
+		t.Fatalf("写入测试文件失败: %v", err)
+	}
+
+	for round := 0; round < 20; round++ {
+		manager := NewConfigManager233(tempDir)
+		if err := manager.loadTsvConfig(withIdFile); err != nil {
+			t.Fatalf("加载 TSV 配置失败: %v", err)
+		}
+		if err := manager.loadTsvConfig(noIdFile); err != nil {
+			t.Fatalf("加载 TSV 配置失败: %v", err)
+		}
+
+		manager.mutex.RLock()
+		withIdKeys := mapKeys(manager.configMaps["WithIdConfig"])
+		noIdKeys := mapKeys(manager.configMaps["NoIdConfig"])
+		manager.mutex.RUnlock()
+
+		if strings.Join(withIdKeys, ",") != "1,2" {
+			t.Fatalf("第 %d 次加载: 期望按 id 列建立映射 [1 2]，实际 %v", round, withIdKeys)
+		}
+		if strings.Join(noIdKeys, ",") != "max_level,server_name" {
+			t.Fatalf("第 %d 次加载: 期望按第一列建立映射 [max_level server_name]，实际 %v", round, noIdKeys)
+		}
+	}
+}
+
+// TestExtractConfigId 测试 ID 提取优先级
+func TestExtractConfigId(t *testing.T) {
+	cases := []struct {
+		name    string
+		item    map[string]interface{}
+		columns []string
+		want    string
+	}{
+		{"小写 id 优先", map[string]interface{}{"key": "k", "id": 1, "ID": 2}, []string{"key"}, "1"},
+		{"大写 ID", map[string]interface{}{"key": "k", "ID": 2}, []string{"key"}, "2"},
+		{"首字母大写 Id", map[string]interface{}{"Id": int64(3)}, nil, "3"},
+		{"空 id 回退第一列", map[string]interface{}{"id": "", "itemId": 1001}, []string{"itemId", "id"}, "1001"},
+		{"没有 id 使用第一列", map[string]interface{}{"key": "max_level", "value": "1"}, []string{"key", "value"}, "max_level"},
+		{"都没有返回空", map[string]interface{}{"value": "1"}, nil, ""},
+	}
+	for _, c := range cases {
+		if got := extractConfigId(c.item, c.columns); got != c.want {
+			t.Errorf("%s: 期望 %q，实际 %q", c.name, c.want, got)
+		}
+	}
+}
+
+// TestExtractItemId 测试 config233:"uid" 字段优先于 id 字段约定
+func TestExtractItemId(t *testing.T) {
+	type uidItem struct {
+		Name    string `json:"name"`
+		SkillId int    `json:"skillId" config233:"uid"`
+	}
+	type embeddedUidItem struct {
+		uidItem
+		Id int `json:"id"`
+	}
+	type strUidItem struct {
+		Code string `json:"code" config233:"uid"`
+	}
+	item := map[string]interface{}{"name": "fire", "id": 1, "skillId": 1001}
+	columns := []string{"name", "id", "skillId"}
+
+	cases := []struct {
+		name      string
+		converted interface{}
+		want      string
+	}{
+		{"uid 字段优先于 id", &uidItem{Name: "fire", SkillId: 1001}, "1001"},
+		{"嵌入结构体中的 uid 字段", &embeddedUidItem{uidItem: uidItem{SkillId: 1002}, Id: 1}, "1002"},
+		{"uid 为零值仍使用 uid", &uidItem{}, "0"},
+		{"uid 为空字符串回退 id", &strUidItem{}, "1"},
+		{"没有 uid 字段回退 id", &struct{ Id int }{Id: 1}, "1"},
+		{"转换失败回退 id", item, "1"},
+	}
+	for _, c := range cases {
+		if got := extractItemId(c.converted, item, columns); got != c.want {
+			t.Errorf("%s: 期望 %q，实际 %q", c.name, c.want, got)
+		}
+	}
+}
+
+func mapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TestRunConfigFileWorkers 测试并行加载的 worker 数不超过上限，且每个文件只处理一次
+func TestRunConfigFileWorkers(t *testing.T) {
+	files := make([]configFile, 50)
+	for i := range files {
+		files[i] = configFile{path: fmt.Sprintf("Config%d.json", i), ext: ".json"}
+	}
+
+	for _, workers := range []int{1, 3, 100} {
+		var (
+			mu        sync.Mutex
+			running   int
+			maxActive int
+			seen      = make(map[string]int)
+		)
+		<-runConfigFileWorkers(context.Background(), files, workers, func(f configFile) {
+			mu.Lock()
+			running++
+			if running > maxActive {
+				maxActive = running
+			}
+			seen[f.path]++
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+		})
+
+		if maxActive > workers {
+			t.Errorf("workers=%d: 同时运行的任务数 %d 超过上限", workers, maxActive)
+		}
+		if len(seen) != len(files) {
+			t.Errorf("workers=%d: 期望处理 %d 个文件，实际 %d 个", workers, len(files), len(seen))
+		}
+		for path, n := range seen {
+			if n != 1 {
+				t.Errorf("workers=%d: %s 被处理了 %d 次", workers, path, n)
+			}
+		}
+	}
+}
+
+// TestLimitExcelFiles 测试同时处理的 Excel 文件不超过上限，其他格式不受限制
+func TestLimitExcelFiles(t *testing.T) {
+	files := make([]configFile, 60)
+	for i := range files {
+		ext := ".json"
+		if i%2 == 0 {
+			ext = ".xlsx"
+		}
+		files[i] = configFile{path: fmt.Sprintf("Config%d%s", i, ext), ext: ext}
+	}
+
+	cases := []struct {
+		workers, excel, want int
+	}{
+		{workers: 16, excel: 0, want: DefaultExcelLoadConcurrency}, // 默认上限
+		{workers: 16, excel: 2, want: 2},
+		{workers: 3, excel: 10, want: 3}, // 不超过整体 worker 数
+	}
+	for _, c := range cases {
+		cm := NewStandaloneConfigManager233(t.TempDir()).SetLoadConcurrency(c.workers).SetExcelLoadConcurrency(c.excel)
+
+		var (
+			mu                    sync.Mutex
+			excelRunning, running int
+			maxExcel, maxRunning  int
+			processed             int
+		)
+		<-runConfigFileWorkers(context.Background(), files, cm.loadWorkerCount(), cm.limitExcelFiles(context.Background(), func(f configFile) {
+			mu.Lock()
+			running++
+			if f.ext == ".xlsx" {
+				excelRunning++
+			}
+			maxRunning = max(maxRunning, running)
+			maxExcel = max(maxExcel, excelRunning)
+			processed++
+			mu.Unlock()
+
+			time.Sleep(2 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			if f.ext == ".xlsx" {
+				excelRunning--
+			}
+			mu.Unlock()
+		}))
+
+		if maxExcel > c.want {
+			t.Errorf("workers=%d excel=%d: 同时处理的 Excel 数 %d 超过上限 %d", c.workers, c.excel, maxExcel, c.want)
+		}
+		if maxRunning > c.workers {
+			t.Errorf("workers=%d excel=%d: 同时运行的任务数 %d 超过 worker 数", c.workers, c.excel, maxRunning)
+		}
+		if c.workers > c.want && maxRunning <= c.want {
+			t.Errorf("workers=%d excel=%d: 轻量格式不应受 Excel 上限限制，最大并发仅 %d", c.workers, c.excel, maxRunning)
+		}
+		if processed != len(files) {
+			t.Errorf("workers=%d excel=%d: 期望处理 %d 个文件，实际 %d 个", c.workers, c.excel, len(files), processed)
+		}
+	}
+}
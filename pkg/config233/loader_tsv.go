@@ -4,76 +4,72 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
 	"github.com/neko233-com/config233-go/pkg/config233/tsv"
 )
 
 // loadTsvConfigThreadSafe 线程安全的 TSV 配置加载（用于并行加载）
+// .csv 文件使用逗号作为分隔符，其余使用制表符
 func (cm *ConfigManager233) loadTsvConfigThreadSafe(filePath string) error {
+	return cm.readTsvConfig(filePath, cm.commitConfig)
+}
+
+// readTsvConfig 读取并解析 TSV 配置文件，解析结果交给 commit 写入
+func (cm *ConfigManager233) readTsvConfig(filePath string, commit configCommitFunc) error {
 	// 创建 TSV 处理器
-	handler := &tsv.TsvConfigHandler{}
+	handler := &tsv.TsvConfigHandler{ColumnNameNormalizer: cm.columnNameNormalizer()}
+	tsvFormat := handler.TypeName()
+	if strings.EqualFold(filepath.Ext(filePath), ".csv") {
+		handler.Delimiter = ','
+		tsvFormat = "csv"
+	}
 
 	// 获取文件名（不含扩展名）作为配置名
 	fileName := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	startTime := time.Now()
 
 	// 读取前端数据格式（不需要锁）
-	configDto := handler.ReadToFrontEndDataList(fileName, filePath).(*dto.FrontEndConfigDto)
+	data, err := cm.readConfigBytes(filePath)
+	if err != nil {
+		return fmt.Errorf("load tsv config %q (%s) failed: %w", fileName, filePath, err)
+	}
+	result, err := handler.ReadToFrontEndDataListFromBytes(fileName, data)
+	if err != nil {
+		return fmt.Errorf("load tsv config %q (%s) failed: %w", fileName, filePath, err)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
 	if configDto.DataList == nil {
 		return nil // 空文件，跳过
 	}
 
-	// 转换为配置映射
-	configMap := make(map[string]interface{})
-	for _, item := range configDto.DataList {
-		// 使用第一列作为 ID（如果存在的话）
-		var id string
-		for _, v := range item {
-			if id == "" {
-				if str, ok := v.(string); ok {
-					id = str
-				} else {
-					id = fmt.Sprintf("%v", v)
-				}
-			}
-			break
-		}
-		if id != "" {
-			// 如果有注册的类型，转换为具体结构体
-			if converted, err := cm.convertMapToRegisteredStruct(fileName, item); err == nil {
-				configMap[id] = converted
-			} else {
-				// 转换失败则使用原始 map
-				configMap[id] = item
-			}
-		}
-	}
-
-	// Convert to []interface{}
-	slice := make([]interface{}, len(configDto.DataList))
-	for i, v := range configDto.DataList {
-		// 尝试转换为注册的结构体类型
-		if converted, err := cm.convertMapToRegisteredStruct(fileName, v); err == nil {
-			slice[i] = converted
-			getLogger().Info("成功转换TSV配置项", "index", i, "configName", fileName, "itemId", v["itemId"])
+	// 转换为配置映射与切片，重复 ID 按 SetDuplicateIdPolicy 的策略处理（每条数据只转换一次，map 与 slice 共享同一实例，避免 AfterLoad 重复触发）
+	items := cm.newConfigItemSet(fileName, len(configDto.DataList))
+	for i, item := range configDto.DataList {
+		converted := any(item)
+		if c, err := cm.convertMapToRegisteredStruct(fileName, item); err == nil {
+			converted = c
 		} else {
-			// 转换失败则使用原始 map
-			slice[i] = v
-			getLogger().Error(err, "转换TSV配置项失败", "index", i, "configName", fileName, "data", v)
+			getLogger().Error(err, "转换TSV配置项失败", "index", i, "configName", fileName, "data", item)
 		}
+
+		// 优先使用 config233:"uid" 字段作为配置 ID，其次为 id/ID/Id 字段，找不到时使用第一列
+		items.add(extractItemId(converted, item, configDto.ColumnNames), converted)
 	}
 
-	// 加锁更新共享数据
-	cm.mutex.Lock()
-	cm.configs[fileName] = configDto.DataList
-	cm.configMaps[fileName] = configMap
-	cm.mutex.Unlock()
+	configMap, slice, err := items.result()
+	if err != nil {
+		return err
+	}
 
-	// 更新缓存（内部已有锁保护）
-	cm.setConfigCache(fileName, configMap, slice)
+	parseDuration := time.Since(startTime)
 
-	// 导出配置到文件（如果开启）
-	cm.ExportConfigToJSON(fileName, slice)
+	// 校验通过后原子替换共享数据与缓存
+	if err := commit(fileName, configDto.DataList, configMap, slice); err != nil {
+		return err
+	}
+	cm.recordLoadStat(fileName, filePath, tsvFormat, len(slice), parseDuration)
 
 	return nil
 }
@@ -0,0 +1,85 @@
+package config233
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	yamlhandler "github.com/neko233-com/config233-go/pkg/config233/yaml"
+)
+
+// loadYamlConfigThreadSafe 线程安全的 YAML 配置加载（用于并行加载）
+func (cm *ConfigManager233) loadYamlConfigThreadSafe(filePath string) error {
+	return cm.readYamlConfig(filePath, cm.commitConfig)
+}
+
+// readYamlConfig 读取并解析 YAML 配置文件，解析结果交给 commit 写入
+func (cm *ConfigManager233) readYamlConfig(filePath string, commit configCommitFunc) error {
+	// 创建 YAML 处理器
+	handler := &yamlhandler.YamlConfigHandler{}
+
+	// 获取文件名（不含扩展名）作为配置名
+	fileName := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	startTime := time.Now()
+
+	// 读取前端数据格式（不需要锁）
+	data, err := cm.readConfigBytes(filePath)
+	if err != nil {
+		return fmt.Errorf("load yaml config %q (%s) failed: %w", fileName, filePath, err)
+	}
+	result, err := handler.ReadToFrontEndDataListFromBytes(fileName, data)
+	if err != nil {
+		return fmt.Errorf("load yaml config %q (%s) failed: %w", fileName, filePath, err)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
+	if configDto.DataList == nil {
+		return nil // 空文件，跳过
+	}
+
+	// 转换为配置映射与切片，重复 ID 按 SetDuplicateIdPolicy 的策略处理（每条数据只转换一次，避免 AfterLoad 重复触发）
+	items := cm.newConfigItemSet(fileName, len(configDto.DataList))
+	for i, item := range configDto.DataList {
+		converted := any(item)
+		if c, err := cm.convertMapToRegisteredStruct(fileName, item); err == nil {
+			converted = c
+		} else {
+			getLogger().Error(err, "转换YAML配置项失败", "index", i, "configName", fileName, "data", item)
+		}
+
+		// 提取 ID：优先使用 config233:"uid" 字段，其次从原始 map 中提取（支持 "id", "ID", "Id" 等字段）
+		items.add(extractItemId(converted, item, configDto.ColumnNames), converted)
+	}
+
+	configMap, slice, err := items.result()
+	if err != nil {
+		return err
+	}
+
+	parseDuration := time.Since(startTime)
+
+	// 校验通过后原子替换共享数据与缓存
+	if err := commit(fileName, configDto.DataList, configMap, slice); err != nil {
+		return err
+	}
+	cm.recordLoadStat(fileName, filePath, handler.TypeName(), len(slice), parseDuration)
+
+	getLogger().Info("YAML配置加载完成", "configName", fileName, "count", len(slice))
+
+	return nil
+}
+
+// loadYamlConfig 从YAML文件加载配置
+// 使用 YAML 处理器读取并解析 YAML 配置文件
+// 参数:
+//
+//	filePath: YAML 配置文件的路径
+//
+// 返回值:
+//
+//	error: 加载过程中的错误
+func (cm *ConfigManager233) loadYamlConfig(filePath string) error {
+	// 直接调用线程安全版本
+	return cm.loadYamlConfigThreadSafe(filePath)
+}
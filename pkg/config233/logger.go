@@ -1,49 +1,83 @@
 package config233
 
 import (
-	"fmt"
+	"context"
+	"log/slog"
+
+	"github.com/neko233-com/config233-go/pkg/config233/internal/logging"
 )
 
 // Logger 日志接口
-type Logger interface {
-	Info(msg string, keysAndValues ...interface{})
-	Error(err error, msg string, keysAndValues ...interface{})
-}
+// 方法签名与 logr.Logger 一致，logr.Logger（包括 zapr、funcr 等实现）可以直接传给 SetLogger
+type Logger = logging.Logger
 
 // ConsoleLogger 默认的控制台日志实现
-type ConsoleLogger struct{}
+type ConsoleLogger = logging.ConsoleLogger
 
-func (l *ConsoleLogger) Info(msg string, keysAndValues ...interface{}) {
-	kvStr := ""
-	if len(keysAndValues) > 0 {
-		kvStr = fmt.Sprintf(" %v", keysAndValues)
-	}
-	fmt.Printf("[INFO] %s%s\n", msg, kvStr)
+// SetLogger 设置全局日志实现
+// 对根包以及 json、excel 等处理器子包同时生效，传入 nil 时恢复默认的 ConsoleLogger
+// 参数:
+//
+//	logger: 日志实现，例如 logr.Logger 或 NewSlogLogger 返回的适配器
+func SetLogger(logger Logger) {
+	logging.Set(logger)
 }
 
-func (l *ConsoleLogger) Error(err error, msg string, keysAndValues ...interface{}) {
-	kvStr := ""
-	if len(keysAndValues) > 0 {
-		kvStr = fmt.Sprintf(" %v", keysAndValues)
-	}
-	if err != nil {
-		fmt.Printf("\033[31m[ERROR] %s: %v%s\033[0m\n", msg, err, kvStr)
-	} else {
-		fmt.Printf("\033[31m[ERROR] %s%s\033[0m\n", msg, kvStr)
-	}
-}
+// LogLevel 日志级别
+type LogLevel = logging.Level
 
-// SetLogger 设置全局日志实现
-var globalLogger Logger = &ConsoleLogger{}
+const (
+	// LogLevelInfo 输出 Info 和 Error 日志（默认）
+	LogLevelInfo = logging.LevelInfo
+	// LogLevelError 只输出 Error 日志，用于关闭加载、热重载过程中的 Info 输出
+	LogLevelError = logging.LevelError
+	// LogLevelSilent 不输出任何日志
+	LogLevelSilent = logging.LevelSilent
+)
 
-func SetLogger(logger Logger) {
-	globalLogger = logger
+// SetLogLevel 设置日志级别
+// 对默认的 ConsoleLogger 和通过 SetLogger 设置的日志实现都生效
+// 参数:
+//
+//	level: 日志级别，如 LogLevelError 只保留错误日志
+func SetLogLevel(level LogLevel) {
+	logging.SetLevel(level)
 }
 
 // getLogger 获取当前日志实现
 func getLogger() Logger {
-	if globalLogger == nil {
-		return &ConsoleLogger{}
+	return logging.Get()
+}
+
+// slogLogger 标准库 log/slog 的适配器
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger 将标准库 *slog.Logger 适配为 Logger
+// 参数:
+//
+//	logger: slog 日志器，为 nil 时使用 slog.Default()
+//
+// 返回值:
+//
+//	Logger: 可传给 SetLogger 的日志实现
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &slogLogger{logger: logger}
+}
+
+// Info 输出 Info 级别日志
+func (l *slogLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.Info(msg, keysAndValues...)
+}
+
+// Error 输出 Error 级别日志，err 非 nil 时以 "error" 字段附加
+func (l *slogLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	if err != nil {
+		keysAndValues = append([]interface{}{"error", err}, keysAndValues...)
 	}
-	return globalLogger
+	l.logger.Log(context.Background(), slog.LevelError, msg, keysAndValues...)
 }
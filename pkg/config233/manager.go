@@ -1,11 +1,18 @@
 package config233
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,30 +20,79 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
 )
 
 // ConfigManager233 全新的配置管理器，支持热重载
 // 提供简化的配置管理接口，支持多种配置格式的自动加载和热重载
-// 内部使用 Config233 进行文件监听和配置处理
+// 字段注入热更新（RegisterForHotUpdate）复用 Config233 的仓库与注入实现，数据与泛型查询共用同一份缓存
 type ConfigManager233 struct {
-	mutex            sync.RWMutex                      // 读写锁，保证线程安全
-	configs          map[string]interface{}            // 配置名 -> 配置数据映射
-	configMaps       map[string]map[string]interface{} // 配置名 -> (ID -> 配置数据) 映射
-	configDir        string                            // 配置目录路径
-	reloadFuncs      []func()                          // 配置重载时的回调函数列表
-	businessManagers []IBusinessConfigManager          // 业务配置管理器列表
-	watcher          *fsnotify.Watcher                 // 文件监听器
-	globalIdMaps     atomic.Value                      // 缓存 ID -> interface{} (存储 *map[string]map[string]interface{})
-	globalSlices     atomic.Value                      // 缓存 slice []interface{} (存储 *map[string][]interface{})
-	registeredTypes  map[string]reflect.Type           // 已注册的类型
-	registerTypeMu   sync.RWMutex                      // 保护 registeredTypes
-	isStarted        atomic.Bool                       // 是否已启动，启动后不允许修改配置目录
-	isFirstLoadDone  atomic.Bool                       // 首次加载是否完成
-	lastLoadTimeMs   atomic.Int64                      // 最后一次加载配置的时间戳（毫秒）
+	mutex             sync.RWMutex                      // 读写锁，保证线程安全
+	configs           map[string]interface{}            // 配置名 -> 配置数据映射
+	configMaps        map[string]map[string]interface{} // 配置名 -> (ID -> 配置数据) 映射
+	configDir         string                            // 配置目录路径
+	fsys              fs.FS                             // 配置文件系统（如 embed.FS），为 nil 时直接读取磁盘
+	extraConfigDirs   []string                          // 通过 AddConfigDir 追加的配置目录，按加入顺序排列
+	dirConflict       ConfigDirConflictPolicy           // 多个目录出现同名配置时的处理策略
+	duplicateName     DuplicateNamePolicy               // 同一目录内出现同名配置文件时的处理策略
+	duplicateId       DuplicateIdPolicy                 // 同一配置文件内出现重复 ID 时的处理策略
+	loadConcurrency   int                               // 并行加载的最大 worker 数，0 表示使用 runtime.NumCPU()
+	excelConcurrency  int                               // 同时打开的 Excel 文件数上限，0 表示使用 DefaultExcelLoadConcurrency
+	jsonAllowComments bool                              // JSON 配置是否允许注释与尾逗号
+	envExpand         EnvExpandPolicy                   // 字符串字段中 ${NAME} 占位符的展开策略
+	columnNormalizer  func(raw string) string           // Excel/TSV 表头列名的规范化函数，为 nil 时不转换
+	schemaMin         int                               // 支持的最低 schema 版本，与 schemaMax 都为 0 时不检查
+	schemaMax         int                               // 支持的最高 schema 版本，0 表示不限制上限
+	schemaPolicy      SchemaVersionPolicy               // schema 版本超出支持范围时的处理策略
+	sheetFiles        map[string][]string               // 通过 LoadExcelAllSheets 加载的 Excel 文件名 -> 各工作表的配置名，这些文件按工作表加载与重载
+	remoteTimeout     time.Duration                     // 拉取远程配置的单次请求超时，0 表示使用默认值
+	remoteAttempts    int                               // 拉取远程配置的最大请求次数（含第一次），0 表示使用默认值
+	remoteRetryDelay  time.Duration                     // 拉取远程配置两次重试之间的间隔，0 表示使用默认值
+	remotePolls       map[string]*remotePoll            // 配置名 -> 远程配置轮询任务
+	remotePollMu      sync.Mutex                        // 保护 remotePolls
+	reloadFuncs       []func()                          // 配置重载时的回调函数列表
+	businessManagers  []IBusinessConfigManager          // 业务配置管理器列表（按优先级从高到低排列，同优先级按注册顺序）
+	businessPriority  []int                             // 与 businessManagers 一一对应的优先级
+	crossValidators   []CrossValidatorFunc              // 全量加载完成后执行的跨配置校验函数
+	watcher           *fsnotify.Watcher                 // 文件监听器
+	watchMu           sync.Mutex                        // 保护文件监听的启动和停止
+	watchDone         chan struct{}                     // 关闭后通知监听 goroutine 退出
+	watchExited       chan struct{}                     // 监听 goroutine 退出后关闭
+	hotReload         *hotReloadState                   // 当前监听使用的热重载状态
+	reloadMu          sync.Mutex                        // 串行化批量重载，热重载、ReloadConfigs 与 TriggerReloadAndWait 共用
+	reloadSubs        []chan ReloadEvent                // SubscribeReload 的订阅者，按订阅顺序排列
+	reloadSubMu       sync.Mutex                        // 保护 reloadSubs
+	reloadBatchDelay  time.Duration                     // 热重载批量延迟时间，0 表示使用默认值
+	reloadCooldown    time.Duration                     // 热重载冷却时间，0 表示使用默认值
+	stableInterval    time.Duration                     // 热重载前检测文件是否写完的间隔，0 表示使用默认值
+	stableChecks      int                               // 热重载前检测文件是否写完的最大次数，0 表示使用默认值
+	globalIdMaps      atomic.Value                      // 缓存 ID -> interface{} (存储 *map[string]map[string]interface{})
+	globalSlices      atomic.Value                      // 缓存 slice []interface{} (存储 *map[string][]interface{})
+	registeredTypes   map[string]reflect.Type           // 已注册的类型
+	typeConfigNames   sync.Map                          // 类型 -> 配置名（通过 RegisterTypeWithName 显式指定时），泛型查询时无锁读取
+	registerTypeMu    sync.RWMutex                      // 保护 registeredTypes
+	isStarted         atomic.Bool                       // 是否已启动，启动后不允许修改配置目录
+	isFirstLoadDone   atomic.Bool                       // 首次加载是否完成
+	lastLoadTimeMs    atomic.Int64                      // 最后一次加载配置的时间戳（毫秒）
+	indexCache        sync.Map                          // 二级索引缓存 indexCacheKey -> *indexCacheEntry
+	cacheStats        cacheCounters                     // 配置缓存的命中、未命中与重建统计
+	configRefs        sync.Map                          // 配置对象 -> config233_ref 字段中记录的原始 ID
+	missingTypeWarned sync.Map                          // 已提示过未加载的配置名，GetConfigList 查询不到时每个配置只提示一次
+	loadStats         map[string]ConfigLoadStat         // 配置名 -> 最近一次加载统计
+	loadStatsMu       sync.RWMutex                      // 保护 loadStats
+	snapshots         []*configSnapshot                 // 历史快照，最旧的在前
+	snapshotSeq       SnapshotID                        // 最近一次分配的快照编号
+	snapshotLimit     int                               // 保留的快照份数，0 表示使用默认值
+	snapshotMu        sync.Mutex                        // 保护 snapshots 与 snapshotSeq
+	hotUpdateHolders  map[string][]hotUpdateHolder      // 配置名 -> 热更新持有器
+	hotUpdateHolderMu sync.Mutex                        // 保护 hotUpdateHolders
+	bridge            *Config233                        // RegisterForHotUpdate 复用的 Config233，其仓库由加载完成后的缓存写入
+	bridgeMu          sync.Mutex                        // 保护 bridge
 
 	// 导出配置相关
 	loadDoneWriteConfigFileDir string // 导出配置文件的目录
 	isOpenWriteTempFile        bool   // 是否开启导出功能
+	isOpenWriteExcelFile       bool   // 是否开启导出 Excel 功能
 }
 
 var (
@@ -56,23 +112,45 @@ func GetInstance() *ConfigManager233 {
 		if configDir == "" {
 			configDir = "config"
 		}
-		instance = &ConfigManager233{
-			configs:          make(map[string]interface{}),
-			configMaps:       make(map[string]map[string]interface{}),
-			configDir:        configDir,
-			reloadFuncs:      make([]func(), 0),
-			businessManagers: make([]IBusinessConfigManager, 0),
-			watcher:          nil,
-			registeredTypes:  make(map[string]reflect.Type),
-		}
-
-		// 初始化 atomic.Value
-		instance.globalIdMaps.Store(&map[string]map[string]interface{}{})
-		instance.globalSlices.Store(&map[string][]interface{}{})
+		instance = newConfigManager233(configDir)
 	})
 	return instance
 }
 
+// NewStandaloneConfigManager233 创建独立于全局单例的配置管理器
+// 多个实例之间互不影响，适合测试或同一进程加载多套配置的场景
+// 读取配置请使用 GetConfigByIdFrom、GetConfigListFrom 等显式传入管理器的函数，
+// 注册类型请使用 cm.RegisterType，RegisterType[T] 等全局便捷函数只作用于全局单例
+// 参数:
+//
+//	configDir: 配置文件的目录路径
+//
+// 返回值:
+//
+//	*ConfigManager233: 新建的配置管理器实例
+func NewStandaloneConfigManager233(configDir string) *ConfigManager233 {
+	return newConfigManager233(configDir)
+}
+
+// newConfigManager233 创建并初始化配置管理器
+func newConfigManager233(configDir string) *ConfigManager233 {
+	cm := &ConfigManager233{
+		configs:          make(map[string]interface{}),
+		configMaps:       make(map[string]map[string]interface{}),
+		configDir:        configDir,
+		reloadFuncs:      make([]func(), 0),
+		businessManagers: make([]IBusinessConfigManager, 0),
+		watcher:          nil,
+		registeredTypes:  make(map[string]reflect.Type),
+		bridge:           NewConfig233(),
+	}
+
+	// 初始化 atomic.Value
+	cm.globalIdMaps.Store(&map[string]map[string]interface{}{})
+	cm.globalSlices.Store(&map[string][]interface{}{})
+	return cm
+}
+
 // Instance 全局配置管理器实例（已废弃，请使用 GetInstance()）
 // 提供单例模式的全局配置管理器，方便快速访问
 var Instance *ConfigManager233
@@ -102,20 +180,92 @@ func (cm *ConfigManager233) SetConfigDir(configDir string) (*ConfigManager233, e
 	return cm, nil
 }
 
+// ConfigDirConflictPolicy 多个配置目录中出现同名配置时的处理策略
+type ConfigDirConflictPolicy int
+
+const (
+	// ConfigDirConflictOverride 后加入的目录覆盖先加入的目录（默认），适合"基础表 + 覆盖表"的叠加
+	ConfigDirConflictOverride ConfigDirConflictPolicy = iota
+	// ConfigDirConflictError 同名配置出现在多个目录时视为冲突，LoadAllConfigs 返回错误且不加载任何配置
+	ConfigDirConflictError
+)
+
+// AddConfigDir 追加一个配置目录（链式调用）
+// 可多次调用，LoadAllConfigs 按 SetConfigDir 设置的主目录、再按加入顺序遍历所有目录，StartWatching 监听所有目录
+// 同名配置的处理方式由 SetConfigDirConflictPolicy 决定，默认后加入的目录覆盖先加入的目录
+// 只能在启动前调用，启动后调用会返回错误
+// 参数:
+//
+//	dir: 配置文件的目录路径，重复加入的目录会被忽略
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+//	error: 如果已启动则返回错误
+func (cm *ConfigManager233) AddConfigDir(dir string) (*ConfigManager233, error) {
+	if cm.isStarted.Load() {
+		return cm, fmt.Errorf("配置管理器已启动，不允许添加配置目录")
+	}
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	if filepath.Clean(dir) == filepath.Clean(cm.configDir) {
+		return cm, nil
+	}
+	for _, existing := range cm.extraConfigDirs {
+		if filepath.Clean(dir) == filepath.Clean(existing) {
+			return cm, nil
+		}
+	}
+	cm.extraConfigDirs = append(cm.extraConfigDirs, dir)
+	return cm, nil
+}
+
+// SetConfigDirConflictPolicy 设置多个配置目录中出现同名配置时的处理策略（链式调用）
+// 参数:
+//
+//	policy: ConfigDirConflictOverride（默认）或 ConfigDirConflictError
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetConfigDirConflictPolicy(policy ConfigDirConflictPolicy) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.dirConflict = policy
+	return cm
+}
+
+// GetConfigDirs 获取所有配置目录，主目录在前，其余按加入顺序排列
+// 返回值:
+//
+//	[]string: 配置目录列表
+func (cm *ConfigManager233) GetConfigDirs() []string {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	dirs := make([]string, 0, 1+len(cm.extraConfigDirs))
+	dirs = append(dirs, cm.configDir)
+	return append(dirs, cm.extraConfigDirs...)
+}
+
 // Start 启动配置管理器（链式调用）
 // 加载所有配置并启动文件监听，启动后不允许修改配置目录
 // 返回值:
 //
 //	*ConfigManager233: 返回自身，支持链式调用
-//	error: 启动过程中的错误
+//	error: 启动过程中的错误；只有部分配置文件加载失败时管理器仍会启动，返回值中带上汇总的加载错误
 func (cm *ConfigManager233) Start() (*ConfigManager233, error) {
 	if cm.isStarted.Load() {
 		return cm, nil // 已经启动，直接返回
 	}
 
-	// 加载所有配置
+	// 加载所有配置：部分文件加载失败时仍启动监听，修复文件后可以热重载，错误在启动完成后返回
+	var partialErr error
 	if err := cm.LoadAllConfigs(); err != nil {
-		return cm, fmt.Errorf("加载配置失败: %w", err)
+		var failed ConfigValidationErrors
+		if !errors.As(err, &failed) {
+			return cm, fmt.Errorf("加载配置失败: %w", err)
+		}
+		partialErr = fmt.Errorf("加载配置失败: %w", err)
 	}
 
 	// 启动文件监听
@@ -126,7 +276,7 @@ func (cm *ConfigManager233) Start() (*ConfigManager233, error) {
 	// 标记为已启动
 	cm.isStarted.Store(true)
 
-	return cm, nil
+	return cm, partialErr
 }
 
 // NewConfigManager233 已废弃：请使用 GetInstance().SetConfigDir().Start() 代替
@@ -136,22 +286,64 @@ func NewConfigManager233(configDir string) *ConfigManager233 {
 	manager := GetInstance()
 	// 如果未启动，清空之前的配置（用于测试场景）
 	if !manager.isStarted.Load() {
+		// 停止远程轮询，并等待进行中的重载或轮询提交完成，避免旧任务写入重置后的管理器
+		manager.stopRemotePolls()
+		manager.reloadMu.Lock()
 		manager.mutex.Lock()
 		manager.configs = make(map[string]interface{})
 		manager.configMaps = make(map[string]map[string]interface{})
 		manager.configDir = configDir
+		manager.fsys = nil
+		manager.extraConfigDirs = nil
+		manager.dirConflict = ConfigDirConflictOverride
+		manager.duplicateName = DuplicateNameError
+		manager.duplicateId = DuplicateIdKeepLast
+		manager.loadConcurrency = 0
+		manager.excelConcurrency = 0
+		manager.jsonAllowComments = false
+		manager.envExpand = EnvExpandOff
+		manager.schemaMin = 0
+		manager.schemaMax = 0
+		manager.schemaPolicy = SchemaVersionReject
+		manager.columnNormalizer = nil
+		manager.sheetFiles = nil
+		manager.remoteTimeout = 0
+		manager.remoteAttempts = 0
+		manager.remoteRetryDelay = 0
+		manager.snapshotLimit = 0
+		manager.stableInterval = 0
+		manager.stableChecks = 0
 		// 清空缓存
 		manager.globalIdMaps.Store(&map[string]map[string]interface{}{})
 		manager.globalSlices.Store(&map[string][]interface{}{})
+		manager.clearConfigIndex()
+		manager.clearLoadStats()
+		manager.clearSnapshots()
+		manager.cacheStats.reset()
+		manager.clearConfigRefs()
+		manager.clearMissingTypeWarnings()
+		manager.bridgeMu.Lock()
+		manager.bridge = NewConfig233()
+		manager.bridgeMu.Unlock()
 		// 重置首次加载标志（用于测试场景）
 		manager.isFirstLoadDone.Store(false)
 		// 清空业务管理器列表（用于测试场景）
 		manager.businessManagers = nil
+		manager.businessPriority = nil
+		manager.crossValidators = nil
 		manager.mutex.Unlock()
 
-		manager.registerTypeMu.Lock()
-		manager.registeredTypes = make(map[string]reflect.Type)
-		manager.registerTypeMu.Unlock()
+		manager.hotUpdateHolderMu.Lock()
+		manager.hotUpdateHolders = nil
+		manager.hotUpdateHolderMu.Unlock()
+		manager.watchMu.Lock()
+		manager.reloadBatchDelay = 0
+		manager.reloadCooldown = 0
+		manager.watchMu.Unlock()
+		manager.closeReloadSubscriptions()
+
+		manager.ClearRegisteredTypes()
+		manager.reloadMu.Unlock()
 	} else {
 		// 如果已启动，只更新配置目录（会返回错误，但保持向后兼容）
 		manager.SetConfigDir(configDir)
@@ -159,6 +351,30 @@ func NewConfigManager233(configDir string) *ConfigManager233 {
 	return manager
 }
 
+// NewConfigManager233FromFS 基于 fs.FS 创建配置管理器（如 embed.FS）
+// 配置文件通过 fs.WalkDir 与 fs.ReadFile 读取，适合将配置打包进二进制的场景
+// embed.FS 内容只读不会变化，StartWatching 在该模式下不做任何事
+// 参数:
+//
+//	fsys: 配置所在的文件系统
+//	root: 配置目录在 fsys 中的路径，为空时使用根目录 "."
+//
+// 返回值:
+//
+//	*ConfigManager233: 全局单例配置管理器实例
+func NewConfigManager233FromFS(fsys fs.FS, root string) *ConfigManager233 {
+	if root == "" {
+		root = "."
+	}
+	manager := NewConfigManager233(root)
+	if !manager.isStarted.Load() {
+		manager.mutex.Lock()
+		manager.fsys = fsys
+		manager.mutex.Unlock()
+	}
+	return manager
+}
+
 // SetLoadDoneWriteConfigFileDir 设置加载完成后导出配置文件的目录
 // 支持相对路径和绝对路径
 func (cm *ConfigManager233) SetLoadDoneWriteConfigFileDir(dir string) *ConfigManager233 {
@@ -215,24 +431,72 @@ func (cm *ConfigManager233) ExportConfigToJSON(configName string, data interface
 	getLogger().Info("已导出配置到文件", "configName", configName, "path", filePath)
 }
 
+// ExportAllConfigsToJSON 将所有已加载的配置聚合为单个 JSON 写出
+// 结构为 {"ItemConfig": {"1": {...}, "2": {...}}, ...}，外层 key 为配置名、内层 key 为配置 ID，均按字典序排列便于 diff；
+// 基于 Lock-Free 缓存中已转换的结构体序列化（未注册类型的配置保持原始 map），读取的是同一份缓存快照，与热重载并发时不会读到中间状态
+// 与 SetIsOpenWriteTempFileToSeeMemoryConfig 开启后按配置分文件导出不同，不受导出开关影响，适合管理后台接口直接返回
+// 注意: 配置之间存在 config233_ref 循环引用时无法序列化，会返回错误
+// 参数:
+//
+//	w: 输出目标，例如 http.ResponseWriter 或 bytes.Buffer
+//
+// 返回值:
+//
+//	error: 序列化或写入失败时返回错误
+func (cm *ConfigManager233) ExportAllConfigsToJSON(w io.Writer) error {
+	idMaps := getGlobalIdMapCache(cm)
+	if idMaps == nil {
+		idMaps = map[string]map[string]interface{}{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(idMaps); err != nil {
+		return fmt.Errorf("导出配置总览失败: %w", err)
+	}
+	return nil
+}
+
 // RegisterType 注册配置结构体类型，用于将加载的配置数据自动转换为指定类型
 // 这个函数应该在加载配置之前调用
 func (cm *ConfigManager233) RegisterType(typ reflect.Type) {
 	if typ == nil {
 		return
 	}
-	name := typ.Name()
+	cm.RegisterTypeWithName("", typ)
+}
+
+// RegisterTypeWithName 注册配置结构体类型，并显式指定其对应的配置名（文件名去掉扩展名）
+// 结构体命名可以与文件名不同，之后 GetConfigById[T] 等泛型方法会按该配置名查找
+// 同一配置名只保留最后注册的类型
+// 这个函数应该在加载配置之前调用
+// 参数:
+//
+//	configName: 配置名称，为空时使用类型名
+//	typ: 配置结构体类型，指针时取元素类型
+func (cm *ConfigManager233) RegisterTypeWithName(configName string, typ reflect.Type) {
+	if typ == nil {
+		return
+	}
 
 	// 如果是指针类型，则获取元素类型
 	if typ.Kind() == reflect.Ptr {
-		name = typ.Elem().Name()
 		typ = typ.Elem()
 	}
+	name := configName
+	if name == "" {
+		name = typ.Name()
+	}
 
 	cm.registerTypeMu.Lock()
 	defer cm.registerTypeMu.Unlock()
 
 	cm.registeredTypes[name] = typ
+	if name == typ.Name() {
+		cm.typeConfigNames.Delete(typ)
+	} else {
+		cm.typeConfigNames.Store(typ, name)
+	}
 	getLogger().Info("注册配置类型 (Object)", "name", name, "type", typ.String())
 }
 
@@ -243,11 +507,74 @@ func RegisterType[T any]() {
 	GetInstance().RegisterType(reflect.TypeOf(example))
 }
 
+// RegisterTypeWithName 注册配置结构体类型，并显式指定其对应的配置名
+// 例如 RegisterTypeWithName[FishingWeaponConfigFix]("FishingWeaponConfig") 读取 FishingWeaponConfig.xlsx
+// 这个函数应该在加载配置之前调用
+// 参数:
+//
+//	configName: 配置名称（文件名去掉扩展名），为空时等同于 RegisterType[T]
+func RegisterTypeWithName[T any](configName string) {
+	var example T
+	GetInstance().RegisterTypeWithName(configName, reflect.TypeOf(example))
+}
+
 // RegisterTypeByReflect 传入 reflect.Type 来注册
 func RegisterTypeByReflect(typ reflect.Type) {
 	GetInstance().RegisterType(typ)
 }
 
+// UnregisterType 取消注册配置结构体类型
+// 之后加载的该配置会保留为 map，已加载的数据不受影响（如需清空请调用 ClearConfigs）
+// 参数:
+//
+//	typ: 配置结构体类型，指针时取元素类型
+func (cm *ConfigManager233) UnregisterType(typ reflect.Type) {
+	if typ == nil {
+		return
+	}
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	cm.registerTypeMu.Lock()
+	defer cm.registerTypeMu.Unlock()
+
+	name := typ.Name()
+	if mapped, ok := cm.typeConfigNames.Load(typ); ok {
+		name = mapped.(string)
+	}
+	// 同一配置名可能已被其他类型覆盖注册，此时保留对方的注册
+	if registered, ok := cm.registeredTypes[name]; ok && registered == typ {
+		delete(cm.registeredTypes, name)
+	}
+	cm.typeConfigNames.Delete(typ)
+	getLogger().Info("取消注册配置类型", "name", name, "type", typ.String())
+}
+
+// ClearRegisteredTypes 清空所有已注册的配置结构体类型
+// 已加载的数据不受影响（如需清空请调用 ClearConfigs）
+func (cm *ConfigManager233) ClearRegisteredTypes() {
+	cm.registerTypeMu.Lock()
+	defer cm.registerTypeMu.Unlock()
+
+	cm.registeredTypes = make(map[string]reflect.Type)
+	cm.typeConfigNames.Range(func(k, _ interface{}) bool {
+		cm.typeConfigNames.Delete(k)
+		return true
+	})
+}
+
+// UnregisterType 取消注册配置结构体类型
+func UnregisterType[T any]() {
+	var example T
+	GetInstance().UnregisterType(reflect.TypeOf(example))
+}
+
+// ClearRegisteredTypes 清空全局管理器中所有已注册的配置结构体类型
+func ClearRegisteredTypes() {
+	GetInstance().ClearRegisteredTypes()
+}
+
 // getRegisteredType 获取已注册的类型
 func (cm *ConfigManager233) getRegisteredType(configName string) (reflect.Type, bool) {
 	cm.registerTypeMu.RLock()
@@ -258,8 +585,8 @@ func (cm *ConfigManager233) getRegisteredType(configName string) (reflect.Type,
 }
 
 // convertMapToRegisteredStruct 将 map 转换为已注册的结构体类型
-// 使用 config233_column tag 来映射 Excel 列名到 struct 字段
-// 如果没有 config233_column tag，则使用字段名匹配（不区分大小写）
+// 依次按 json tag、config233_column tag、字段名匹配 map 的 key，精确匹配失败时不区分大小写
+// 值会按字段类型自动转换（如字符串转数字、布尔）
 func (cm *ConfigManager233) convertMapToRegisteredStruct(configName string, data map[string]interface{}) (interface{}, error) {
 	typ, exists := cm.getRegisteredType(configName)
 	if !exists {
@@ -269,85 +596,209 @@ func (cm *ConfigManager233) convertMapToRegisteredStruct(configName string, data
 
 	// 创建新实例
 	instance := reflect.New(typ).Elem()
+	fillStructFromMap(instance, data, configName)
+	applyFieldDefaults(instance)
+	cm.expandEnvFields(instance, configName)
+
+	// 获取指针以便调用方法
+	instancePtr := instance.Addr().Interface()
+
+	// config233_ref 引用字段在所有配置加载完成后回填，这里只记录原始 ID
+	cm.recordConfigRefs(typ, instancePtr, data)
+
+	// lifecycle/AfterLoad 生命周期回调
+	if lifecycle, ok := instancePtr.(IConfigLifecycle); ok {
+		lifecycle.AfterLoad()
+	}
+
+	// lifecycle/Check 校验配置
+	if validator, ok := instancePtr.(IConfigValidator); ok {
+		if err := validator.Check(); err != nil {
+			getLogger().Error(err, "配置校验失败", "configName", configName, "data", data)
+			// 注意：校验失败仍然返回实例，只是输出错误信息
+		}
+	}
 
-	// 构建 map key 到 struct 字段名的映射
-	// 优先使用 config233_column tag，否则使用字段名匹配
+	return instancePtr, nil
+}
+
+// fillStructFromMap 按 json tag、config233_column tag、字段名的顺序查找 map 中的值并设置到结构体
+// 匿名嵌入的结构体（或结构体指针）字段提升到外层，用同一个 map 填充；
+// 嵌套的结构体字段可以是嵌套的 map（如 JSON 对象），也可以是 reward.itemId 形式的点分 key；
+// 途经的 nil 结构体指针在有值写入时自动初始化
+func fillStructFromMap(instance reflect.Value, data map[string]interface{}, configName string) {
+	typ := instance.Type()
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
-		fieldName := field.Name
-
-		// 获取 config233_column tag
-		columnTag := field.Tag.Get("config233_column")
-		jsonTag := field.Tag.Get("json")
-		// parse json tag to get name (e.g. `json:"id,omitempty"`)
-		if jsonTag != "" {
-			parts := strings.Split(jsonTag, ",")
-			jsonTag = parts[0]
-		}
-
-		// 确定要查找的 key
-		var keyToFind string
-		if columnTag != "" {
-			keyToFind = columnTag
-		} else if jsonTag != "" {
-			keyToFind = jsonTag
-		} else {
-			// 使用字段名（尝试小写首字母）
-			keyToFind = lowerFirst(fieldName)
-		}
-
-		// 在 data 中查找对应的值
-		var value interface{}
-		var found bool
-
-		// 优先精确匹配
-		if v, ok := data[keyToFind]; ok {
-			value = v
-			found = true
-		} else if columnTag == "" && jsonTag == "" {
-			// 如果没有 config233_column 或 json tag，尝试不区分大小写匹配
-			for k, v := range data {
-				if strings.EqualFold(k, fieldName) || strings.EqualFold(k, keyToFind) {
-					value = v
-					found = true
-					break
-				}
+		fieldValue := instance.Field(i)
+
+		if field.Anonymous && field.Tag.Get("json") == "" && isNestedStructType(field.Type) {
+			if target := allocStructValue(fieldValue); target.IsValid() {
+				fillStructFromMap(target, data, configName)
 			}
+			continue
+		}
+		if !fieldValue.CanSet() || isRefField(field) {
+			continue
 		}
 
+		value, found := lookupFieldValue(field, data)
 		if !found {
+			// 没有同名 key 时查找 reward.itemId 形式的点分 key
+			if isNestedStructType(field.Type) {
+				if nested := nestedFieldData(field, data); len(nested) > 0 {
+					fillStructFromMap(allocStructValue(fieldValue), nested, configName)
+				}
+			}
 			continue
 		}
 
-		// 设置字段值
-		fieldValue := instance.Field(i)
-		if !fieldValue.CanSet() {
+		if nested, ok := value.(map[string]interface{}); ok && isNestedStructType(field.Type) {
+			fillStructFromMap(allocStructValue(fieldValue), nested, configName)
 			continue
 		}
+		if err := setStructFieldValue(fieldValue, field, value, configName); err != nil {
+			getLogger().Error(err, "字段类型转换失败", "configName", configName, "field", field.Name)
+		}
+	}
+}
+
+// isNestedStructType 判断字段是否为可以展开填充的结构体或结构体指针
+// time.Time 与实现了 IConfigCellUnmarshaler 的类型按单个值处理，不展开
+func isNestedStructType(typ reflect.Type) bool {
+	if convert.IsCellUnmarshaler(typ) {
+		return false
+	}
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct && !convert.IsTimeType(typ)
+}
 
-		if err := setFieldValueFromInterface(fieldValue, value, configName, fieldName); err != nil {
-			fmt.Printf("\033[31m[config233] 字段类型转换失败 [%s.%s]: %v\033[0m\n", configName, fieldName, err)
+// allocStructValue 返回结构体字段本身，结构体指针为 nil 时自动初始化
+// 指针不可设置（如未导出的嵌入指针）时返回无效的 reflect.Value
+func allocStructValue(fieldValue reflect.Value) reflect.Value {
+	if fieldValue.Kind() != reflect.Ptr {
+		return fieldValue
+	}
+	if fieldValue.IsNil() {
+		if !fieldValue.CanSet() {
+			return reflect.Value{}
 		}
+		fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
 	}
+	return fieldValue.Elem()
+}
 
-	// 获取指针以便调用方法
-	instancePtr := instance.Addr().Interface()
+// nestedFieldData 收集 "<字段 key>.xxx" 形式的 key，去掉前缀后作为嵌套结构体的数据（前缀不区分大小写）
+func nestedFieldData(field reflect.StructField, data map[string]interface{}) map[string]interface{} {
+	var nested map[string]interface{}
+	for _, candidate := range fieldKeyCandidates(field) {
+		prefix := strings.ToLower(candidate) + "."
+		for k, v := range data {
+			if len(k) > len(prefix) && strings.ToLower(k[:len(prefix)]) == prefix {
+				if nested == nil {
+					nested = make(map[string]interface{})
+				}
+				if _, exists := nested[k[len(prefix):]]; !exists {
+					nested[k[len(prefix):]] = v
+				}
+			}
+		}
+	}
+	return nested
+}
 
-	// lifecycle/AfterLoad 生命周期回调
-	if lifecycle, ok := instancePtr.(IConfigLifecycle); ok {
-		lifecycle.AfterLoad()
+// fieldKeyCandidates 返回结构体字段在 map 中可能对应的 key，按优先级排列：
+// json tag、config233_column tag（逗号分隔的多个别名按顺序）、字段名、首字母小写的字段名
+func fieldKeyCandidates(field reflect.StructField) []string {
+	candidates := make([]string, 0, 4)
+	if jsonTag := strings.Split(field.Tag.Get("json"), ",")[0]; jsonTag != "" && jsonTag != "-" {
+		candidates = append(candidates, jsonTag)
 	}
+	candidates = append(candidates, convert.ColumnAliases(field)...)
+	return append(candidates, field.Name, lowerFirst(field.Name))
+}
 
-	// lifecycle/Check 校验配置
-	if validator, ok := instancePtr.(IConfigValidator); ok {
-		if err := validator.Check(); err != nil {
-			fmt.Printf("\033[31m[config233] 配置校验失败 [%s]: %v\033[0m\n", configName, err)
-			getLogger().Error(err, "配置校验失败", "configName", configName, "data", data)
-			// 注意：校验失败仍然返回实例，只是输出错误信息
+// lookupFieldValue 在 map 中查找结构体字段对应的值
+// 先按候选 key 的优先级精确匹配，都没有命中时再不区分大小写匹配
+func lookupFieldValue(field reflect.StructField, data map[string]interface{}) (interface{}, bool) {
+	candidates := fieldKeyCandidates(field)
+	for _, key := range candidates {
+		if v, ok := data[key]; ok {
+			return v, true
+		}
+	}
+	for _, key := range candidates {
+		for k, v := range data {
+			if strings.EqualFold(k, key) {
+				return v, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// setStructFieldValue 设置结构体字段值，切片字段按 config233_sep 标签指定的分隔符拆分字符串
+// 字段类型实现了 IConfigCellUnmarshaler 时交给它解析；字符串内容为内嵌 JSON 时（见 convert.IsJSONCell）用 json.Unmarshal 解析进字段
+func setStructFieldValue(fieldValue reflect.Value, field reflect.StructField, value interface{}, configName string) error {
+	if convert.IsCellUnmarshaler(fieldValue.Type()) {
+		raw, err := cellRawString(value)
+		if err == nil {
+			err = convert.UnmarshalCell(fieldValue, raw)
+		}
+		if err != nil {
+			return fmt.Errorf("自定义解码 '%v' 为 %s 失败: %w", value, fieldValue.Type(), err)
+		}
+		return nil
+	}
+	if raw, ok := value.(string); ok && convert.IsJSONCell(field, raw) {
+		if err := convert.SetJSONCellValue(fieldValue, raw); err != nil {
+			return fmt.Errorf("无法将 '%s' 按 JSON 解析为 %s: %w", raw, fieldValue.Type(), err)
 		}
+		return nil
+	}
+	if convert.IsTimeType(fieldValue.Type()) {
+		return setTimeValueFromInterface(fieldValue, field, value)
+	}
+	if fieldValue.Kind() == reflect.Slice {
+		return setSliceValueFromInterface(fieldValue, value, configName, field.Name, convert.SliceSeparator(field))
 	}
+	return setFieldValueFromInterface(fieldValue, value, configName, field.Name)
+}
 
-	return instancePtr, nil
+// cellRawString 将 map 中的值还原为传给 UnmarshalConfigCell 的原始内容
+// 字符串原样返回，JSON 对象和数组重新编码为 JSON，其他值按 %v 格式化
+func cellRawString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		return string(data), err
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+// setTimeValueFromInterface 设置 time.Time / time.Duration 字段值
+// 字符串按 config233_timefmt 标签指定的 layout（默认 "2006-01-02 15:04:05" 和 RFC3339）解析，
+// 时长支持 "5s"、"3m" 等写法，数字按毫秒处理
+func setTimeValueFromInterface(fieldValue reflect.Value, field reflect.StructField, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	raw, ok := value.(string)
+	if !ok {
+		ms, err := toInt64(value)
+		if err != nil {
+			return fmt.Errorf("无法将 '%v' 转换为 %s: %w", value, fieldValue.Type(), err)
+		}
+		raw = strconv.FormatInt(ms, 10)
+	}
+	return convert.SetTimeValue(fieldValue, raw, field.Tag.Get("config233_timefmt"))
 }
 
 // setFieldValueFromInterface 从 interface{} 设置字段值，自动类型转换
@@ -356,16 +807,22 @@ func setFieldValueFromInterface(field reflect.Value, value interface{}, configNa
 		return nil
 	}
 
+	// 指针字段（如 *int、*string）：空单元格保持 nil 表示未配置，有值且转换成功时才分配
 	if field.Kind() == reflect.Ptr {
-		if field.IsNil() {
-			field.Set(reflect.New(field.Type().Elem()))
+		if str, ok := value.(string); ok && strings.TrimSpace(str) == "" {
+			return nil
+		}
+		target := reflect.New(field.Type().Elem())
+		if err := setFieldValueFromInterface(target.Elem(), value, configName, fieldName); err != nil {
+			return err
 		}
-		return setFieldValueFromInterface(field.Elem(), value, configName, fieldName)
+		field.Set(target)
+		return nil
 	}
 
 	switch field.Kind() {
 	case reflect.Slice:
-		return setSliceValueFromInterface(field, value, configName, fieldName)
+		return setSliceValueFromInterface(field, value, configName, fieldName, convert.DefaultSliceSeparator)
 
 	case reflect.String:
 		field.SetString(fmt.Sprintf("%v", value))
@@ -404,7 +861,9 @@ func setFieldValueFromInterface(field reflect.Value, value interface{}, configNa
 	return nil
 }
 
-func setSliceValueFromInterface(field reflect.Value, value interface{}, configName, fieldName string) error {
+// setSliceValueFromInterface 设置切片字段值
+// 字符串先尝试按 JSON 数组解析，失败时按 sep 拆分后逐元素转换，空字符串得到空切片
+func setSliceValueFromInterface(field reflect.Value, value interface{}, configName, fieldName, sep string) error {
 	if value == nil {
 		return nil
 	}
@@ -424,7 +883,7 @@ func setSliceValueFromInterface(field reflect.Value, value interface{}, configNa
 
 		trimmed = strings.TrimPrefix(trimmed, "[")
 		trimmed = strings.TrimSuffix(trimmed, "]")
-		parts := strings.Split(trimmed, ",")
+		parts := strings.Split(trimmed, sep)
 		result := reflect.MakeSlice(field.Type(), 0, len(parts))
 		for i, part := range parts {
 			part = strings.TrimSpace(strings.Trim(part, `"'`))
@@ -499,7 +958,7 @@ func toInt64(v interface{}) (int64, error) {
 		if t == "" {
 			return 0, nil
 		}
-		return strconv.ParseInt(t, 10, 64)
+		return convert.ParseInt(t)
 	default:
 		return 0, fmt.Errorf("unsupported type %T", v)
 	}
@@ -536,7 +995,7 @@ func toUint64(v interface{}) (uint64, error) {
 		if t == "" {
 			return 0, nil
 		}
-		return strconv.ParseUint(t, 10, 64)
+		return convert.ParseUint(t)
 	default:
 		return 0, fmt.Errorf("unsupported type %T", v)
 	}
@@ -573,7 +1032,7 @@ func toFloat64(v interface{}) (float64, error) {
 		if t == "" {
 			return 0, nil
 		}
-		return strconv.ParseFloat(t, 64)
+		return convert.ParseFloat(t, 64)
 	default:
 		return 0, fmt.Errorf("unsupported type %T", v)
 	}
@@ -591,13 +1050,10 @@ func toBool(v interface{}) (bool, error) {
 	case float32, float64:
 		return reflect.ValueOf(t).Float() != 0, nil
 	case string:
-		if t == "" || t == "0" || strings.EqualFold(t, "false") {
+		if strings.TrimSpace(t) == "" {
 			return false, nil
 		}
-		if t == "1" || strings.EqualFold(t, "true") {
-			return true, nil
-		}
-		return false, fmt.Errorf("cannot parse '%s' as bool", t)
+		return convert.ParseBool(t)
 	default:
 		return false, fmt.Errorf("unsupported type %T", v)
 	}
@@ -642,99 +1098,529 @@ func (cm *ConfigManager233) convertSliceToRegisteredStructSlice(configName strin
 
 // LoadAllConfigs 从目录加载所有配置（并行加载以提升性能）
 // 遍历配置目录，自动识别并加载所有支持格式的配置文件
-// 支持的格式包括: Excel (.xlsx, .xls), JSON (.json), TSV (.tsv)
+// 支持的格式包括: Excel (.xlsx, .xls), JSON (.json), TSV (.tsv), CSV (.csv), YAML (.yaml, .yml), XML (.xml)
 //
 // 性能优化：使用并行加载大幅提升首次启动速度
 // - 文件扫描阶段：快速收集所有需要加载的配置文件
 // - 并行加载阶段：每个配置文件在独立 goroutine 中加载，充分利用多核 CPU
 // - 线程安全保证：使用细粒度锁保护共享数据结构，缓存使用无锁 CAS 更新
 //
-// 加载过程中出现的错误会被记录但不会中断整个加载过程
+// 单个文件加载失败时记录错误并跳过，不中断其他文件的加载，所有失败在加载结束后汇总返回；
+// 同一目录内的同名文件在 DuplicateNameError 策略下只跳过冲突的配置，同样汇总在返回的错误中
+// 全部写入后执行 RegisterCrossValidator 注册的跨配置校验
 // 返回值:
 //
-//	error: 加载过程中的错误，如果遍历目录失败则返回错误
+//	error: 遍历目录失败时返回错误；部分文件加载失败或存在同名文件冲突时返回 ConfigValidationErrors（每个失败的文件或冲突的配置一项，
+//	       含配置名、文件路径与原始错误，可用 errors.As 取出、errors.Is 判断原始错误），成功的文件照常加载并触发 OnConfigLoadComplete，
+//	       但不会触发 OnFirstAllConfigDone（留到之后所有文件都加载成功的那次加载）；
+//	       跨配置校验未通过时返回汇总的校验错误（配置仍保持加载），两者同时出现时用 errors.Join 合并
 func (cm *ConfigManager233) LoadAllConfigs() error {
-	// 首先收集所有需要加载的配置文件（不持有锁）
-	type configFile struct {
-		path string
-		ext  string
+	return cm.LoadAllConfigsContext(context.Background())
+}
+
+// LoadAllConfigsContext 从目录加载所有配置，支持通过 ctx 取消或设置超时
+// 与 LoadAllConfigs 行为一致，额外在遍历每个文件和执行每个加载任务前检查 ctx
+// 取消后立即返回，仍在解析中的任务不会再写入内存；已写入的配置保持完整（每个配置原子替换），
+// 但本次加载不会触发 OnConfigLoadComplete / OnFirstAllConfigDone
+// 参数:
+//
+//	ctx: 控制加载的上下文，例如 context.WithTimeout 为服务启动设置总超时
+//
+// 返回值:
+//
+//	error: 取消或超时时返回 ctx.Err()（context.Canceled / context.DeadlineExceeded），遍历目录失败时返回错误
+func (cm *ConfigManager233) LoadAllConfigsContext(ctx context.Context) error {
+	// 首先收集所有需要加载的配置文件（不持有锁），同名冲突的配置被跳过，与加载失败的文件一起返回
+	filesToLoad, duplicates, err := cm.collectConfigFiles(ctx)
+	if err != nil {
+		return err
 	}
 
-	var filesToLoad []configFile
-	err := filepath.Walk(cm.configDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+	// 取消后不再写入：写入前在 commitMu 下检查 ctx，返回前获取 commitMu 等待正在进行的写入完成，
+	// 保证返回后不会有迟到的任务修改内存
+	var commitMu sync.Mutex
+	commit := func(configName string, dataList interface{}, configMap map[string]interface{}, slice []interface{}) error {
+		commitMu.Lock()
+		defer commitMu.Unlock()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
 		}
-		if isHiddenDir(info) {
-			return filepath.SkipDir
+		return cm.commitConfig(configName, dataList, configMap, slice)
+	}
+
+	// 并行加载所有配置文件，并发度由 SetLoadConcurrency 控制，同时打开的 Excel 数由 SetExcelLoadConcurrency 限制
+	loadErrors := make(chan *ConfigValidationError, len(filesToLoad))
+	allDone := runConfigFileWorkers(ctx, filesToLoad, cm.loadWorkerCount(), cm.limitExcelFiles(ctx, func(f configFile) {
+		if loadErr := cm.readConfigFile(f, commit); loadErr != nil {
+			select {
+			case loadErrors <- &ConfigValidationError{
+				ConfigName: strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path)),
+				FilePath:   f.path,
+				Err:        loadErr,
+			}:
+			default:
+			}
 		}
+	}))
 
-		// 处理不同类型的配置文件
-		if !info.IsDir() {
-			// 跳过临时文件和特殊文件
-			baseName := filepath.Base(path)
-			// 跳过 Excel 临时文件 (以 ~$ 开头) 和包含 ~ 或 # 的文件
-			if strings.HasPrefix(baseName, "~$") ||
-				strings.Contains(baseName, "~") ||
-				strings.Contains(baseName, "#") {
-				return nil
-			}
+	// 等待所有加载完成，或 ctx 被取消
+	select {
+	case <-allDone:
+	case <-ctx.Done():
+	}
 
-			ext := strings.ToLower(filepath.Ext(path))
-			switch ext {
-			case ".xlsx", ".xls", ".json", ".tsv":
-				filesToLoad = append(filesToLoad, configFile{path: path, ext: ext})
-			}
-		}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		commitMu.Lock()
+		commitMu.Unlock()
+		getLogger().Info("配置加载已取消", "reason", ctxErr.Error(), "totalCount", len(filesToLoad))
+		return ctxErr
+	}
+	close(loadErrors)
 
-		return nil
-	})
+	// 汇总加载失败的文件：坏文件已被跳过，不影响其他配置继续加载，错误在最后一并返回
+	failed := duplicates
+	for loadErr := range loadErrors {
+		failed = append(failed, loadErr)
+	}
+	if len(failed) > 0 {
+		sortValidationErrors(failed)
+		getLogger().Error(failed, "部分配置文件加载失败，已跳过", "failedCount", len(failed), "totalCount", len(filesToLoad))
+	}
+
+	// 所有配置写入后回填配置引用，再统一执行跨配置校验，未通过时配置仍保持加载，错误返回给调用方
+	cm.resolveConfigRefs()
+	crossErr := cm.ValidateCrossReferences()
+	cm.notifyAllConfigsLoaded(len(failed) == 0)
+	switch {
+	case len(failed) > 0 && crossErr != nil:
+		return errors.Join(failed, crossErr)
+	case len(failed) > 0:
+		return failed
+	default:
+		return crossErr
+	}
+}
 
+// LoadAllConfigsStrict 以严格模式从目录加载所有配置
+// 与 LoadAllConfigs 不同，任一配置文件加载失败、任一配置项 Check 校验失败或字段规则（config233_required、config233_min / config233_max）不满足时，整次加载失败且不写入内存
+// 适合在 CI 阶段校验整套配置的完整性
+// 返回值:
+//
+//	error: 遍历目录失败时返回普通错误；存在配置问题时返回 ConfigValidationErrors，包含全部问题（配置名 + ID）；
+//	       跨配置校验需要读取已写入的配置，因此在写入后执行，未通过时返回汇总的校验错误（配置仍保持加载）
+func (cm *ConfigManager233) LoadAllConfigsStrict() error {
+	filesToLoad, duplicates, err := cm.collectConfigFiles(context.Background())
 	if err != nil {
 		return err
 	}
 
-	// 并行加载所有配置文件
-	var wg sync.WaitGroup
-	loadErrors := make(chan error, len(filesToLoad))
+	type stagedConfig struct {
+		dataList  interface{}
+		configMap map[string]interface{}
+		slice     []interface{}
+	}
+
+	var (
+		stageMu  sync.Mutex
+		staged   = make(map[string]stagedConfig, len(filesToLoad))
+		problems = duplicates
+	)
 
-	for _, file := range filesToLoad {
+	<-runConfigFileWorkers(context.Background(), filesToLoad, cm.loadWorkerCount(), cm.limitExcelFiles(context.Background(), func(f configFile) {
+		// 只解析和校验，暂不写入内存
+		stage := func(configName string, dataList interface{}, configMap map[string]interface{}, slice []interface{}) error {
+			checkErrs := append(checkFieldRules(configName, configMap, slice), checkConfigItems(configName, configMap, slice)...)
+			for _, checkErr := range checkErrs {
+				checkErr.FilePath = f.path
+			}
+
+			stageMu.Lock()
+			defer stageMu.Unlock()
+			problems = append(problems, checkErrs...)
+			staged[configName] = stagedConfig{dataList: dataList, configMap: configMap, slice: slice}
+			return nil
+		}
+
+		if loadErr := cm.readConfigFile(f, stage); loadErr != nil {
+			stageMu.Lock()
+			problems = append(problems, &ConfigValidationError{
+				ConfigName: strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path)),
+				FilePath:   f.path,
+				Err:        loadErr,
+			})
+			stageMu.Unlock()
+		}
+	}))
+
+	if len(problems) > 0 {
+		sortValidationErrors(problems)
+		getLogger().Error(problems, "严格模式加载配置失败，未写入任何配置", "problemCount", len(problems), "totalCount", len(filesToLoad))
+		return problems
+	}
+
+	// 全部通过后统一写入
+	for configName, sc := range staged {
+		if commitErr := cm.commitConfig(configName, sc.dataList, sc.configMap, sc.slice); commitErr != nil {
+			return commitErr
+		}
+	}
+
+	// 所有配置写入后回填配置引用，再统一执行跨配置校验，未通过时配置仍保持加载，错误返回给调用方
+	cm.resolveConfigRefs()
+	crossErr := cm.ValidateCrossReferences()
+	cm.notifyAllConfigsLoaded(true)
+	return crossErr
+}
+
+// SetLoadConcurrency 设置并行加载配置文件的最大 worker 数（链式调用）
+// 配置文件极多或单个 Excel 很大时，限制并发可以避免内存和文件句柄被打爆
+// 参数:
+//
+//	n: 最大并行数，<= 0 时恢复默认值 runtime.NumCPU()
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetLoadConcurrency(n int) *ConfigManager233 {
+	if n < 0 {
+		n = 0
+	}
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.loadConcurrency = n
+	return cm
+}
+
+// loadWorkerCount 获取并行加载的 worker 数
+func (cm *ConfigManager233) loadWorkerCount() int {
+	cm.mutex.RLock()
+	n := cm.loadConcurrency
+	cm.mutex.RUnlock()
+	if n <= 0 {
+		return runtime.NumCPU()
+	}
+	return n
+}
+
+// DefaultExcelLoadConcurrency 并行加载时默认最多同时打开的 Excel 文件数
+const DefaultExcelLoadConcurrency = 4
+
+// SetExcelLoadConcurrency 设置并行加载时最多同时打开的 Excel 文件数（链式调用）
+// excelize 打开一个 xlsx 会把整个工作簿读入内存，几百个 Excel 同时打开可能 OOM；
+// 该上限独立于 SetLoadConcurrency，只限制 .xlsx / .xls，JSON、TSV 等轻量格式不受影响。
+// 实际上限不超过整体 worker 数，峰值内存约为"最大的几个 Excel 同时解析"的占用
+// 参数:
+//
+//	n: 同时打开的 Excel 文件数上限，<= 0 时恢复默认值 DefaultExcelLoadConcurrency
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetExcelLoadConcurrency(n int) *ConfigManager233 {
+	if n < 0 {
+		n = 0
+	}
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.excelConcurrency = n
+	return cm
+}
+
+// excelWorkerCount 获取同时打开 Excel 文件数的实际上限（不超过整体 worker 数）
+func (cm *ConfigManager233) excelWorkerCount() int {
+	cm.mutex.RLock()
+	n := cm.excelConcurrency
+	cm.mutex.RUnlock()
+	if n <= 0 {
+		n = DefaultExcelLoadConcurrency
+	}
+	if workers := cm.loadWorkerCount(); n > workers {
+		n = workers
+	}
+	return n
+}
+
+// limitExcelFiles 包装 fn，使同时处理的 Excel 文件不超过 excelWorkerCount 个
+// 等待名额时 ctx 取消则直接返回，不再处理该文件
+func (cm *ConfigManager233) limitExcelFiles(ctx context.Context, fn func(configFile)) func(configFile) {
+	sem := make(chan struct{}, cm.excelWorkerCount())
+	return func(f configFile) {
+		if f.ext != ".xlsx" && f.ext != ".xls" {
+			fn(f)
+			return
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		defer func() { <-sem }()
+		fn(f)
+	}
+}
+
+// runConfigFileWorkers 启动最多 workers 个 goroutine 逐个处理 files，全部处理完后关闭返回的 channel
+// ctx 取消后剩余的文件不再调用 fn
+func runConfigFileWorkers(ctx context.Context, files []configFile, workers int, fn func(configFile)) <-chan struct{} {
+	tasks := make(chan configFile, len(files))
+	for _, f := range files {
+		tasks <- f
+	}
+	close(tasks)
+
+	if workers > len(files) {
+		workers = len(files)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go func(f configFile) {
+		go func() {
 			defer wg.Done()
-
-			var loadErr error
-			switch f.ext {
-			case ".xlsx", ".xls":
-				loadErr = cm.loadExcelConfigThreadSafe(f.path)
-				if loadErr != nil {
-					getLogger().Error(loadErr, "加载Excel配置失败", "path", f.path)
-				}
-			case ".json":
-				loadErr = cm.loadJsonConfigThreadSafe(f.path)
-				if loadErr != nil {
-					configName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
-					getLogger().Error(loadErr, "加载JSON配置失败", "path", f.path, "configName", configName)
+			for f := range tasks {
+				if ctx.Err() != nil {
+					return
 				}
-			case ".tsv":
-				loadErr = cm.loadTsvConfigThreadSafe(f.path)
-				if loadErr != nil {
-					getLogger().Error(loadErr, "加载TSV配置失败", "path", f.path)
+				fn(f)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+// configFile 待加载的配置文件
+type configFile struct {
+	path string
+	ext  string
+	dir  int // 所在配置目录的序号，见 GetConfigDirs
+}
+
+// collectConfigFiles 遍历所有配置目录，收集所有支持格式的配置文件（跳过隐藏目录和临时文件）
+// 同一目录内的同名文件按 duplicateName 策略处理，默认跳过冲突的配置，其余文件照常返回
+// 多个目录出现同名配置时按 dirConflict 策略处理：覆盖时只保留最后加入的目录中的文件，报错时返回所有冲突
+// 遍历每个文件前检查 ctx，取消时返回 ctx.Err()
+// 返回值:
+//
+//	[]configFile: 需要加载的配置文件
+//	ConfigValidationErrors: 因同名文件冲突而跳过的配置，每个配置一项
+//	error: 遍历目录失败、取消或多目录冲突时返回错误
+func (cm *ConfigManager233) collectConfigFiles(ctx context.Context) ([]configFile, ConfigValidationErrors, error) {
+	cm.mutex.RLock()
+	policy := cm.dirConflict
+	duplicatePolicy := cm.duplicateName
+	cm.mutex.RUnlock()
+
+	var filesToLoad []configFile
+	owner := make(map[string]int)          // 配置名 -> 所在目录序号（覆盖策略下为最后出现的目录）
+	conflicts := make(map[string][]string) // 配置名 -> 出现冲突的文件路径
+	var conflictNames []string
+	var duplicates ConfigValidationErrors
+	for dirIndex, dir := range cm.GetConfigDirs() {
+		files, err := cm.collectDirConfigFiles(ctx, dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		files, dirDuplicates := resolveDirDuplicateNames(files, duplicatePolicy)
+		duplicates = append(duplicates, dirDuplicates...)
+		for _, f := range files {
+			configName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
+			if prev, seen := owner[configName]; seen && prev != dirIndex {
+				if policy == ConfigDirConflictError {
+					if _, recorded := conflicts[configName]; !recorded {
+						conflictNames = append(conflictNames, configName)
+						for _, existing := range filesToLoad {
+							if strings.TrimSuffix(filepath.Base(existing.path), filepath.Ext(existing.path)) == configName {
+								conflicts[configName] = append(conflicts[configName], existing.path)
+							}
+						}
+					}
+					conflicts[configName] = append(conflicts[configName], f.path)
+				} else {
+					getLogger().Info("配置被后加入的目录覆盖", "configName", configName, "path", f.path)
 				}
 			}
+			owner[configName] = dirIndex
+			f.dir = dirIndex
+			filesToLoad = append(filesToLoad, f)
+		}
+	}
 
-			if loadErr != nil {
-				select {
-				case loadErrors <- loadErr:
-				default:
+	if len(conflictNames) > 0 {
+		errs := make([]error, 0, len(conflictNames))
+		for _, configName := range conflictNames {
+			errs = append(errs, fmt.Errorf("配置 %s 在多个目录中重复: %v", configName, conflicts[configName]))
+		}
+		return nil, nil, errors.Join(errs...)
+	}
+
+	// 覆盖策略：只保留每个配置最后出现的目录中的文件
+	resolved := filesToLoad[:0]
+	for _, f := range filesToLoad {
+		configName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
+		if owner[configName] == f.dir {
+			resolved = append(resolved, f)
+		}
+	}
+	if len(duplicates) > 0 {
+		getLogger().Error(duplicates, "存在同名配置文件，已跳过冲突的配置", "conflictCount", len(duplicates))
+	}
+	return resolved, duplicates, nil
+}
+
+// resolveDirDuplicateNames 按 policy 处理单个配置目录内的同名配置文件
+// 返回保留的文件，以及 DuplicateNameError 策略下被跳过的冲突配置
+func resolveDirDuplicateNames(files []configFile, policy DuplicateNamePolicy) ([]configFile, ConfigValidationErrors) {
+	paths := make([]string, 0, len(files))
+	byPath := make(map[string]configFile, len(files))
+	for _, f := range files {
+		paths = append(paths, f.path)
+		byPath[f.path] = f
+	}
+
+	resolved, err := resolveDuplicateNames(paths, policy)
+	var conflicts ConfigValidationErrors
+	errors.As(err, &conflicts)
+	result := make([]configFile, 0, len(resolved))
+	for _, path := range resolved {
+		result = append(result, byPath[path])
+	}
+	return result, conflicts
+}
+
+// collectDirConfigFiles 遍历单个配置目录，收集所有支持格式的配置文件
+// 设置了 fs.FS 时通过 fs.WalkDir 遍历，否则遍历磁盘目录
+func (cm *ConfigManager233) collectDirConfigFiles(ctx context.Context, dir string) ([]configFile, error) {
+	var filesToLoad []configFile
+	collect := func(path string) {
+		if ext, ok := configFileExt(path); ok {
+			filesToLoad = append(filesToLoad, configFile{path: path, ext: ext})
+		}
+	}
+
+	if fsys := cm.configFS(); fsys != nil {
+		err := fs.WalkDir(fsys, dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if d.IsDir() {
+				if isHiddenDirName(d.Name()) {
+					return fs.SkipDir
 				}
+				return nil
 			}
-		}(file)
+			collect(path)
+			return nil
+		})
+		return filesToLoad, err
 	}
 
-	// 等待所有加载完成
-	wg.Wait()
-	close(loadErrors)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if isHiddenDir(info) {
+			return filepath.SkipDir
+		}
+
+		// 处理不同类型的配置文件
+		if !info.IsDir() {
+			collect(path)
+		}
+
+		return nil
+	})
+	return filesToLoad, err
+}
+
+// configFileExt 返回配置文件的扩展名（小写），不是支持的配置文件时返回 false
+func configFileExt(path string) (string, bool) {
+	// 跳过临时文件和特殊文件
+	baseName := filepath.Base(path)
+	// 跳过 Excel 临时文件 (以 ~$ 开头) 和包含 ~ 或 # 的文件
+	if strings.HasPrefix(baseName, "~$") ||
+		strings.Contains(baseName, "~") ||
+		strings.Contains(baseName, "#") {
+		return "", false
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+	switch ext {
+	case ".xlsx", ".xls", ".json", ".jsonl", ".tsv", ".csv", ".yaml", ".yml", ".xml":
+		return ext, true
+	}
+	return "", false
+}
+
+// readConfigBytes 读取配置文件内容，设置了 fs.FS 时从 fs.FS 读取
+func (cm *ConfigManager233) readConfigBytes(path string) ([]byte, error) {
+	if fsys := cm.configFS(); fsys != nil {
+		return fs.ReadFile(fsys, path)
+	}
+	return os.ReadFile(path)
+}
+
+// configFS 获取当前使用的 fs.FS，未设置时返回 nil
+func (cm *ConfigManager233) configFS() fs.FS {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	return cm.fsys
+}
+
+// readConfigFile 按扩展名选择加载器读取配置文件，解析结果交给 commit 写入
+func (cm *ConfigManager233) readConfigFile(f configFile, commit configCommitFunc) error {
+	var loadErr error
+	switch f.ext {
+	case ".xlsx", ".xls":
+		if configName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path)); cm.isSheetFile(configName) {
+			_, loadErr = cm.readExcelAllSheets(f.path, commit)
+		} else {
+			loadErr = cm.readExcelConfig(f.path, commit)
+		}
+		if loadErr != nil {
+			getLogger().Error(loadErr, "加载Excel配置失败", "path", f.path)
+		}
+	case ".json", ".jsonl":
+		loadErr = cm.readJsonConfig(f.path, commit)
+		if loadErr != nil {
+			configName := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
+			getLogger().Error(loadErr, "加载JSON配置失败", "path", f.path, "configName", configName)
+		}
+	case ".tsv", ".csv":
+		loadErr = cm.readTsvConfig(f.path, commit)
+		if loadErr != nil {
+			getLogger().Error(loadErr, "加载TSV配置失败", "path", f.path)
+		}
+	case ".yaml", ".yml":
+		loadErr = cm.readYamlConfig(f.path, commit)
+		if loadErr != nil {
+			getLogger().Error(loadErr, "加载YAML配置失败", "path", f.path)
+		}
+	case ".xml":
+		loadErr = cm.readXmlConfig(f.path, commit)
+		if loadErr != nil {
+			getLogger().Error(loadErr, "加载XML配置失败", "path", f.path)
+		}
+	}
+	return loadErr
+}
+
+// notifyAllConfigsLoaded 全量加载完成后保存快照、通知业务管理器，并更新加载时间
+// 参数:
+//
+//	allLoaded: 本次是否所有配置文件都加载成功，只有为 true 时才会触发一次性的 OnFirstAllConfigDone
+func (cm *ConfigManager233) notifyAllConfigsLoaded(allLoaded bool) {
+	cm.Snapshot()
 
 	// 加载完成后调用业务配置管理器的回调（批量）
 	cm.mutex.RLock()
@@ -745,60 +1631,258 @@ func (cm *ConfigManager233) LoadAllConfigs() error {
 	cm.mutex.RUnlock()
 
 	// 批量通知所有业务管理器（每个管理器收到独立的切片副本，防止数据污染）
+	cm.publishHotUpdate(configNames)
 	if len(configNames) > 0 {
 		for _, manager := range cm.businessManagers {
 			// 为每个管理器创建独立副本，防止某个管理器修改影响其他管理器
 			configNamesCopy := make([]string, len(configNames))
 			copy(configNamesCopy, configNames)
-			manager.OnConfigLoadComplete(configNamesCopy)
+			callBusinessManager(manager, "OnConfigLoadComplete", func() { manager.OnConfigLoadComplete(configNamesCopy) })
 		}
 	}
 
-	// 首次加载完成后，调用 OnFirstAllConfigDone 回调
-	// 使用 CAS 确保只调用一次
-	if cm.isFirstLoadDone.CompareAndSwap(false, true) {
+	// 首次所有配置都加载成功后，调用 OnFirstAllConfigDone 回调
+	// 有文件加载失败时不消耗这次回调，留给之后全部成功的加载；使用 CAS 确保只调用一次
+	if allLoaded && cm.isFirstLoadDone.CompareAndSwap(false, true) {
 		for _, manager := range cm.businessManagers {
-			manager.OnFirstAllConfigDone()
+			callBusinessManager(manager, "OnFirstAllConfigDone", manager.OnFirstAllConfigDone)
 		}
 		getLogger().Info("首次配置加载完成，已通知所有业务管理器")
 	}
 
 	// 更新最后一次加载配置的时间戳
 	cm.lastLoadTimeMs.Store(time.Now().UnixMilli())
+}
 
-	return nil
+// callBusinessManager 调用业务管理器的回调，捕获 panic 并记录日志
+// 单个管理器 panic 不会中断加载流程，也不影响后续管理器收到回调
+func callBusinessManager(manager IBusinessConfigManager, callback string, fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			getLogger().Error(fmt.Errorf("%v", r), "业务管理器回调发生 panic", "callback", callback, "manager", fmt.Sprintf("%T", manager))
+		}
+	}()
+	fn()
 }
 
 // =====================================================
 // 泛型查询方法
 // =====================================================
 
-// idToString 将多种类型的 ID 统一转换为 string
-func (cm *ConfigManager233) idToString(id interface{}) (string, bool) {
+// normalizeId 将多种类型的 ID 统一规范化为 configMaps 中的 string key
+// 字符串去除首尾空白；整数按十进制输出；整数值的浮点数（如 JSON 中的 1、1.0）去掉小数部分，
+// 其余浮点数按最短形式输出且不使用科学计数法；其余类型按 %v 输出
+// 加载建立索引与按 ID 查询都经过该函数，保证 1、"1"、1.0 命中同一条配置
+func normalizeId(id interface{}) string {
 	switch v := id.(type) {
+	case nil:
+		return ""
 	case string:
-		return v, true
+		return strings.TrimSpace(v)
 	case int:
-		return strconv.Itoa(v), true
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
 	case int64:
-		return strconv.FormatInt(v, 10), true
+		return strconv.FormatInt(v, 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return normalizeFloatId(float64(v), 32)
+	case float64:
+		return normalizeFloatId(v, 64)
+	case json.Number:
+		return strings.TrimSpace(v.String())
 	default:
-		getLogger().Error(nil, "不支持的 ID 类型", "type", fmt.Sprintf("%T", id))
-		return "", false
+		return strings.TrimSpace(fmt.Sprintf("%v", id))
+	}
+}
+
+// normalizeFloatId 浮点 ID 为整数值时去掉小数部分，否则按最短形式输出
+func normalizeFloatId(f float64, bitSize int) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'f', -1, bitSize)
+}
+
+// extractConfigId 从一条配置数据中提取配置 ID
+// 优先查找 id/ID/Id 字段，找不到时使用约定的第一列（columnNames 的第一个元素）
+// 值为空时返回空字符串
+func extractConfigId(item map[string]interface{}, columnNames []string) string {
+	keys := []string{"id", "ID", "Id"}
+	if len(columnNames) > 0 {
+		keys = append(keys, columnNames[0])
+	}
+	for _, key := range keys {
+		idVal, ok := item[key]
+		if !ok || idVal == nil {
+			continue
+		}
+		if id := normalizeId(idVal); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// uidFieldCache 结构体类型 -> 带 config233:"uid" 标签的字段下标（没有该字段时为 nil）
+var uidFieldCache sync.Map
+
+// uidFieldIndex 查找结构体中带 config233:"uid" 标签的字段（包括嵌入结构体中的字段），结果按类型缓存
+func uidFieldIndex(t reflect.Type) []int {
+	if cached, ok := uidFieldCache.Load(t); ok {
+		return cached.([]int)
+	}
+	var index []int
+	for _, field := range reflect.VisibleFields(t) {
+		if field.IsExported() && field.Tag.Get("config233") == "uid" {
+			index = field.Index
+			break
+		}
 	}
+	uidFieldCache.Store(t, index)
+	return index
+}
+
+// extractItemId 提取一条配置的 ID，用于建立 ID 索引
+// 转换后的结构体上有 config233:"uid" 字段时优先使用该字段的值（主键可以叫 itemId、skillId 等），
+// 没有该字段、转换失败或值为空字符串时，按 extractConfigId 的约定从原始数据中提取
+func extractItemId(converted interface{}, item map[string]interface{}, columnNames []string) string {
+	v := reflect.ValueOf(converted)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if index := uidFieldIndex(v.Type()); index != nil {
+			if field, err := v.FieldByIndexErr(index); err == nil {
+				for field.Kind() == reflect.Ptr && !field.IsNil() {
+					field = field.Elem()
+				}
+				if field.Kind() != reflect.Ptr {
+					if id := normalizeId(field.Interface()); id != "" {
+						return id
+					}
+				}
+			}
+		}
+	}
+	return extractConfigId(item, columnNames)
 }
 
 // GetConfigById 根据 ID 获取单个配置（O(1) 查找）- 指定管理器
+// id 支持 int/int64/float64/string 等类型，经 normalizeId 规范化后查找，1、"1"、1.0 命中同一条
 func GetConfigById[T any](id interface{}) (*T, bool) {
-	cm := GetInstance()
-	configName := typeNameOf[T]()
+	return GetConfigByIdFrom[T](GetInstance(), id)
+}
+
+// GetConfigByIdFrom 与 GetConfigById 相同，但从指定的管理器 cm 读取
+func GetConfigByIdFrom[T any](cm *ConfigManager233, id interface{}) (*T, bool) {
+	configName := configNameOf[T](cm)
 	return getConfigByIdWithNameForManager[T](cm, configName, id)
 }
 
+// ErrConfigTypeNotLoaded 配置表没有加载：没有与类型名（或 RegisterTypeWithName 指定的配置名）同名的配置文件，
+// 或配置数据无法转换为该类型
+var ErrConfigTypeNotLoaded = errors.New("配置类型未加载")
+
+// ErrConfigIdNotFound 配置表已加载，但其中没有该 ID
+var ErrConfigIdNotFound = errors.New("配置 ID 不存在")
+
+// GetConfigByIdE 根据 ID 获取单个配置，找不到时返回可区分原因的错误（纯泛型）
+// 与 GetConfigById 查找规则相同，用于排查"忘了 RegisterType"与"数据缺失"
+// 参数:
+//
+//	id: 配置 ID，规范化规则同 GetConfigById
+//
+// 返回值:
+//
+//	*T: 找到的配置
+//	error: 用 errors.Is 判断原因，ErrConfigTypeNotLoaded 表示配置表未加载，ErrConfigIdNotFound 表示表中没有该 ID
+func GetConfigByIdE[T any](id interface{}) (*T, error) {
+	return GetConfigByIdEFrom[T](GetInstance(), id)
+}
+
+// GetConfigByIdEFrom 与 GetConfigByIdE 相同，但从指定的管理器 cm 读取
+func GetConfigByIdEFrom[T any](cm *ConfigManager233, id interface{}) (*T, error) {
+	configName := configNameOf[T](cm)
+	if result, ok := getConfigByIdWithNameForManager[T](cm, configName, id); ok {
+		return result, nil
+	}
+
+	// 接口类型跨所有配置查找，没有单独的配置表
+	var zero T
+	if tType := reflect.TypeOf(zero); tType != nil && tType.Kind() == reflect.Interface {
+		return nil, fmt.Errorf("%w: 没有实现 %s 且 ID 为 %v 的配置", ErrConfigIdNotFound, tType, id)
+	}
+
+	cm.mutex.RLock()
+	configMap, loaded := cm.configMaps[configName]
+	cm.mutex.RUnlock()
+	if !loaded {
+		return nil, fmt.Errorf("%w: 配置 %s 未加载，请检查配置文件是否存在、RegisterTypeWithName 的配置名是否与文件名一致", ErrConfigTypeNotLoaded, configName)
+	}
+	if _, exists := configMap[normalizeId(id)]; exists {
+		// 数据存在但无法转换为 *T，例如同名配置注册的是另一个类型
+		return nil, fmt.Errorf("%w: 配置 %s 已加载但无法转换为 %T，请检查 RegisterType 注册的类型", ErrConfigTypeNotLoaded, configName, zero)
+	}
+	return nil, fmt.Errorf("%w: 配置 %s 中不存在 ID %v", ErrConfigIdNotFound, configName, id)
+}
+
+// GetConfigByIds 根据多个 ID 批量获取配置（纯泛型）
+// 只读取一次缓存快照完成全部查找，避免逐个调用 GetConfigById 的开销
+// 参数:
+//
+//	ids: 要查询的配置 ID 列表
+//
+// 返回值:
+//
+//	map[string]*T: 命中的配置，key 为传入的 ID（查找前经 normalizeId 去除空白）
+//	[]string: 未命中的 ID，保持传入顺序
+func GetConfigByIds[T any](ids []string) (map[string]*T, []string) {
+	return GetConfigByIdsFrom[T](GetInstance(), ids)
+}
+
+// GetConfigByIdsFrom 与 GetConfigByIds 相同，但从指定的管理器 cm 读取
+func GetConfigByIdsFrom[T any](cm *ConfigManager233, ids []string) (map[string]*T, []string) {
+	found := make(map[string]*T, len(ids))
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	configName := configNameOf[T](cm)
+
+	// 优先从缓存获取 (Lock-Free)
+	idMap, exists := getGlobalIdMapCache(cm)[configName]
+	cm.cacheStats.recordLookup(exists)
+	if !exists {
+		// 缓存未命中，从实例获取 (Need Lock)
+		cm.mutex.RLock()
+		idMap, exists = cm.configMaps[configName]
+		cm.mutex.RUnlock()
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if exists {
+			if item, ok := idMap[normalizeId(id)]; ok {
+				if result := convertToType[T](item); result != nil {
+					found[id] = result
+					continue
+				}
+			}
+		}
+		missing = append(missing, id)
+	}
+	return found, missing
+}
+
 // getConfigByIdWithNameForManager 根据配置名和 ID 获取单个配置 - 指定管理器（内部使用）
 func getConfigByIdWithNameForManager[T any](cm *ConfigManager233, configName string, configId interface{}) (*T, bool) {
-	idStr, ok := cm.idToString(configId)
-	if !ok {
+	idStr := normalizeId(configId)
+	if idStr == "" {
 		return nil, false
 	}
 
@@ -817,10 +1901,12 @@ func getConfigByIdWithNameForManager[T any](cm *ConfigManager233, configName str
 			if item, ok := idMap[idStr]; ok {
 				// 尝试转换为 *T
 				if result := convertToType[T](item); result != nil {
+					cm.cacheStats.recordLookup(true)
 					return result, true
 				}
 			}
 		}
+		cm.cacheStats.recordLookup(false)
 		return nil, false
 	}
 
@@ -829,13 +1915,16 @@ func getConfigByIdWithNameForManager[T any](cm *ConfigManager233, configName str
 		if item, ok := idMap[idStr]; ok {
 			// 尝试转换为 *T
 			if result := convertToType[T](item); result != nil {
+				cm.cacheStats.recordLookup(true)
 				return result, true
 			}
 		}
+		cm.cacheStats.recordLookup(false)
 		return nil, false
 	}
 
 	// 缓存未命中，从实例获取 (Need Lock)
+	cm.cacheStats.recordLookup(false)
 	// 如果 T 是接口类型，需要遍历所有配置
 	if isInterface {
 		cm.mutex.RLock()
@@ -925,15 +2014,22 @@ func convertToType[T any](data interface{}) *T {
 	return nil
 }
 
-// typeNameOf 获取类型名称，指针时取元素名
-func typeNameOf[T any]() string {
+// configNameOf 获取类型对应的配置名
+// 通过 RegisterTypeWithName 指定过配置名时使用该名称，否则使用类型名（指针时取元素名）
+func configNameOf[T any](cm *ConfigManager233) string {
 	var zero T
 	typ := reflect.TypeOf(zero)
 	if typ == nil {
 		return ""
 	}
 	if typ.Kind() == reflect.Ptr {
-		return typ.Elem().Name()
+		typ = typ.Elem()
+	}
+
+	if cm != nil {
+		if name, ok := cm.typeConfigNames.Load(typ); ok {
+			return name.(string)
+		}
 	}
 	return typ.Name()
 }
@@ -971,69 +2067,17 @@ func getGlobalIdMapCache(cm *ConfigManager233) map[string]map[string]interface{}
 }
 
 // convertMapToStruct 将 map[string]interface{} 转换为指定的 struct 类型
-// 使用 config233_column tag 来映射字段，如果没有则使用字段名匹配
+// 字段匹配规则与 convertMapToRegisteredStruct 相同，见 lookupFieldValue
 func convertMapToStruct[T any](data map[string]interface{}) (*T, error) {
 	var result T
 	typ := reflect.TypeOf(result)
-	val := reflect.ValueOf(&result).Elem()
-
-	// 构建 map key 到 struct 字段的映射并设置值
-	for i := 0; i < typ.NumField(); i++ {
-		field := typ.Field(i)
-		fieldName := field.Name
-		columnTag := field.Tag.Get("config233_column")
-		jsonTag := field.Tag.Get("json")
-		if jsonTag != "" {
-			parts := strings.Split(jsonTag, ",")
-			jsonTag = parts[0]
-		}
-
-		// 确定要查找的 key
-		var keyToFind string
-		if columnTag != "" {
-			keyToFind = columnTag
-		} else if jsonTag != "" {
-			keyToFind = jsonTag
-		} else {
-			keyToFind = lowerFirst(fieldName)
-		}
-
-		// 在 data 中查找对应的值
-		var value interface{}
-		var found bool
-
-		if v, ok := data[keyToFind]; ok {
-			value = v
-			found = true
-		} else if columnTag == "" && jsonTag == "" {
-			// 如果没有 config233_column 或 json tag，尝试不区分大小写匹配
-			for k, v := range data {
-				if strings.EqualFold(k, fieldName) || strings.EqualFold(k, keyToFind) {
-					value = v
-					found = true
-					break
-				}
-			}
-		}
-
-		if !found {
-			continue
-		}
-
-		fieldValue := val.Field(i)
-		if !fieldValue.CanSet() {
-			continue
-		}
-
-		if err := setFieldValueFromInterface(fieldValue, value, "", ""); err != nil {
-			fmt.Printf("\033[31m[config233] 字段类型转换失败 [%s.%s]: %v\033[0m\n", typ.Name(), fieldName, err)
-		}
-	}
+	fillStructFromMap(reflect.ValueOf(&result).Elem(), data, typ.Name())
+	applyFieldDefaults(reflect.ValueOf(&result).Elem())
 
 	// 校验
 	if validator, ok := any(&result).(IConfigValidator); ok {
 		if err := validator.Check(); err != nil {
-			fmt.Printf("\033[31m[config233] 配置校验失败 [%s]: %v\033[0m\n", typ.Name(), err)
+			getLogger().Error(err, "配置校验失败", "configName", typ.Name())
 		}
 	}
 
@@ -1100,34 +2144,190 @@ func convertSliceToStructSlice[T any](data []interface{}) ([]*T, error) {
 
 // GetConfigList 获取某类型的所有配置列表（纯泛型）- 指定管理器
 // 返回 []*T，相当于 map.values() 转 slice
+// 配置未加载（忘记 RegisterType 或没有同名配置文件）时返回 nil，并对每个配置名只记录一次错误日志；
+// 需要区分"未加载"与"表为空"时使用 GetConfigListE
 func GetConfigList[T any]() []*T {
-	cm := GetInstance()
-	configName := typeNameOf[T]()
+	return GetConfigListFrom[T](GetInstance())
+}
+
+// GetConfigListFrom 与 GetConfigList 相同，但从指定的管理器 cm 读取
+func GetConfigListFrom[T any](cm *ConfigManager233) []*T {
+	result, err := GetConfigListEFrom[T](cm)
+	if errors.Is(err, ErrConfigTypeNotLoaded) {
+		cm.warnMissingConfigType(configNameOf[T](cm), err)
+	}
+	return result
+}
+
+// GetConfigListE 获取某类型的所有配置列表，配置未加载时返回明确的错误而不是空切片
+// 返回值:
+//
+//	[]*T: 配置列表，配置已加载但为空表时返回空切片
+//	error: 配置未加载时返回 ErrConfigTypeNotLoaded（可用 errors.Is 判断），错误信息包含配置名
+func GetConfigListE[T any]() ([]*T, error) {
+	return GetConfigListEFrom[T](GetInstance())
+}
+
+// GetConfigListEFrom 与 GetConfigListE 相同，但从指定的管理器 cm 读取
+func GetConfigListEFrom[T any](cm *ConfigManager233) ([]*T, error) {
+	configName := configNameOf[T](cm)
 
 	// Lock-Free
 	slices := getGlobalSliceCache(cm)
 	if slices == nil {
-		return nil
+		return nil, fmt.Errorf("%w: 配置管理器未初始化", ErrConfigTypeNotLoaded)
+	}
+	slice, exists := slices[configName]
+	cm.cacheStats.recordLookup(exists)
+
+	if !exists {
+		var zero T
+		return nil, fmt.Errorf("%w: 类型 %T 未注册或对应配置文件 %s 未找到，请检查 RegisterType 与配置文件名", ErrConfigTypeNotLoaded, zero, configName)
+	}
+
+	result, err := convertSliceToStructSlice[T](slice)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// warnMissingConfigType 记录配置未加载的错误日志，每个配置名只记录一次，避免每次查询都刷日志
+func (cm *ConfigManager233) warnMissingConfigType(configName string, err error) {
+	if cm == nil {
+		return
+	}
+	if _, warned := cm.missingTypeWarned.LoadOrStore(configName, struct{}{}); !warned {
+		getLogger().Error(err, "查询的配置未加载，返回空列表（之后的查询不再提示）", "configName", configName)
+	}
+}
+
+// clearMissingTypeWarnings 清除已提示的记录
+func (cm *ConfigManager233) clearMissingTypeWarnings() {
+	cm.missingTypeWarned.Range(func(k, _ interface{}) bool {
+		cm.missingTypeWarned.Delete(k)
+		return true
+	})
+}
+
+// GetConfigListByFilter 按条件筛选某类型的配置列表（纯泛型）
+// 遍历缓存中已转换好的结构体切片，返回满足条件的新切片，不会修改缓存本身
+// 参数:
+//
+//	predicate: 筛选条件，返回 true 的配置会被保留；为 nil 时返回全部配置
+//
+// 返回值:
+//
+//	[]*T: 满足条件的配置列表，没有该类型配置时返回空切片
+func GetConfigListByFilter[T any](predicate func(*T) bool) []*T {
+	return GetConfigListByFilterFrom[T](GetInstance(), predicate)
+}
+
+// GetConfigListByFilterFrom 与 GetConfigListByFilter 相同，但从指定的管理器 cm 读取
+func GetConfigListByFilterFrom[T any](cm *ConfigManager233, predicate func(*T) bool) []*T {
+	configName := configNameOf[T](cm)
+
+	// Lock-Free
+	slices := getGlobalSliceCache(cm)
+	slice, exists := slices[configName]
+	cm.cacheStats.recordLookup(exists)
+	if !exists {
+		return make([]*T, 0)
+	}
+
+	list, err := convertSliceToStructSlice[T](slice)
+	if err != nil {
+		return make([]*T, 0)
+	}
+
+	result := make([]*T, 0, len(list))
+	for _, item := range list {
+		if predicate == nil || predicate(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// GetConfigListSorted 获取按比较函数排序的配置列表（纯泛型）
+// 基于缓存中已转换好的结构体切片复制一份后做稳定排序，比较结果相同的配置保持加载时的顺序，不会修改缓存本身
+// 参数:
+//
+//	less: 比较函数，a 应排在 b 之前时返回 true；为 nil 时不排序
+//
+// 返回值:
+//
+//	[]*T: 排序后的配置列表，没有该类型配置时返回空切片
+func GetConfigListSorted[T any](less func(a, b *T) bool) []*T {
+	return GetConfigListSortedFrom[T](GetInstance(), less)
+}
+
+// GetConfigListSortedFrom 与 GetConfigListSorted 相同，但从指定的管理器 cm 读取
+func GetConfigListSortedFrom[T any](cm *ConfigManager233, less func(a, b *T) bool) []*T {
+	// 过滤结果总是新切片，排序不影响内部存储
+	result := GetConfigListByFilterFrom[T](cm, nil)
+	if less != nil {
+		sort.SliceStable(result, func(i, j int) bool {
+			return less(result[i], result[j])
+		})
 	}
+	return result
+}
+
+// ForEachConfig 按加载顺序遍历某类型的配置，不拷贝整个列表（纯泛型）
+// 直接遍历缓存中的切片快照，适合对几万条的大配置做统计、查找；fn 返回 false 时提前终止
+// 快照在加载时整体替换，遍历期间发生热重载不影响本次遍历（仍是旧数据），也不会阻塞热重载
+// 注意: 元素与缓存共享，fn 内不要修改元素；也不要在 fn 内做长时间阻塞的操作，否则旧快照会一直无法释放
+// 参数:
+//
+//	fn: 对每条配置调用的函数，返回 false 时停止遍历
+func ForEachConfig[T any](fn func(*T) bool) {
+	ForEachConfigFrom[T](GetInstance(), fn)
+}
+
+// ForEachConfigFrom 与 ForEachConfig 相同，但从指定的管理器 cm 读取
+func ForEachConfigFrom[T any](cm *ConfigManager233, fn func(*T) bool) {
+	configName := configNameOf[T](cm)
+
+	// Lock-Free
+	slices := getGlobalSliceCache(cm)
 	slice, exists := slices[configName]
-
+	cm.cacheStats.recordLookup(exists)
 	if !exists {
-		return nil
+		return
 	}
 
-	result, err := convertSliceToStructSlice[T](slice)
-	if err != nil {
-		return nil
+	for _, item := range slice {
+		typedItem, ok := item.(*T)
+		if !ok {
+			// 未注册类型时缓存中是 map，逐条转换
+			mapItem, isMap := item.(map[string]interface{})
+			if !isMap {
+				continue
+			}
+			converted, err := convertMapToStruct[T](mapItem)
+			if err != nil {
+				getLogger().Error(err, "转换切片元素失败", "data", mapItem)
+				continue
+			}
+			typedItem = converted
+		}
+		if !fn(typedItem) {
+			return
+		}
 	}
-
-	return result
 }
 
 // GetConfigListCount 获取某类型配置列表的数量（纯泛型）
 // 只读取缓存中的切片长度，不做结构体转换，避免重复触发生命周期回调
 func GetConfigListCount[T any]() int {
-	cm := GetInstance()
-	configName := typeNameOf[T]()
+	return GetConfigListCountFrom[T](GetInstance())
+}
+
+// GetConfigListCountFrom 与 GetConfigListCount 相同，但从指定的管理器 cm 读取
+func GetConfigListCountFrom[T any](cm *ConfigManager233) int {
+	configName := configNameOf[T](cm)
 
 	// Lock-Free
 	slices := getGlobalSliceCache(cm)
@@ -1135,6 +2335,7 @@ func GetConfigListCount[T any]() int {
 		return 0
 	}
 	slice, exists := slices[configName]
+	cm.cacheStats.recordLookup(exists)
 	if !exists {
 		return 0
 	}
@@ -1144,9 +2345,15 @@ func GetConfigListCount[T any]() int {
 
 // GetConfigMap 获取某类型的配置映射（纯泛型）
 // 返回 map[string]*T，其中 key 是配置的 ID
+// 每次调用都返回新建的浅拷贝 map：增删 key 不会影响全局缓存和下次调用，
+// 但 value 与缓存共享同一个 *T，不应修改其指向的配置内容
 func GetConfigMap[T any]() map[string]*T {
-	cm := GetInstance()
-	configName := typeNameOf[T]()
+	return GetConfigMapFrom[T](GetInstance())
+}
+
+// GetConfigMapFrom 与 GetConfigMap 相同，但从指定的管理器 cm 读取
+func GetConfigMapFrom[T any](cm *ConfigManager233) map[string]*T {
+	configName := configNameOf[T](cm)
 
 	// Lock-Free
 	idMaps := getGlobalIdMapCache(cm)
@@ -1154,6 +2361,7 @@ func GetConfigMap[T any]() map[string]*T {
 		return nil
 	}
 	idMap, exists := idMaps[configName]
+	cm.cacheStats.recordLookup(exists)
 
 	if !exists {
 		return nil
@@ -1179,7 +2387,7 @@ func GetConfigMap[T any]() map[string]*T {
 // 对于未找到的配置，会打错误日志
 func getKvConfigInternal[T any](id string) (*T, bool) {
 	cm := GetInstance()
-	configName := typeNameOf[T]()
+	configName := configNameOf[T](cm)
 
 	// 获取配置项
 	config, exists := getConfigByIdWithNameForManager[T](cm, configName, id)
@@ -1191,6 +2399,27 @@ func getKvConfigInternal[T any](id string) (*T, bool) {
 	return config, true
 }
 
+// getKvValueInternal 获取 KV 配置项的字符串值
+// 配置不存在、未实现 IKvConfig 或值为空字符串时返回 false，调用方统一返回默认值
+func getKvValueInternal[T any](id string) (string, bool) {
+	config, exists := getKvConfigInternal[T](id)
+	if !exists {
+		return "", false
+	}
+
+	// 使用类型断言将 *T 转换为 IKvConfig 接口
+	kvConfig, ok := any(config).(IKvConfig)
+	if !ok {
+		return "", false
+	}
+
+	value := kvConfig.GetValue()
+	if value == "" {
+		return "", false
+	}
+	return value, true
+}
+
 // GetKvToString 从 KV 配置中获取字符串值
 // 参数:
 //
@@ -1203,24 +2432,11 @@ func getKvConfigInternal[T any](id string) (*T, bool) {
 //
 // 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
 func GetKvToString[T any](id string, defaultVal string) string {
-	// 获取配置项
-	config, exists := getKvConfigInternal[T](id)
-	if !exists {
-		return defaultVal
-	}
-
-	// 使用类型断言将 *T 转换为 IKvConfig 接口
-	kvConfig, ok := any(config).(IKvConfig)
+	value, ok := getKvValueInternal[T](id)
 	if !ok {
 		return defaultVal
 	}
 
-	// 调用 GetValue 方法获取值
-	value := kvConfig.GetValue()
-	if value == "" {
-		return defaultVal
-	}
-
 	return value
 }
 
@@ -1236,24 +2452,11 @@ func GetKvToString[T any](id string, defaultVal string) string {
 //
 // 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
 func GetKvToInt[T any](id string, defaultVal int) int {
-	// 获取配置项
-	config, exists := getKvConfigInternal[T](id)
-	if !exists {
-		return defaultVal
-	}
-
-	// 使用类型断言将 *T 转换为 IKvConfig 接口
-	kvConfig, ok := any(config).(IKvConfig)
+	value, ok := getKvValueInternal[T](id)
 	if !ok {
 		return defaultVal
 	}
 
-	// 调用 GetValue 方法获取值
-	value := kvConfig.GetValue()
-	if value == "" {
-		return defaultVal
-	}
-
 	// 尝试转换为整数
 	if intVal, err := strconv.Atoi(value); err == nil {
 		return intVal
@@ -1274,38 +2477,17 @@ func GetKvToInt[T any](id string, defaultVal int) int {
 //
 // 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
 func GetKvToBoolean[T any](id string, defaultVal bool) bool {
-	// 获取配置项
-	config, exists := getKvConfigInternal[T](id)
-	if !exists {
-		return defaultVal
-	}
-
-	// 使用类型断言将 *T 转换为 IKvConfig 接口
-	kvConfig, ok := any(config).(IKvConfig)
+	value, ok := getKvValueInternal[T](id)
 	if !ok {
 		return defaultVal
 	}
 
-	// 调用 GetValue 方法获取值
-	value := kvConfig.GetValue()
-	if value == "" {
-		return defaultVal
-	}
-
-	// 尝试转换为布尔值（支持 true/false, 1/0, yes/no, on/off）
-	lowerValue := strings.ToLower(strings.TrimSpace(value))
-	switch lowerValue {
-	case "true", "1", "yes", "on", "enabled":
-		return true
-	case "false", "0", "no", "off", "disabled":
-		return false
-	default:
-		// 如果无法解析，尝试使用 strconv.ParseBool
-		if boolVal, err := strconv.ParseBool(value); err == nil {
-			return boolVal
-		}
+	// 尝试转换为布尔值（支持 true/false, 1/0, yes/no, y/n, on/off, 是/否）
+	boolVal, err := convert.ParseBool(value)
+	if err != nil {
 		return defaultVal
 	}
+	return boolVal
 }
 
 // GetKvToCsvStringList 从 KV 配置中获取 CSV 字符串列表（按逗号分隔）
@@ -1320,32 +2502,137 @@ func GetKvToBoolean[T any](id string, defaultVal bool) bool {
 //
 // 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
 func GetKvToCsvStringList[T any](id string, defaultVal []string) []string {
-	// 获取配置项
+	value, ok := getKvValueInternal[T](id)
+	if !ok {
+		return defaultVal
+	}
+
+	// 按逗号分割并去除空格
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		trimmed := strings.TrimSpace(part)
+		if trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+
+	if len(result) == 0 {
+		return defaultVal
+	}
+
+	return result
+}
+
+// GetKvToCsvStringListOrEmpty 从 KV 配置中获取 CSV 字符串列表，区分"未配置"与"配置为空"
+// 与 GetKvToCsvStringList 不同，id 存在但值为空（或只有分隔符）时返回空切片而不是默认值
+// 参数:
+//
+//	id: 配置项的 ID
+//
+// 返回值:
+//
+//	[]string: 解析后的字符串列表（按逗号分隔），配置为空时为非 nil 的空切片
+//	bool: id 是否存在且实现了 IKvConfig
+func GetKvToCsvStringListOrEmpty[T any](id string) ([]string, bool) {
 	config, exists := getKvConfigInternal[T](id)
 	if !exists {
-		return defaultVal
+		return nil, false
 	}
 
-	// 使用类型断言将 *T 转换为 IKvConfig 接口
 	kvConfig, ok := any(config).(IKvConfig)
+	if !ok {
+		return nil, false
+	}
+
+	parts := strings.Split(kvConfig.GetValue(), ",")
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		trimmed := strings.TrimSpace(part)
+		if trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result, true
+}
+
+// GetKvToInt64 从 KV 配置中获取 int64 值
+// 参数:
+//
+//	id: 配置项的 ID
+//	defaultVal: 如果配置不存在或值无效时的默认值
+//
+// 返回值:
+//
+//	int64: 配置的 int64 值
+//
+// 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
+func GetKvToInt64[T any](id string, defaultVal int64) int64 {
+	value, ok := getKvValueInternal[T](id)
 	if !ok {
 		return defaultVal
 	}
 
-	// 调用 GetValue 方法获取值
-	value := kvConfig.GetValue()
-	if value == "" {
+	if intVal, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+		return intVal
+	}
+
+	return defaultVal
+}
+
+// GetKvToFloat64 从 KV 配置中获取浮点值
+// 参数:
+//
+//	id: 配置项的 ID
+//	defaultVal: 如果配置不存在或值无效时的默认值
+//
+// 返回值:
+//
+//	float64: 配置的浮点值
+//
+// 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
+func GetKvToFloat64[T any](id string, defaultVal float64) float64 {
+	value, ok := getKvValueInternal[T](id)
+	if !ok {
+		return defaultVal
+	}
+
+	if floatVal, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+		return floatVal
+	}
+
+	return defaultVal
+}
+
+// GetKvToCsvIntList 从 KV 配置中获取 CSV 整数列表（按逗号分隔）
+// 参数:
+//
+//	id: 配置项的 ID
+//	defaultVal: 如果配置不存在、值为空或有无法解析的元素时的默认值
+//
+// 返回值:
+//
+//	[]int: 解析后的整数列表（按逗号分隔，忽略空元素）
+//
+// 注意: 这里的 T 不再受 IKvConfig 约束，实际约束由运行时的类型断言保证（*T 或 T 需要实现 IKvConfig）
+func GetKvToCsvIntList[T any](id string, defaultVal []int) []int {
+	value, ok := getKvValueInternal[T](id)
+	if !ok {
 		return defaultVal
 	}
 
-	// 按逗号分割并去除空格
 	parts := strings.Split(value, ",")
-	result := make([]string, 0, len(parts))
+	result := make([]int, 0, len(parts))
 	for _, part := range parts {
 		trimmed := strings.TrimSpace(part)
-		if trimmed != "" {
-			result = append(result, trimmed)
+		if trimmed == "" {
+			continue
 		}
+		intVal, err := strconv.Atoi(trimmed)
+		if err != nil {
+			return defaultVal
+		}
+		result = append(result, intVal)
 	}
 
 	if len(result) == 0 {
@@ -1393,14 +2680,36 @@ func (cm *ConfigManager233) RegisterReloadFunc(fn func()) {
 
 // RegisterBusinessManager 注册业务配置管理器
 // 注册一个业务配置管理器，用于接收配置加载和热更新的回调
+// 等价于以优先级 0 调用 RegisterBusinessManagerWithPriority
 // 参数:
 //
 //	manager: 业务配置管理器实例
 func (cm *ConfigManager233) RegisterBusinessManager(manager IBusinessConfigManager) {
+	cm.RegisterBusinessManagerWithPriority(manager, 0)
+}
+
+// RegisterBusinessManagerWithPriority 以指定优先级注册业务配置管理器
+// 回调时按优先级从高到低调用，优先级相同时按注册顺序调用
+// 适合管理器之间有依赖的场景，例如 A 的缓存必须先于 B 刷新时给 A 更高的优先级
+// 参数:
+//
+//	manager: 业务配置管理器实例
+//	priority: 优先级，数值越大越先回调，RegisterBusinessManager 使用 0
+func (cm *ConfigManager233) RegisterBusinessManagerWithPriority(manager IBusinessConfigManager, priority int) {
 	cm.mutex.Lock()
 	defer cm.mutex.Unlock()
-	cm.businessManagers = append(cm.businessManagers, manager)
-	getLogger().Info("注册业务配置管理器", "type", fmt.Sprintf("%T", manager))
+
+	// 插入到第一个优先级更低的管理器之前，保持同优先级的注册顺序
+	idx := sort.Search(len(cm.businessPriority), func(i int) bool {
+		return cm.businessPriority[i] < priority
+	})
+	cm.businessManagers = append(cm.businessManagers, nil)
+	copy(cm.businessManagers[idx+1:], cm.businessManagers[idx:])
+	cm.businessManagers[idx] = manager
+	cm.businessPriority = append(cm.businessPriority, 0)
+	copy(cm.businessPriority[idx+1:], cm.businessPriority[idx:])
+	cm.businessPriority[idx] = priority
+	getLogger().Info("注册业务配置管理器", "type", fmt.Sprintf("%T", manager), "priority", priority)
 }
 
 // GetLoadedConfigNames 获取已加载的配置名列表
@@ -1479,8 +2788,8 @@ func (cm *ConfigManager233) getAllConfigs(configName string) (map[string]interfa
 //	interface{}: 配置项数据
 //	bool: 是否找到该配置项
 func (cm *ConfigManager233) getConfig(configName string, id interface{}) (interface{}, bool) {
-	idStr, ok := cm.idToString(id)
-	if !ok {
+	idStr := normalizeId(id)
+	if idStr == "" {
 		return nil, false
 	}
 	cm.mutex.RLock()
@@ -1558,6 +2867,144 @@ func (cm *ConfigManager233) setConfigCache(configName string, idMap map[string]i
 			break
 		}
 	}
+
+	// 3. 切片已替换，清除基于旧数据构建的二级索引
+	cm.invalidateConfigIndex(configName)
+	cm.cacheStats.rebuilds.Add(1)
+
+	// 4. 原子替换热更新持有器
+	cm.refreshHotUpdateHolders(configName)
+}
+
+// commitConfig 将解析好的配置写入共享数据与缓存
+// 配置已加载过（即重载）时，先对所有配置项执行 Check 校验，任一项失败则保留旧数据
+// 字段规则（如 config233_required）不满足时只记录错误日志，严格模式请使用 LoadAllConfigsStrict
+// 参数:
+//
+//	configName: 配置名称
+//	dataList: 原始数据列表
+//	configMap: ID -> 配置 映射
+//	slice: 配置列表
+//
+// 返回值:
+//
+//	error: 校验失败时返回错误，此时旧数据保持不变
+func (cm *ConfigManager233) commitConfig(configName string, dataList interface{}, configMap map[string]interface{}, slice []interface{}) error {
+	cm.mutex.RLock()
+	_, isReload := cm.configs[configName]
+	cm.mutex.RUnlock()
+
+	if isReload {
+		if errs := checkConfigItems(configName, configMap, slice); len(errs) > 0 {
+			getLogger().Error(errs, "重载配置校验失败，保留旧数据", "configName", configName)
+			return fmt.Errorf("配置 %s 校验失败，已保留旧数据: %w", configName, errs)
+		}
+	}
+
+	// 字段规则（如 config233_required）在宽松模式下只记录日志，仍然写入
+	if errs := checkFieldRules(configName, configMap, slice); len(errs) > 0 {
+		sortValidationErrors(errs)
+		getLogger().Error(errs, "配置字段规则校验未通过（宽松模式，仍加载）", "configName", configName)
+	}
+
+	// 加锁更新共享数据
+	cm.mutex.Lock()
+	cm.configs[configName] = dataList
+	cm.configMaps[configName] = configMap
+	cm.mutex.Unlock()
+
+	// 更新缓存（内部已有锁保护）
+	cm.setConfigCache(configName, configMap, slice)
+
+	// 导出配置到文件（如果开启）
+	cm.ExportConfigToJSON(configName, slice)
+	cm.ExportConfigToExcel(configName, slice)
+	return nil
+}
+
+// removeConfig 从内存中移除指定配置（配置文件被删除时使用）
+// 参数:
+//
+//	configName: 配置名称
+//
+// 返回值:
+//
+//	bool: 配置此前是否已加载
+func (cm *ConfigManager233) removeConfig(configName string) bool {
+	cm.mutex.Lock()
+	_, exists := cm.configs[configName]
+	delete(cm.configs, configName)
+	delete(cm.configMaps, configName)
+	cm.mutex.Unlock()
+
+	cm.removeConfigCache(configName)
+	cm.removeLoadStat(configName)
+	return exists
+}
+
+// ClearConfigs 清空所有已加载的配置数据
+// 同时清空全局缓存、二级索引和加载统计，不会触发 OnConfigLoadComplete，已注册的类型保持不变
+// 正在进行的读操作会读到清空前或清空后的完整快照，不会读到中间状态
+func (cm *ConfigManager233) ClearConfigs() {
+	cm.mutex.Lock()
+	cm.configs = make(map[string]interface{})
+	cm.configMaps = make(map[string]map[string]interface{})
+	cm.mutex.Unlock()
+
+	cm.globalIdMaps.Store(&map[string]map[string]interface{}{})
+	cm.globalSlices.Store(&map[string][]interface{}{})
+	cm.clearConfigIndex()
+	cm.clearLoadStats()
+	cm.clearConfigRefs()
+	cm.refreshAllHotUpdateHolders()
+}
+
+// removeConfigCache 从全局缓存中移除指定配置 - 完全无锁
+func (cm *ConfigManager233) removeConfigCache(configName string) {
+	// 1. 无锁更新 ID Maps (CAS 重试)
+	for {
+		currentIdMapsPtr := cm.globalIdMaps.Load().(*map[string]map[string]interface{})
+		currentIdMaps := *currentIdMapsPtr
+		if _, exists := currentIdMaps[configName]; !exists {
+			break
+		}
+
+		// Copy-On-Write
+		newIdMaps := make(map[string]map[string]interface{}, len(currentIdMaps))
+		for k, v := range currentIdMaps {
+			if k != configName {
+				newIdMaps[k] = v
+			}
+		}
+
+		if cm.globalIdMaps.CompareAndSwap(currentIdMapsPtr, &newIdMaps) {
+			break
+		}
+	}
+
+	// 2. 无锁更新 Slices (CAS 重试)
+	for {
+		currentSlicesPtr := cm.globalSlices.Load().(*map[string][]interface{})
+		currentSlices := *currentSlicesPtr
+		if _, exists := currentSlices[configName]; !exists {
+			break
+		}
+
+		// Copy-On-Write
+		newSlices := make(map[string][]interface{}, len(currentSlices))
+		for k, v := range currentSlices {
+			if k != configName {
+				newSlices[k] = v
+			}
+		}
+
+		if cm.globalSlices.CompareAndSwap(currentSlicesPtr, &newSlices) {
+			break
+		}
+	}
+
+	cm.invalidateConfigIndex(configName)
+	cm.refreshHotUpdateHolders(configName)
 }
 
 // getConfigMap 获取配置映射（内部方法）
@@ -0,0 +1,162 @@
+package config233
+
+import (
+	"reflect"
+	"sort"
+)
+
+// mapEntryOverhead map 每个条目的粗略额外开销（桶内 tophash、溢出指针等的均摊）
+const mapEntryOverhead = 16
+
+// EstimateMemoryUsage 按配置名粗略估算已加载配置占用的内存（字节），用于容量规划
+// 按反射遍历估算，包括三部分：转换后的配置对象（含其引用的字符串、切片、map 等）、
+// 加载时保留的原始数据（DataList）以及 ID 索引。结果不等于真实的堆占用（不计内存对齐的空洞、分配器的取整与 GC 开销），
+// 但对相同的数据总是得到相同的值，可以在不同版本、不同配置之间比较。
+// 被多处引用的对象只计一次：config233_ref 指向的其他配置计入被引用的配置本身
+// 返回值:
+//
+//	map[string]int64: 配置名 -> 估算的字节数，没有加载任何配置时返回空 map
+func (cm *ConfigManager233) EstimateMemoryUsage() map[string]int64 {
+	slices := getGlobalSliceCache(cm)
+	idMaps := getGlobalIdMapCache(cm)
+	cm.mutex.RLock()
+	rawData := make(map[string]interface{}, len(cm.configs))
+	for configName, dataList := range cm.configs {
+		rawData[configName] = dataList
+	}
+	cm.mutex.RUnlock()
+
+	// 先登记所有配置对象，引用字段指向其他配置时不再重复计入
+	estimator := &memoryEstimator{seen: make(map[memoryKey]bool)}
+	for _, slice := range slices {
+		for _, item := range slice {
+			if v := reflect.ValueOf(item); v.Kind() == reflect.Ptr && !v.IsNil() {
+				estimator.seen[memoryKey{v.Pointer(), v.Type()}] = true
+			}
+		}
+	}
+
+	configNames := make([]string, 0, len(slices))
+	for configName := range slices {
+		configNames = append(configNames, configName)
+	}
+	sort.Strings(configNames)
+
+	usage := make(map[string]int64, len(configNames))
+	for _, configName := range configNames {
+		var size int64
+		for _, item := range slices[configName] {
+			v := reflect.ValueOf(item)
+			if v.Kind() == reflect.Ptr && !v.IsNil() {
+				size += int64(v.Type().Elem().Size()) + estimator.heapSize(v.Elem())
+			} else if v.IsValid() {
+				size += int64(v.Type().Size()) + estimator.heapSize(v)
+			}
+		}
+		if raw := rawData[configName]; raw != nil {
+			size += estimator.heapSize(reflect.ValueOf(raw))
+		}
+		for id := range idMaps[configName] {
+			size += int64(len(id)) + idIndexEntrySize
+		}
+		usage[configName] = size
+	}
+	return usage
+}
+
+// EstimateTotalMemoryUsage 估算所有已加载配置占用的内存总量（字节），为 EstimateMemoryUsage 各项之和
+// 返回值:
+//
+//	int64: 估算的字节数
+func (cm *ConfigManager233) EstimateTotalMemoryUsage() int64 {
+	var total int64
+	for _, size := range cm.EstimateMemoryUsage() {
+		total += size
+	}
+	return total
+}
+
+// idIndexEntrySize ID 索引（map[string]interface{}）每个条目不含 ID 字符串内容的大小
+var idIndexEntrySize = int64(reflect.TypeOf("").Size()+reflect.TypeOf((*interface{})(nil)).Elem().Size()) + mapEntryOverhead
+
+// memoryKey 已计入的对象：同一地址上不同类型的值（如结构体与其第一个字段）分开记录
+type memoryKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// memoryEstimator 按反射估算值引用的堆内存，seen 记录已计入的指针、切片与 map
+type memoryEstimator struct {
+	seen map[memoryKey]bool
+}
+
+// markSeen 登记一个引用，已登记过时返回 false
+func (e *memoryEstimator) markSeen(addr uintptr, typ reflect.Type) bool {
+	key := memoryKey{addr, typ}
+	if e.seen[key] {
+		return false
+	}
+	e.seen[key] = true
+	return true
+}
+
+// heapSize 估算 v 引用的堆内存，不含 v 本身的大小（v 所在的结构体、切片元素等已计入）
+func (e *memoryEstimator) heapSize(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || !e.markSeen(v.Pointer(), v.Type()) {
+			return 0
+		}
+		return int64(v.Type().Elem().Size()) + e.heapSize(v.Elem())
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		elem := v.Elem()
+		if elem.Kind() == reflect.Ptr {
+			return e.heapSize(elem)
+		}
+		return int64(elem.Type().Size()) + e.heapSize(elem)
+
+	case reflect.String:
+		return int64(v.Len())
+
+	case reflect.Slice:
+		if v.IsNil() || !e.markSeen(v.Pointer(), v.Type()) {
+			return 0
+		}
+		size := int64(v.Cap()) * int64(v.Type().Elem().Size())
+		for i := 0; i < v.Len(); i++ {
+			size += e.heapSize(v.Index(i))
+		}
+		return size
+
+	case reflect.Array:
+		var size int64
+		for i := 0; i < v.Len(); i++ {
+			size += e.heapSize(v.Index(i))
+		}
+		return size
+
+	case reflect.Map:
+		if v.IsNil() || !e.markSeen(v.Pointer(), v.Type()) {
+			return 0
+		}
+		entrySize := int64(v.Type().Key().Size()+v.Type().Elem().Size()) + mapEntryOverhead
+		size := int64(v.Len()) * entrySize
+		iter := v.MapRange()
+		for iter.Next() {
+			size += e.heapSize(iter.Key()) + e.heapSize(iter.Value())
+		}
+		return size
+
+	case reflect.Struct:
+		var size int64
+		for i := 0; i < v.NumField(); i++ {
+			size += e.heapSize(v.Field(i))
+		}
+		return size
+	}
+	return 0
+}
@@ -0,0 +1,33 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MethodUpdateListener 方法回调监听器
+// 当配置数据发生变化时，调用注册对象上约定的热更新方法或 RegisterHotUpdateFunc 注册的回调
+// 回调中的 panic 会被捕获并记录日志，不影响其他监听器
+type MethodUpdateListener struct {
+	name      string       // 方法名或回调描述，用于日志
+	fieldType reflect.Type // 监听的配置类型
+	callback  func()       // 热更新回调
+}
+
+// OnConfigDataChange 配置数据变更时调用
+// 参数:
+//
+//	typ: 发生变化的配置数据类型
+//	dataList: 新的配置数据列表
+func (l *MethodUpdateListener) OnConfigDataChange(typ reflect.Type, dataList []interface{}) {
+	if typ != l.fieldType {
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			getLogger().Error(fmt.Errorf("%v", r), "热更新回调发生 panic", "callback", l.name, "configType", typ.String())
+		}
+	}()
+	l.callback()
+}
@@ -0,0 +1,136 @@
+package config233
+
+import (
+	"sort"
+	"time"
+)
+
+// ReloadEventBufferSize 每个订阅者 channel 的缓冲大小，缓冲满时丢弃最旧的事件
+const ReloadEventBufferSize = 16
+
+// ReloadTrigger 触发批量重载的原因
+type ReloadTrigger string
+
+const (
+	// ReloadTriggerFileWatch 文件监听检测到配置文件变更
+	ReloadTriggerFileWatch ReloadTrigger = "file_watch"
+	// ReloadTriggerRetry 上一批热重载失败（如文件仍在写入）后的自动重试
+	ReloadTriggerRetry ReloadTrigger = "retry"
+	// ReloadTriggerManual 通过 ReloadConfig、ReloadConfigs、TriggerReloadAndWait 或 LoadConfigGroup 手动触发
+	ReloadTriggerManual ReloadTrigger = "manual"
+)
+
+// ReloadEvent 一次批量重载完成的事件
+// FileEvents 与 BatchConfigs 反映热重载的去抖效果：批量延迟与冷却时间内的多次文件变更被合并为一次重载，
+// 两者相差越大说明合并越多，FileEvents 长期偏高则说明配置改动过于频繁
+type ReloadEvent struct {
+	ConfigNames    []string      // 本次变更的配置名（含被删除的配置），按字典序排列
+	RemovedConfigs []string      // 其中因文件删除而移除的配置名
+	Time           time.Time     // 重载完成的时间
+	Trigger        ReloadTrigger // 触发本次重载的原因
+	FileEvents     int           // 合并到本次重载的原始文件变更事件数，非文件监听触发时为 0
+	BatchConfigs   int           // 本批次合并重载的配置数（含重载失败的配置）
+}
+
+// reloadBatchInfo 一批重载的来源信息，随重载完成事件发布
+type reloadBatchInfo struct {
+	trigger    ReloadTrigger // 触发原因
+	fileEvents int           // 合并的原始文件变更事件数
+}
+
+// manualReload 手动触发的重载
+var manualReload = reloadBatchInfo{trigger: ReloadTriggerManual}
+
+// SubscribeReload 订阅批量重载完成事件
+// 热重载、ReloadConfigs 与 TriggerReloadAndWait 每完成一批有变更的重载都会向所有订阅者发送一个事件，
+// 与 IBusinessConfigManager 回调相比不需要实现接口，适合用 select 消费的业务
+// 每个订阅者的 channel 有 ReloadEventBufferSize 的缓冲，消费过慢导致缓冲满时丢弃最旧的事件，不会阻塞重载
+// 返回值:
+//
+//	<-chan ReloadEvent: 事件 channel，不再需要时调用 Unsubscribe 取消订阅
+func (cm *ConfigManager233) SubscribeReload() <-chan ReloadEvent {
+	ch := make(chan ReloadEvent, ReloadEventBufferSize)
+
+	cm.reloadSubMu.Lock()
+	defer cm.reloadSubMu.Unlock()
+	cm.reloadSubs = append(cm.reloadSubs, ch)
+	return ch
+}
+
+// Unsubscribe 取消 SubscribeReload 的订阅并关闭对应的 channel
+// 参数:
+//
+//	ch: SubscribeReload 返回的 channel
+//
+// 返回值:
+//
+//	bool: 是否找到并取消了该订阅
+func (cm *ConfigManager233) Unsubscribe(ch <-chan ReloadEvent) bool {
+	cm.reloadSubMu.Lock()
+	defer cm.reloadSubMu.Unlock()
+
+	for i, sub := range cm.reloadSubs {
+		if sub == ch {
+			cm.reloadSubs = append(cm.reloadSubs[:i], cm.reloadSubs[i+1:]...)
+			close(sub)
+			return true
+		}
+	}
+	return false
+}
+
+// publishReloadEvent 向所有订阅者发送重载完成事件，缓冲满时丢弃最旧的事件
+// 参数:
+//
+//	changedConfigs: 变更的配置名（含被删除的配置）
+//	removedConfigs: 被删除的配置名
+//	batchConfigs: 本批次合并重载的配置数
+//	info: 本批次的触发原因与合并的文件变更事件数
+func (cm *ConfigManager233) publishReloadEvent(changedConfigs, removedConfigs []string, batchConfigs int, info reloadBatchInfo) {
+	cm.reloadSubMu.Lock()
+	defer cm.reloadSubMu.Unlock()
+	if len(cm.reloadSubs) == 0 {
+		return
+	}
+
+	configNames := append([]string(nil), changedConfigs...)
+	sort.Strings(configNames)
+	removed := append([]string(nil), removedConfigs...)
+	sort.Strings(removed)
+	now := time.Now()
+
+	for _, sub := range cm.reloadSubs {
+		// 每个订阅者收到独立的切片副本
+		event := ReloadEvent{
+			ConfigNames:    append([]string(nil), configNames...),
+			RemovedConfigs: append([]string(nil), removed...),
+			Time:           now,
+			Trigger:        info.trigger,
+			FileEvents:     info.fileEvents,
+			BatchConfigs:   batchConfigs,
+		}
+		for {
+			select {
+			case sub <- event:
+			default:
+				// 缓冲已满，丢弃最旧的事件后重试
+				select {
+				case <-sub:
+				default:
+				}
+				continue
+			}
+			break
+		}
+	}
+}
+
+// closeReloadSubscriptions 关闭并移除所有订阅
+func (cm *ConfigManager233) closeReloadSubscriptions() {
+	cm.reloadSubMu.Lock()
+	defer cm.reloadSubMu.Unlock()
+	for _, sub := range cm.reloadSubs {
+		close(sub)
+	}
+	cm.reloadSubs = nil
+}
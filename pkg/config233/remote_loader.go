@@ -0,0 +1,419 @@
+package config233
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	jsonhandler "github.com/neko233-com/config233-go/pkg/config233/json"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+	xmlhandler "github.com/neko233-com/config233-go/pkg/config233/xml"
+	yamlhandler "github.com/neko233-com/config233-go/pkg/config233/yaml"
+)
+
+const (
+	// defaultRemoteLoadTimeout 拉取远程配置的默认超时（单次请求）
+	defaultRemoteLoadTimeout = 10 * time.Second
+	// defaultRemoteLoadRetries 拉取远程配置失败时的默认重试次数
+	defaultRemoteLoadRetries = 2
+	// defaultRemoteRetryInterval 两次重试之间的默认间隔
+	defaultRemoteRetryInterval = 500 * time.Millisecond
+)
+
+// remoteContentTypeExt Content-Type 到配置文件扩展名的映射
+var remoteContentTypeExt = map[string]string{
+	"application/json":          ".json",
+	"text/json":                 ".json",
+	"application/jsonl":         ".jsonl",
+	"application/x-ndjson":      ".jsonl",
+	"text/tab-separated-values": ".tsv",
+	"text/csv":                  ".csv",
+	"application/yaml":          ".yaml",
+	"application/x-yaml":        ".yaml",
+	"text/yaml":                 ".yaml",
+	"text/x-yaml":               ".yaml",
+	"application/xml":           ".xml",
+	"text/xml":                  ".xml",
+	"application/vnd.ms-excel":  ".xls",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": ".xlsx",
+}
+
+// remotePoll 一个远程配置的轮询任务
+type remotePoll struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// stop 停止轮询并取消进行中的请求
+func (p *remotePoll) stop() {
+	close(p.done)
+	p.cancel()
+}
+
+// SetRemoteLoadTimeout 设置拉取远程配置的单次请求超时（链式调用）
+// 参数:
+//
+//	d: 超时时间，<= 0 时恢复默认值 10s
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetRemoteLoadTimeout(d time.Duration) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.remoteTimeout = d
+	return cm
+}
+
+// SetRemoteLoadRetry 设置拉取远程配置失败时的重试策略（链式调用）
+// 请求失败、超时或返回非 2xx 状态码时重试
+// 参数:
+//
+//	retries: 重试次数（不含第一次请求），< 0 时恢复默认值 2，0 表示不重试
+//	interval: 两次重试之间的间隔，<= 0 时恢复默认值 500ms
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetRemoteLoadRetry(retries int, interval time.Duration) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	if retries < 0 {
+		cm.remoteAttempts = 0
+	} else {
+		cm.remoteAttempts = retries + 1
+	}
+	cm.remoteRetryDelay = interval
+	return cm
+}
+
+// remoteLoadSettings 获取远程加载的超时、重试次数和重试间隔
+func (cm *ConfigManager233) remoteLoadSettings() (time.Duration, int, time.Duration) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	timeout, retries, interval := cm.remoteTimeout, defaultRemoteLoadRetries, cm.remoteRetryDelay
+	if timeout <= 0 {
+		timeout = defaultRemoteLoadTimeout
+	}
+	if cm.remoteAttempts > 0 {
+		retries = cm.remoteAttempts - 1
+	}
+	if interval <= 0 {
+		interval = defaultRemoteRetryInterval
+	}
+	return timeout, retries, interval
+}
+
+// LoadConfigFromURL 从 HTTP 地址拉取配置并加载到全局管理器
+// 按响应的 Content-Type 选择解析器，Content-Type 无法识别时按 name 或 URL 路径的扩展名选择，都没有时按 JSON 解析
+// 加载成功后与本地文件一样存入内存和 ID 索引，并通知业务管理器 OnConfigLoadComplete
+// 参数:
+//
+//	name: 配置名，可以带扩展名（如 "ItemConfig.json"），T 会按去掉扩展名后的配置名注册
+//	url: 配置地址，超时与重试由 SetRemoteLoadTimeout / SetRemoteLoadRetry 控制
+//
+// 返回值:
+//
+//	error: 拉取或解析失败时返回错误，此时内存中已有的配置保持不变
+func LoadConfigFromURL[T any](name, url string) error {
+	return LoadConfigFromURLFrom[T](GetInstance(), name, url)
+}
+
+// LoadConfigFromURLFrom 与 LoadConfigFromURL 相同，但加载到指定的管理器 cm
+func LoadConfigFromURLFrom[T any](cm *ConfigManager233, name, url string) error {
+	var example T
+	cm.RegisterTypeWithName(remoteConfigName(name), reflect.TypeOf(example))
+	_, err := cm.loadRemoteConfig(context.Background(), name, url, nil)
+	return err
+}
+
+// StartPollingURL 按固定间隔轮询远程配置，内容变化时重新加载并通知业务管理器 OnConfigLoadComplete
+// 配置结构体需要事先通过 LoadConfigFromURL 或 RegisterTypeWithName 注册，否则以 map 形式保存
+// 同一配置名重复调用时会先停止之前的轮询；拉取失败时打错误日志，保留上一次成功加载的配置
+// 参数:
+//
+//	name: 配置名，规则与 LoadConfigFromURL 相同
+//	url: 配置地址
+//	interval: 轮询间隔，必须大于 0
+//
+// 返回值:
+//
+//	error: interval 不合法时返回错误
+func (cm *ConfigManager233) StartPollingURL(name, url string, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("轮询间隔必须大于 0: %v", interval)
+	}
+	configName := remoteConfigName(name)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	poll := &remotePoll{done: make(chan struct{}), cancel: cancel}
+	cm.remotePollMu.Lock()
+	if cm.remotePolls == nil {
+		cm.remotePolls = make(map[string]*remotePoll)
+	}
+	if previous, ok := cm.remotePolls[configName]; ok {
+		previous.stop()
+	}
+	cm.remotePolls[configName] = poll
+	cm.remotePollMu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var lastBody []byte
+		for {
+			body, err := cm.loadRemoteConfig(ctx, name, url, lastBody)
+			if err == nil {
+				lastBody = body
+			} else if ctx.Err() == nil {
+				getLogger().Error(err, "轮询远程配置失败，保留上一次的配置", "configName", configName, "url", url)
+			}
+
+			select {
+			case <-poll.done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	getLogger().Info("开始轮询远程配置", "configName", configName, "url", url, "interval", interval.String())
+	return nil
+}
+
+// StopPollingURL 停止指定配置的轮询，已加载的配置保持不变
+// 参数:
+//
+//	name: 配置名，可以带扩展名
+//
+// 返回值:
+//
+//	bool: 该配置是否正在轮询
+func (cm *ConfigManager233) StopPollingURL(name string) bool {
+	configName := remoteConfigName(name)
+
+	cm.remotePollMu.Lock()
+	defer cm.remotePollMu.Unlock()
+	poll, ok := cm.remotePolls[configName]
+	if !ok {
+		return false
+	}
+	poll.stop()
+	delete(cm.remotePolls, configName)
+	return true
+}
+
+// stopRemotePolls 停止所有远程配置轮询（用于重置管理器）
+func (cm *ConfigManager233) stopRemotePolls() {
+	cm.remotePollMu.Lock()
+	defer cm.remotePollMu.Unlock()
+	for _, poll := range cm.remotePolls {
+		poll.stop()
+	}
+	cm.remotePolls = nil
+}
+
+// loadRemoteConfig 拉取远程配置并写入内存，返回响应内容
+// unchanged 不为 nil 且与本次内容相同时跳过解析与通知（用于轮询）
+func (cm *ConfigManager233) loadRemoteConfig(ctx context.Context, name, rawURL string, unchanged []byte) ([]byte, error) {
+	configName := remoteConfigName(name)
+	startTime := time.Now()
+
+	body, contentType, err := cm.fetchRemoteConfig(ctx, rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("拉取远程配置 %s (%s) 失败: %w", configName, rawURL, err)
+	}
+	if unchanged != nil && bytes.Equal(body, unchanged) {
+		return body, nil
+	}
+
+	ext := remoteConfigExt(contentType, name, rawURL)
+	configDto, format, err := parseRemoteConfig(configName, ext, body, cm.columnNameNormalizer())
+	if err != nil {
+		return nil, fmt.Errorf("解析远程配置 %s (%s) 失败: %w", configName, rawURL, err)
+	}
+	logConversionErrors(configDto.ConversionErrors)
+
+	items := cm.newConfigItemSet(configName, len(configDto.DataList))
+	for i, item := range configDto.DataList {
+		converted := any(item)
+		if c, err := cm.convertMapToRegisteredStruct(configName, item); err == nil {
+			converted = c
+		} else {
+			getLogger().Error(err, "转换远程配置项失败", "index", i, "configName", configName, "data", item)
+		}
+
+		items.add(extractItemId(converted, item, configDto.ColumnNames), converted)
+	}
+	configMap, slice, err := items.result()
+	if err != nil {
+		return nil, err
+	}
+	parseDuration := time.Since(startTime)
+
+	// 与热重载批次串行，避免轮询结果与批量重载交错写入和通知
+	cm.reloadMu.Lock()
+	defer cm.reloadMu.Unlock()
+	// 轮询已停止（如管理器被重置）时不再写入
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := cm.commitConfig(configName, configDto.DataList, configMap, slice); err != nil {
+		return nil, err
+	}
+	cm.recordLoadStat(configName, rawURL, format, len(slice), parseDuration)
+	getLogger().Info("远程配置加载完成", "configName", configName, "url", rawURL, "count", len(slice))
+
+	cm.Snapshot()
+	cm.publishHotUpdate([]string{configName})
+
+	// 通知业务管理器（每个管理器收到独立副本）
+	cm.mutex.RLock()
+	managers := append([]IBusinessConfigManager(nil), cm.businessManagers...)
+	cm.mutex.RUnlock()
+	for _, manager := range managers {
+		callBusinessManager(manager, "OnConfigLoadComplete", func() { manager.OnConfigLoadComplete([]string{configName}) })
+	}
+	cm.lastLoadTimeMs.Store(time.Now().UnixMilli())
+
+	return body, nil
+}
+
+// fetchRemoteConfig 按超时与重试设置拉取远程内容，返回响应体与 Content-Type
+func (cm *ConfigManager233) fetchRemoteConfig(ctx context.Context, rawURL string) ([]byte, string, error) {
+	timeout, retries, interval := cm.remoteLoadSettings()
+	client := &http.Client{Timeout: timeout}
+
+	var lastErr error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, "", ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+
+		body, contentType, err := fetchRemoteOnce(ctx, client, rawURL)
+		if err == nil {
+			return body, contentType, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			return nil, "", ctx.Err()
+		}
+		getLogger().Info("拉取远程配置失败，准备重试", "url", rawURL, "attempt", attempt+1, "maxAttempts", retries+1, "error", err.Error())
+	}
+	return nil, "", lastErr
+}
+
+// fetchRemoteOnce 发起一次 GET 请求，非 2xx 状态码视为失败
+func fetchRemoteOnce(ctx context.Context, client *http.Client, rawURL string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, "", fmt.Errorf("HTTP 状态码 %d", resp.StatusCode)
+	}
+	return body, resp.Header.Get("Content-Type"), nil
+}
+
+// remoteConfigName 去掉配置名中的扩展名
+func remoteConfigName(name string) string {
+	if _, ok := configFileExt(name); ok {
+		return strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return name
+}
+
+// remoteConfigExt 确定远程配置的格式：优先 Content-Type，其次 name 与 URL 路径的扩展名，默认 JSON
+func remoteConfigExt(contentType, name, rawURL string) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if ext, ok := remoteContentTypeExt[strings.ToLower(mediaType)]; ok {
+			return ext
+		}
+	}
+	if ext, ok := configFileExt(name); ok {
+		return ext
+	}
+	if u, err := url.Parse(rawURL); err == nil {
+		if ext, ok := configFileExt(path.Base(u.Path)); ok {
+			return ext
+		}
+	}
+	return ".json"
+}
+
+// parseRemoteConfig 按扩展名选择处理器解析远程内容，返回解析结果与格式名
+// 处理器 panic 时转为错误返回，内容为空时返回错误，避免轮询时用空数据覆盖已有配置
+func parseRemoteConfig(configName, ext string, data []byte, normalizer func(raw string) string) (configDto *dto.FrontEndConfigDto, format string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	var result interface{}
+	switch ext {
+	case ".xlsx", ".xls":
+		handler := &excel.ExcelConfigHandler{ColumnNameNormalizer: normalizer}
+		format = handler.TypeName()
+		result, err = handler.ReadToFrontEndDataListFromBytes(configName, data)
+	case ".tsv", ".csv":
+		handler := &tsv.TsvConfigHandler{ColumnNameNormalizer: normalizer}
+		format = handler.TypeName()
+		if ext == ".csv" {
+			handler.Delimiter = ','
+			format = "csv"
+		}
+		result, err = handler.ReadToFrontEndDataListFromBytes(configName, data)
+	case ".yaml", ".yml":
+		handler := &yamlhandler.YamlConfigHandler{}
+		format = handler.TypeName()
+		result, err = handler.ReadToFrontEndDataListFromBytes(configName, data)
+	case ".xml":
+		handler := &xmlhandler.XmlConfigHandler{}
+		format = handler.TypeName()
+		result, err = handler.ReadToFrontEndDataListFromBytes(configName, data)
+	default:
+		handler := &jsonhandler.JsonConfigHandler{}
+		format = handler.TypeName()
+		if ext == ".jsonl" {
+			handler.Lines = true
+			format = "jsonl"
+		}
+		result, err = handler.ReadToFrontEndDataListFromBytes(configName, data)
+	}
+	if err != nil {
+		return nil, format, err
+	}
+	configDto = result.(*dto.FrontEndConfigDto)
+	if configDto.DataList == nil {
+		return nil, format, fmt.Errorf("远程配置内容为空")
+	}
+	return configDto, format, nil
+}
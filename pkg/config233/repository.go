@@ -2,6 +2,7 @@ package config233
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 )
 
@@ -35,12 +36,11 @@ func NewConfigDataRepository() *ConfigDataRepository {
 //	dataList: 配置数据列表
 func (r *ConfigDataRepository) Put(typ reflect.Type, dataList []interface{}) {
 	r.mu.Lock()
-	defer r.mu.Unlock()
-
 	r.typeToDataList[typ] = dataList
+	listeners := append([]ConfigDataChangeListener(nil), r.typeToChangeListeners[typ]...)
+	r.mu.Unlock()
 
-	// 触发变更监听
-	listeners := r.typeToChangeListeners[typ]
+	// 触发变更监听（在锁外调用，监听器中可以再次读取仓库）
 	for _, listener := range listeners {
 		listener.OnConfigDataChange(typ, dataList)
 	}
@@ -90,23 +90,34 @@ func (r *ConfigDataRepository) GetUIDMap(typ reflect.Type) map[interface{}]inter
 	if len(dataList) == 0 {
 		return nil
 	}
+	return buildUIDMap(dataList)
+}
 
+// buildUIDMap 根据带有 "config233":"uid" 标签的字段，创建 UID 到对象实例的映射
+// 没有 uid 字段时使用名为 Id（不区分大小写）的字段，与 ConfigManager233 提取 ID 的约定一致；两者都没有的对象被跳过
+func buildUIDMap(dataList []interface{}) map[interface{}]interface{} {
 	uidMap := make(map[interface{}]interface{})
 	for _, item := range dataList {
 		val := reflect.ValueOf(item)
 		if val.Kind() == reflect.Ptr {
 			val = val.Elem()
 		}
+		if val.Kind() != reflect.Struct {
+			continue
+		}
 
-		// 查找带有 uid tag 的字段
-		for i := 0; i < val.NumField(); i++ {
-			field := val.Type().Field(i)
-			if field.Tag.Get("config233") == "uid" {
-				fieldVal := val.Field(i)
-				uidMap[fieldVal.Interface()] = item
-				break
+		index := uidFieldIndex(val.Type())
+		if index == nil {
+			if field, ok := val.Type().FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, "id") }); ok {
+				index = field.Index
 			}
 		}
+		if index == nil {
+			continue
+		}
+		if fieldVal, err := val.FieldByIndexErr(index); err == nil {
+			uidMap[fieldVal.Interface()] = item
+		}
 	}
 
 	return uidMap
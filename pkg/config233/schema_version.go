@@ -0,0 +1,94 @@
+package config233
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrSchemaVersionUnsupported 配置文件声明的 schema 版本号不在程序支持的范围内
+var ErrSchemaVersionUnsupported = errors.New("配置 schema 版本不受支持")
+
+// SchemaVersionPolicy 配置文件的 schema 版本号超出支持范围时的处理策略
+type SchemaVersionPolicy int
+
+const (
+	// SchemaVersionReject 记录错误日志并拒绝加载（默认），该配置保留旧数据（严格模式下整次加载失败）
+	SchemaVersionReject SchemaVersionPolicy = iota
+	// SchemaVersionWarn 只记录错误日志，仍然加载
+	SchemaVersionWarn
+)
+
+// SetSupportedSchemaVersion 设置程序支持的配置 schema 版本范围（链式调用）
+// 配置文件可以声明版本号：JSON 在顶层对象中写 "__version" 字段（如 {"__version": 2, "data": [...]}），
+// Excel 在多行表头中加一行，第 1 列写 version 标记、第 2 列写版本号。
+// 加载时版本号不在 [min, max] 内的配置按 SetSchemaVersionPolicy 的策略处理，防止旧程序读取新格式的表；
+// 没有声明版本号的配置不做检查。min 与 max 都为 0 时关闭检查（默认），max 为 0 表示不限制上限
+// 参数:
+//
+//	min: 支持的最低版本（含）
+//	max: 支持的最高版本（含）
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetSupportedSchemaVersion(min, max int) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.schemaMin = min
+	cm.schemaMax = max
+	return cm
+}
+
+// SetSchemaVersionPolicy 设置 schema 版本号超出支持范围时的处理策略（链式调用）
+// 参数:
+//
+//	policy: SchemaVersionReject（默认）或 SchemaVersionWarn
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetSchemaVersionPolicy(policy SchemaVersionPolicy) *ConfigManager233 {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.schemaPolicy = policy
+	return cm
+}
+
+// checkSchemaVersion 检查配置文件声明的 schema 版本号是否在支持范围内
+// 参数:
+//
+//	configName: 配置名称
+//	filePath: 配置文件路径
+//	version: 声明的版本号，0 表示未声明
+//
+// 返回值:
+//
+//	error: 超出范围且策略为 SchemaVersionReject 时返回包装了 ErrSchemaVersionUnsupported 的错误
+func (cm *ConfigManager233) checkSchemaVersion(configName, filePath string, version int) error {
+	cm.mutex.RLock()
+	min, max, policy := cm.schemaMin, cm.schemaMax, cm.schemaPolicy
+	cm.mutex.RUnlock()
+
+	if version == 0 || (min == 0 && max == 0) {
+		return nil
+	}
+	if version >= min && (max == 0 || version <= max) {
+		return nil
+	}
+
+	err := fmt.Errorf("%w: 配置 %s (%s) 的版本为 %d，支持的范围为 %s",
+		ErrSchemaVersionUnsupported, configName, filePath, version, schemaVersionRange(min, max))
+	if policy == SchemaVersionWarn {
+		getLogger().Error(err, "配置 schema 版本不在支持范围内（仍加载）", "configName", configName, "path", filePath)
+		return nil
+	}
+	return err
+}
+
+// schemaVersionRange 返回形如 "[1, 3]" 或 "[2, +∞)" 的版本范围描述
+func schemaVersionRange(min, max int) string {
+	if max == 0 {
+		return fmt.Sprintf("[%d, +∞)", min)
+	}
+	return fmt.Sprintf("[%d, %d]", min, max)
+}
@@ -0,0 +1,228 @@
+package config233
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultSnapshotLimit 默认保留的历史快照份数
+const DefaultSnapshotLimit = 5
+
+// SnapshotID 配置快照的编号，同一个管理器内单调递增
+type SnapshotID int64
+
+// SnapshotInfo 配置快照的概要信息
+type SnapshotInfo struct {
+	Id          SnapshotID // 快照编号
+	CreatedAt   time.Time  // 创建时间
+	ConfigNames []string   // 快照中包含的配置名（已排序）
+}
+
+// configSnapshot 某一时刻的内存配置
+// 各配置的数据在写入时都会整体替换（Copy-On-Write），快照只需保存外层映射的副本，不会复制配置数据本身
+type configSnapshot struct {
+	info       SnapshotInfo
+	configs    map[string]interface{}
+	configMaps map[string]map[string]interface{}
+	idMaps     *map[string]map[string]interface{}
+	slices     *map[string][]interface{}
+}
+
+// SetSnapshotLimit 设置保留的历史快照份数（链式调用）
+// 每次成功加载配置后会自动保存一份快照，超出份数时丢弃最旧的快照
+// 参数:
+//
+//	limit: 保留份数，<= 0 表示使用默认值 DefaultSnapshotLimit
+//
+// 返回值:
+//
+//	*ConfigManager233: 返回自身，支持链式调用
+func (cm *ConfigManager233) SetSnapshotLimit(limit int) *ConfigManager233 {
+	cm.snapshotMu.Lock()
+	defer cm.snapshotMu.Unlock()
+	cm.snapshotLimit = limit
+	cm.trimSnapshotsLocked()
+	return cm
+}
+
+// Snapshot 保存当前内存配置的快照
+// 返回值:
+//
+//	SnapshotID: 快照编号，可传给 Rollback 回滚到该快照
+func (cm *ConfigManager233) Snapshot() SnapshotID {
+	cm.mutex.RLock()
+	configs := make(map[string]interface{}, len(cm.configs))
+	for k, v := range cm.configs {
+		configs[k] = v
+	}
+	configMaps := make(map[string]map[string]interface{}, len(cm.configMaps))
+	for k, v := range cm.configMaps {
+		configMaps[k] = v
+	}
+	idMaps := cm.globalIdMaps.Load().(*map[string]map[string]interface{})
+	slices := cm.globalSlices.Load().(*map[string][]interface{})
+	cm.mutex.RUnlock()
+
+	configNames := make([]string, 0, len(configs))
+	for configName := range configs {
+		configNames = append(configNames, configName)
+	}
+	sort.Strings(configNames)
+
+	cm.snapshotMu.Lock()
+	defer cm.snapshotMu.Unlock()
+	cm.snapshotSeq++
+	cm.snapshots = append(cm.snapshots, &configSnapshot{
+		info: SnapshotInfo{
+			Id:          cm.snapshotSeq,
+			CreatedAt:   time.Now(),
+			ConfigNames: configNames,
+		},
+		configs:    configs,
+		configMaps: configMaps,
+		idMaps:     idMaps,
+		slices:     slices,
+	})
+	cm.trimSnapshotsLocked()
+	return cm.snapshotSeq
+}
+
+// ListSnapshots 获取当前保留的快照列表
+// 返回值:
+//
+//	[]SnapshotInfo: 按创建顺序排列（最旧的在前）
+func (cm *ConfigManager233) ListSnapshots() []SnapshotInfo {
+	cm.snapshotMu.Lock()
+	defer cm.snapshotMu.Unlock()
+
+	infos := make([]SnapshotInfo, 0, len(cm.snapshots))
+	for _, snapshot := range cm.snapshots {
+		info := snapshot.info
+		info.ConfigNames = append([]string(nil), info.ConfigNames...)
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+// Rollback 将内存配置回滚到指定快照，完成后触发 OnConfigLoadComplete
+// 快照之后新增的配置会被移除，回滚本身不会产生新的快照
+// 参数:
+//
+//	id: Snapshot 返回或 ListSnapshots 列出的快照编号
+//
+// 返回值:
+//
+//	error: 快照不存在（或已因超出份数被丢弃）时返回错误
+func (cm *ConfigManager233) Rollback(id SnapshotID) error {
+	cm.snapshotMu.Lock()
+	var target *configSnapshot
+	for _, snapshot := range cm.snapshots {
+		if snapshot.info.Id == id {
+			target = snapshot
+			break
+		}
+	}
+	cm.snapshotMu.Unlock()
+	if target == nil {
+		return fmt.Errorf("快照 %d 不存在或已被丢弃", id)
+	}
+
+	// 快照之后发生变化的配置都需要通知：快照中的配置与快照之后新增的配置
+	configs := make(map[string]interface{}, len(target.configs))
+	for k, v := range target.configs {
+		configs[k] = v
+	}
+	configMaps := make(map[string]map[string]interface{}, len(target.configMaps))
+	for k, v := range target.configMaps {
+		configMaps[k] = v
+	}
+
+	cm.mutex.Lock()
+	changed := append([]string(nil), target.info.ConfigNames...)
+	for configName := range cm.configs {
+		if _, ok := configs[configName]; !ok {
+			changed = append(changed, configName)
+		}
+	}
+	cm.configs = configs
+	cm.configMaps = configMaps
+	cm.globalIdMaps.Store(target.idMaps)
+	cm.globalSlices.Store(target.slices)
+	managers := append([]IBusinessConfigManager(nil), cm.businessManagers...)
+	cm.mutex.Unlock()
+
+	cm.clearConfigIndex()
+	cm.refreshAllHotUpdateHolders()
+	cm.resolveConfigRefs()
+	getLogger().Info("配置已回滚到快照", "snapshotId", id, "configCount", len(configs))
+
+	cm.publishHotUpdate(changed)
+	if len(changed) > 0 {
+		for _, manager := range managers {
+			configsCopy := make([]string, len(changed))
+			copy(configsCopy, changed)
+			callBusinessManager(manager, "OnConfigLoadComplete", func() { manager.OnConfigLoadComplete(configsCopy) })
+		}
+	}
+	cm.lastLoadTimeMs.Store(time.Now().UnixMilli())
+	return nil
+}
+
+// trimSnapshotsLocked 丢弃超出份数的最旧快照，调用方需持有 snapshotMu
+func (cm *ConfigManager233) trimSnapshotsLocked() {
+	limit := cm.snapshotLimit
+	if limit <= 0 {
+		limit = DefaultSnapshotLimit
+	}
+	if overflow := len(cm.snapshots) - limit; overflow > 0 {
+		// 复制到新切片，被丢弃的快照不再被底层数组引用，可以被回收
+		cm.snapshots = append([]*configSnapshot(nil), cm.snapshots[overflow:]...)
+	}
+}
+
+// clearSnapshots 清空所有快照
+func (cm *ConfigManager233) clearSnapshots() {
+	cm.snapshotMu.Lock()
+	defer cm.snapshotMu.Unlock()
+	cm.snapshots = nil
+}
+
+// GetSnapshotConfigList 获取快照中某类型的配置列表（纯泛型），可与 DiffConfig 配合输出与当前配置的差异
+// 参数:
+//
+//	id: Snapshot 返回或 ListSnapshots 列出的快照编号
+//
+// 返回值:
+//
+//	[]*T: 快照中的配置列表，快照中没有该类型配置时返回 nil
+//	bool: 快照是否存在（未因超出份数被丢弃）
+func GetSnapshotConfigList[T any](id SnapshotID) ([]*T, bool) {
+	return GetSnapshotConfigListFrom[T](GetInstance(), id)
+}
+
+// GetSnapshotConfigListFrom 与 GetSnapshotConfigList 相同，但从指定的管理器 cm 读取
+func GetSnapshotConfigListFrom[T any](cm *ConfigManager233, id SnapshotID) ([]*T, bool) {
+	cm.snapshotMu.Lock()
+	var target *configSnapshot
+	for _, snapshot := range cm.snapshots {
+		if snapshot.info.Id == id {
+			target = snapshot
+			break
+		}
+	}
+	cm.snapshotMu.Unlock()
+	if target == nil {
+		return nil, false
+	}
+
+	slice, exists := (*target.slices)[configNameOf[T](cm)]
+	if !exists {
+		return nil, true
+	}
+	list, err := convertSliceToStructSlice[T](slice)
+	if err != nil {
+		return nil, true
+	}
+	return list, true
+}
@@ -2,6 +2,7 @@ package config233
 
 import (
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,15 +11,52 @@ import (
 	"github.com/xuri/excelize/v2"
 )
 
+// FileNameStyle 生成文件名的风格
+type FileNameStyle string
+
+const (
+	FileNameStyleOriginal FileNameStyle = "original" // 与配置名一致，如 ItemConfig.go（默认）
+	FileNameStyleSnake    FileNameStyle = "snake"    // 蛇形，如 item_config.go
+	FileNameStyleCamel    FileNameStyle = "camel"    // 小驼峰，如 itemConfig.go
+)
+
+// KvConfigMode 是否为生成的 struct 实现 IKvConfig 接口
+type KvConfigMode int
+
+const (
+	KvConfigAuto   KvConfigMode = iota // 配置名包含 Kv（不区分大小写）时实现（默认）
+	KvConfigAlways                     // 总是实现
+	KvConfigNever                      // 从不实现
+)
+
+// GenOptions struct 生成选项，零值的行为与 GenerateStructFromExcel 一致
+type GenOptions struct {
+	OutputDir     string        // 输出目录
+	PackageName   string        // 生成代码的包名，为空时使用 generated
+	FileNameStyle FileNameStyle // 输出文件名风格，为空时与配置名一致
+	UidTag        bool          // 是否为主键字段（id，没有时为第一个字段）生成 config233:"uid" 标签
+	KvConfig      KvConfigMode  // 是否实现 IKvConfig，value 字段优先取 value/val，否则取第二个字段
+}
+
+// defaultGenPackageName 未指定包名时使用的包名
+const defaultGenPackageName = "generated"
+
 // StructGenerator Excel 配置 Struct 代码生成器
 type StructGenerator struct {
 	outputDir string
+	opts      GenOptions
 }
 
 // NewStructGenerator 创建新的 Struct 生成器
 func NewStructGenerator(outputDir string) *StructGenerator {
+	return NewStructGeneratorWithOptions(GenOptions{OutputDir: outputDir})
+}
+
+// NewStructGeneratorWithOptions 按生成选项创建 Struct 生成器
+func NewStructGeneratorWithOptions(opts GenOptions) *StructGenerator {
 	return &StructGenerator{
-		outputDir: outputDir,
+		outputDir: opts.OutputDir,
+		opts:      opts,
 	}
 }
 
@@ -44,8 +82,10 @@ func (g *StructGenerator) GenerateFromExcel(excelPath string) error {
 
 	// 固定行结构
 	const (
-		typeRowIndex   = 3 // 类型行（第 4 行）
-		serverRowIndex = 4 // Server 字段名行（第 5 行）
+		remarkRowIndex  = 0 // 注释行（第 1 行）
+		commentRowIndex = 1 // 中文行（第 2 行）
+		typeRowIndex    = 3 // 类型行（第 4 行）
+		serverRowIndex  = 4 // Server 字段名行（第 5 行）
 	)
 
 	if len(rows) <= serverRowIndex {
@@ -78,11 +118,20 @@ func (g *StructGenerator) GenerateFromExcel(excelPath string) error {
 			Name:      fieldName,
 			ExcelType: fieldType,
 			GoType:    g.excelTypeToGoType(fieldType),
+			Comment:   fieldComment(fieldName, cellAt(rows[commentRowIndex], i), cellAt(rows[remarkRowIndex], i)),
 		})
 	}
 
 	// 生成代码
-	code := g.generateStructCode(structName, configName, fields)
+	code := g.generateStructCode(structName, configName, configName+".xlsx", fields)
+	return g.writeStructFile(configName, code)
+}
+
+// writeStructFile 格式化生成的代码并写入输出目录，格式化失败时保留原始代码
+func (g *StructGenerator) writeStructFile(configName, code string) error {
+	if formatted, err := format.Source([]byte(code)); err == nil {
+		code = string(formatted)
+	}
 
 	// 确保输出目录存在
 	if err := os.MkdirAll(g.outputDir, 0755); err != nil {
@@ -90,7 +139,7 @@ func (g *StructGenerator) GenerateFromExcel(excelPath string) error {
 	}
 
 	// 写入文件
-	outputPath := filepath.Join(g.outputDir, configName+".go")
+	outputPath := filepath.Join(g.outputDir, g.toFileName(configName)+".go")
 	if err := os.WriteFile(outputPath, []byte(code), 0644); err != nil {
 		return fmt.Errorf("写入文件失败: %w", err)
 	}
@@ -136,6 +185,34 @@ type FieldInfo struct {
 	Name      string // Server 字段名
 	ExcelType string // Excel 中的类型
 	GoType    string // Go 类型
+	Comment   string // 字段说明，来自中文行与注释行，为空时不生成注释
+}
+
+// cellAt 获取行中指定列的单元格，列不存在时返回空字符串
+func cellAt(row []string, i int) string {
+	if i < len(row) {
+		return strings.TrimSpace(row[i])
+	}
+	return ""
+}
+
+// headerRowLabels 表头行的标签文字，出现在字段列时不作为说明
+var headerRowLabels = map[string]bool{"注释": true, "中文": true}
+
+// fieldComment 由中文行与注释行组成字段说明，如 "ID 配置ID"
+// 两者相同时只保留一个；与字段名相同的内容（表头缺少中文行时常见）以及 "注释" 等行标签视为没有说明
+func fieldComment(fieldName, chinese, remark string) string {
+	parts := make([]string, 0, 2)
+	for _, text := range []string{chinese, remark} {
+		if text == "" || strings.EqualFold(text, fieldName) || headerRowLabels[text] {
+			continue
+		}
+		if len(parts) > 0 && parts[0] == text {
+			continue
+		}
+		parts = append(parts, text)
+	}
+	return strings.Join(parts, " ")
 }
 
 // excelTypeToGoType 将 Excel 类型转换为 Go 类型
@@ -184,9 +261,16 @@ func (g *StructGenerator) toFieldName(name string) string {
 	return string(runes)
 }
 
-// isKvConfig 检查是否是 KV 配置（名字包含 Kv，不区分大小写）
+// isKvConfig 检查是否需要实现 IKvConfig，默认在名字包含 Kv（不区分大小写）时实现
 func (g *StructGenerator) isKvConfig(name string) bool {
-	return strings.Contains(strings.ToLower(name), "kv")
+	switch g.opts.KvConfig {
+	case KvConfigAlways:
+		return true
+	case KvConfigNever:
+		return false
+	default:
+		return strings.Contains(strings.ToLower(name), "kv")
+	}
 }
 
 // hasValueField 检查字段列表中是否有 value/Value/val/Val 字段
@@ -200,19 +284,66 @@ func (g *StructGenerator) findValueField(fields []FieldInfo) string {
 	return ""
 }
 
-// generateStructCode 生成 struct 代码
-func (g *StructGenerator) generateStructCode(structName, configName string, fields []FieldInfo) string {
+// findUidField 查找主键字段：优先取名为 id 的字段（不区分大小写），没有时取第一个字段
+func (g *StructGenerator) findUidField(fields []FieldInfo) string {
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, "id") {
+			return f.Name
+		}
+	}
+	if len(fields) > 0 {
+		return fields[0].Name
+	}
+	return ""
+}
+
+// packageName 获取生成代码的包名
+func (g *StructGenerator) packageName() string {
+	if g.opts.PackageName != "" {
+		return g.opts.PackageName
+	}
+	return defaultGenPackageName
+}
+
+// toFileName 按 FileNameStyle 将配置名转为输出文件名（不含扩展名）
+func (g *StructGenerator) toFileName(configName string) string {
+	switch g.opts.FileNameStyle {
+	case FileNameStyleSnake:
+		return toSnakeCase(configName)
+	case FileNameStyleCamel:
+		return lowerLeadingUpper(configName)
+	default:
+		return configName
+	}
+}
+
+// toSnakeCase 将驼峰名转为蛇形，连续大写视为一个单词，例如 GodLvUpConfig -> god_lv_up_config、IDConfig -> id_config
+func toSnakeCase(name string) string {
+	runes := []rune(name)
 	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			acronymEnd := i > 0 && unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if (prevLower || acronymEnd) && !strings.HasSuffix(sb.String(), "_") {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
 
-	// 包声明和导入
-	sb.WriteString("// Code generated by config233. DO NOT EDIT.\n")
-	sb.WriteString("// Source: " + configName + ".xlsx\n\n")
-	sb.WriteString("package generated\n\n")
+// generateStructCode 生成 struct 代码，sourceName 为文件头中标注的来源文件名
+func (g *StructGenerator) generateStructCode(structName, configName, sourceName string, fields []FieldInfo) string {
+	var sb strings.Builder
 
-	// 检查是否是 KV 配置，如果是则需要导入 config233
-	isKv := g.isKvConfig(configName)
+	// 检查是否需要实现 IKvConfig，并确定 value 字段
 	valueField := ""
-	if isKv {
+	valueFieldType := ""
+	if g.isKvConfig(configName) {
 		valueField = g.findValueField(fields)
 		if valueField == "" {
 			// 如果没有 value 字段，默认使用第二个字段（假设第一个是 id）
@@ -220,94 +351,70 @@ func (g *StructGenerator) generateStructCode(structName, configName string, fiel
 				valueField = fields[1].Name
 			}
 		}
-		if valueField != "" {
-			sb.WriteString("import \"github.com/neko233-com/config233-go/pkg/config233\"\n\n")
-		}
-	}
-
-	// 生成 struct
-	sb.WriteString(fmt.Sprintf("// %s 配置结构体\n", structName))
-	sb.WriteString(fmt.Sprintf("type %s struct {\n", structName))
-
-	for _, field := range fields {
-		fieldName := g.toFieldName(field.Name)
-		// 添加 json tag
-		sb.WriteString(fmt.Sprintf("\t%s %s `json:\"%s\"`\n", fieldName, field.GoType, field.Name))
-	}
-
-	sb.WriteString("}\n")
-
-	// 如果是 KV 配置，生成 IKvConfig 接口实现
-	if isKv && valueField != "" {
-		sb.WriteString("\n")
-		sb.WriteString(fmt.Sprintf("// Ensure %s implements IKvConfig interface\n", structName))
-		sb.WriteString(fmt.Sprintf("var _ config233.IKvConfig = (*%s)(nil)\n\n", structName))
-		sb.WriteString("// GetValue 实现 IKvConfig 接口\n")
-		sb.WriteString(fmt.Sprintf("func (c *%s) GetValue() string {\n", structName))
-
-		// 根据字段类型生成返回代码
-		var fieldType string
 		for _, f := range fields {
 			if f.Name == valueField {
-				fieldType = f.GoType
+				valueFieldType = f.GoType
 				break
 			}
 		}
-
-		fieldGoName := g.toFieldName(valueField)
-		switch fieldType {
-		case "string":
-			sb.WriteString(fmt.Sprintf("\treturn c.%s\n", fieldGoName))
-		case "int", "int32", "int64":
-			sb.WriteString(fmt.Sprintf("\treturn strconv.FormatInt(int64(c.%s), 10)\n", fieldGoName))
-		case "float32", "float64":
-			sb.WriteString(fmt.Sprintf("\treturn strconv.FormatFloat(float64(c.%s), 'f', -1, 64)\n", fieldGoName))
-		case "bool":
-			sb.WriteString(fmt.Sprintf("\treturn strconv.FormatBool(c.%s)\n", fieldGoName))
-		default:
-			sb.WriteString(fmt.Sprintf("\treturn c.%s\n", fieldGoName))
-		}
-
-		sb.WriteString("}\n")
-
-		// 需要导入 strconv
-		if fieldType != "string" {
-			// 重新生成带 import 的代码
-			return g.generateStructCodeWithImport(structName, configName, fields, valueField, fieldType)
-		}
 	}
 
-	return sb.String()
-}
-
-// generateStructCodeWithImport 生成带 import 的 struct 代码
-func (g *StructGenerator) generateStructCodeWithImport(structName, configName string, fields []FieldInfo, valueField, valueFieldType string) string {
-	var sb strings.Builder
-
 	// 包声明和导入
 	sb.WriteString("// Code generated by config233. DO NOT EDIT.\n")
-	sb.WriteString("// Source: " + configName + ".xlsx\n\n")
-	sb.WriteString("package generated\n\n")
-	sb.WriteString("import (\n")
-	sb.WriteString("\t\"strconv\"\n\n")
-	sb.WriteString("\t\"github.com/neko233-com/config233-go/pkg/config233\"\n")
-	sb.WriteString(")\n\n")
+	sb.WriteString("// Source: " + sourceName + "\n\n")
+	sb.WriteString("package " + g.packageName() + "\n\n")
+	if valueField != "" {
+		if valueFieldType == "string" {
+			sb.WriteString("import \"github.com/neko233-com/config233-go/pkg/config233\"\n\n")
+		} else {
+			// 非字符串的 value 需要 strconv 转换
+			sb.WriteString("import (\n")
+			sb.WriteString("\t\"strconv\"\n\n")
+			sb.WriteString("\t\"github.com/neko233-com/config233-go/pkg/config233\"\n")
+			sb.WriteString(")\n\n")
+		}
+	}
 
 	// 生成 struct
+	uidField := ""
+	if g.opts.UidTag {
+		uidField = g.findUidField(fields)
+	}
 	sb.WriteString(fmt.Sprintf("// %s 配置结构体\n", structName))
 	sb.WriteString(fmt.Sprintf("type %s struct {\n", structName))
 
 	for _, field := range fields {
 		fieldName := g.toFieldName(field.Name)
-		sb.WriteString(fmt.Sprintf("\t%s %s `json:\"%s\"`\n", fieldName, field.GoType, field.Name))
+		// 字段说明写在字段上方，多行说明逐行加注释
+		if field.Comment != "" {
+			for _, line := range strings.Split(field.Comment, "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					sb.WriteString("\t// " + line + "\n")
+				}
+			}
+		}
+		// 添加 json tag
+		tag := fmt.Sprintf("json:\"%s\"", field.Name)
+		if field.Name == uidField {
+			tag += " config233:\"uid\""
+		}
+		sb.WriteString(fmt.Sprintf("\t%s %s `%s`\n", fieldName, field.GoType, tag))
 	}
 
 	sb.WriteString("}\n")
 
+	if valueField == "" {
+		return sb.String()
+	}
+
 	// IKvConfig 接口实现
 	sb.WriteString("\n")
 	sb.WriteString(fmt.Sprintf("// Ensure %s implements IKvConfig interface\n", structName))
 	sb.WriteString(fmt.Sprintf("var _ config233.IKvConfig = (*%s)(nil)\n\n", structName))
+	sb.WriteString("// GetUid 实现 IKvConfig 接口\n")
+	sb.WriteString(fmt.Sprintf("func (c *%s) GetUid() any {\n", structName))
+	sb.WriteString(fmt.Sprintf("\treturn c.%s\n", g.toFieldName(g.findUidField(fields))))
+	sb.WriteString("}\n\n")
 	sb.WriteString("// GetValue 实现 IKvConfig 接口\n")
 	sb.WriteString(fmt.Sprintf("func (c *%s) GetValue() string {\n", structName))
 
@@ -339,3 +446,23 @@ func GenerateStructFromExcel(excelPath, outputDir string) error {
 	generator := NewStructGenerator(outputDir)
 	return generator.GenerateFromExcel(excelPath)
 }
+
+// GenerateStructFromExcelWithOptions 便捷函数：按生成选项从单个 Excel 文件生成 struct
+// 参数:
+//
+//	excelPath: Excel 文件路径
+//	opts: 生成选项（输出目录、包名、文件名风格、uid 标签、IKvConfig 实现）
+//
+// 返回值:
+//
+//	error: 读取 Excel 或写入文件失败时返回错误
+func GenerateStructFromExcelWithOptions(excelPath string, opts GenOptions) error {
+	generator := NewStructGeneratorWithOptions(opts)
+	return generator.GenerateFromExcel(excelPath)
+}
+
+// GenerateStructsFromExcelDirWithOptions 便捷函数：按生成选项从 Excel 目录生成 struct
+func GenerateStructsFromExcelDirWithOptions(excelDir string, opts GenOptions) error {
+	generator := NewStructGeneratorWithOptions(opts)
+	return generator.GenerateFromDir(excelDir)
+}
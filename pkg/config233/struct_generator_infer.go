@@ -0,0 +1,372 @@
+package config233
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// tsvSampleRows 从 TSV 推断类型时最多采样的数据行数
+const tsvSampleRows = 100
+
+// jsonNodeKind JSON 值的种类
+type jsonNodeKind int
+
+const (
+	jsonNull jsonNodeKind = iota
+	jsonBool
+	jsonNumber
+	jsonString
+	jsonObject
+	jsonArray
+)
+
+// jsonNode 保留 key 顺序的 JSON 值，用于按文件中的字段顺序生成 struct
+type jsonNode struct {
+	kind   jsonNodeKind
+	number json.Number
+	keys   []string
+	fields map[string]*jsonNode
+	elems  []*jsonNode
+}
+
+// nestedStruct 由 JSON 嵌套对象推断出的 struct 定义
+type nestedStruct struct {
+	name   string
+	fields []FieldInfo
+}
+
+// decodeJSONNode 从 decoder 读取一个完整的 JSON 值
+func decodeJSONNode(dec *json.Decoder) (*jsonNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch v := tok.(type) {
+	case nil:
+		return &jsonNode{kind: jsonNull}, nil
+	case bool:
+		return &jsonNode{kind: jsonBool}, nil
+	case json.Number:
+		return &jsonNode{kind: jsonNumber, number: v}, nil
+	case string:
+		return &jsonNode{kind: jsonString}, nil
+	case json.Delim:
+		if v == '[' {
+			node := &jsonNode{kind: jsonArray}
+			for dec.More() {
+				elem, err := decodeJSONNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.elems = append(node.elems, elem)
+			}
+			_, err := dec.Token()
+			return node, err
+		}
+		node := &jsonNode{kind: jsonObject, fields: make(map[string]*jsonNode)}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, _ := keyTok.(string)
+			value, err := decodeJSONNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			if _, exists := node.fields[key]; !exists {
+				node.keys = append(node.keys, key)
+			}
+			node.fields[key] = value
+		}
+		_, err := dec.Token()
+		return node, err
+	}
+	return nil, fmt.Errorf("无法识别的 JSON 内容: %v", tok)
+}
+
+// jsonRecords 取出 JSON 配置中的记录列表，支持数组、id 映射对象与单个对象三种写法
+func jsonRecords(root *jsonNode) []*jsonNode {
+	switch root.kind {
+	case jsonArray:
+		records := make([]*jsonNode, 0, len(root.elems))
+		for _, elem := range root.elems {
+			if elem.kind == jsonObject {
+				records = append(records, elem)
+			}
+		}
+		return records
+	case jsonObject:
+		records := make([]*jsonNode, 0, len(root.keys))
+		for _, key := range root.keys {
+			if root.fields[key].kind != jsonObject {
+				// 不是 id 映射，整个对象作为一条记录
+				return []*jsonNode{root}
+			}
+			records = append(records, root.fields[key])
+		}
+		if len(records) == 0 {
+			return []*jsonNode{root}
+		}
+		return records
+	}
+	return nil
+}
+
+// jsonTypeInferrer 根据多条记录推断 Go 类型，嵌套对象生成独立的 struct
+type jsonTypeInferrer struct {
+	g       *StructGenerator
+	nested  []nestedStruct
+	nameSet map[string]bool
+}
+
+// inferFields 按首次出现的顺序汇总所有对象的字段并推断类型
+func (inf *jsonTypeInferrer) inferFields(structName string, objects []*jsonNode) []FieldInfo {
+	var keys []string
+	values := make(map[string][]*jsonNode)
+	for _, obj := range objects {
+		for _, key := range obj.keys {
+			if _, ok := values[key]; !ok {
+				keys = append(keys, key)
+			}
+			values[key] = append(values[key], obj.fields[key])
+		}
+	}
+
+	fields := make([]FieldInfo, 0, len(keys))
+	for _, key := range keys {
+		fields = append(fields, FieldInfo{
+			Name:   key,
+			GoType: inf.inferType(structName+inf.g.toFieldName(key), values[key]),
+		})
+	}
+	return fields
+}
+
+// inferType 推断一组值的共同类型
+// null 不参与推断，全为 null 时为 interface{}；空数组的元素类型由其他记录决定，都为空时为 []interface{}
+// 整数超出 int32 范围时为 int64，出现小数时为 float64，类型不一致时为 interface{}
+func (inf *jsonTypeInferrer) inferType(name string, nodes []*jsonNode) string {
+	var kind jsonNodeKind = jsonNull
+	present := make([]*jsonNode, 0, len(nodes))
+	for _, node := range nodes {
+		if node.kind == jsonNull {
+			continue
+		}
+		if kind != jsonNull && node.kind != kind {
+			return "interface{}"
+		}
+		kind = node.kind
+		present = append(present, node)
+	}
+
+	switch kind {
+	case jsonBool:
+		return "bool"
+	case jsonString:
+		return "string"
+	case jsonNumber:
+		goType := "int"
+		for _, node := range present {
+			i, err := node.number.Int64()
+			if err != nil {
+				return "float64"
+			}
+			if i > math.MaxInt32 || i < math.MinInt32 {
+				goType = "int64"
+			}
+		}
+		return goType
+	case jsonObject:
+		return inf.addNested(name, present)
+	case jsonArray:
+		var elems []*jsonNode
+		for _, node := range present {
+			elems = append(elems, node.elems...)
+		}
+		if len(elems) == 0 {
+			return "[]interface{}"
+		}
+		return "[]" + inf.inferType(name, elems)
+	default:
+		return "interface{}"
+	}
+}
+
+// addNested 为嵌套对象生成 struct，重名时追加序号
+func (inf *jsonTypeInferrer) addNested(name string, objects []*jsonNode) string {
+	unique := name
+	for i := 2; inf.nameSet[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	inf.nameSet[unique] = true
+
+	idx := len(inf.nested)
+	inf.nested = append(inf.nested, nestedStruct{name: unique})
+	inf.nested[idx].fields = inf.inferFields(unique, objects)
+	return unique
+}
+
+// GenerateFromJson 从 JSON 配置文件生成 Go struct
+// 字段按第一条记录中的顺序排列，类型综合所有记录推断（null 与空数组由其他记录补全），
+// 嵌套对象生成名为 <struct 名><字段名> 的独立 struct
+func (g *StructGenerator) GenerateFromJson(jsonPath string) error {
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return fmt.Errorf("读取 JSON 文件失败: %w", err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return fmt.Errorf("JSON 文件为空")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	root, err := decodeJSONNode(dec)
+	if err != nil {
+		return fmt.Errorf("解析 JSON 文件失败: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("解析 JSON 文件失败: 顶层只能有一个值")
+	}
+
+	records := jsonRecords(root)
+	if len(records) == 0 {
+		return fmt.Errorf("JSON 文件中没有可用于推断字段的记录")
+	}
+
+	baseName := filepath.Base(jsonPath)
+	configName := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+	structName := g.toStructName(configName)
+
+	inf := &jsonTypeInferrer{g: g, nameSet: map[string]bool{structName: true}}
+	fields := inf.inferFields(structName, records)
+
+	code := g.generateStructCode(structName, configName, baseName, fields)
+	for _, nested := range inf.nested {
+		code += g.generateNestedStructCode(nested)
+	}
+	return g.writeStructFile(configName, code)
+}
+
+// generateNestedStructCode 生成嵌套 struct 代码
+func (g *StructGenerator) generateNestedStructCode(nested nestedStruct) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("\n// %s 嵌套结构体\n", nested.name))
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", nested.name))
+	for _, field := range nested.fields {
+		sb.WriteString(fmt.Sprintf("\t%s %s `json:\"%s\"`\n", g.toFieldName(field.Name), field.GoType, field.Name))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// GenerateFromTsv 从 TSV/CSV 配置文件生成 Go struct
+// 第一行为字段名，类型由最多 tsvSampleRows 行非空数据推断：
+// 都是整数时为 int（超出 int32 范围时为 int64），都是数字时为 float64，都是 true/false 时为 bool，否则为 string
+func (g *StructGenerator) GenerateFromTsv(tsvPath string) error {
+	handler := &tsv.TsvConfigHandler{}
+	if strings.EqualFold(filepath.Ext(tsvPath), ".csv") {
+		handler.Delimiter = ','
+	}
+
+	baseName := filepath.Base(tsvPath)
+	configName := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+	structName := g.toStructName(configName)
+
+	result, err := handler.ReadToFrontEndDataList(configName, tsvPath)
+	if err != nil {
+		return fmt.Errorf("读取 TSV 文件失败: %w", err)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
+	if len(configDto.ColumnNames) == 0 {
+		return fmt.Errorf("TSV 文件没有表头")
+	}
+
+	sampled := configDto.DataList
+	if len(sampled) > tsvSampleRows {
+		sampled = sampled[:tsvSampleRows]
+	}
+
+	fields := make([]FieldInfo, 0, len(configDto.ColumnNames))
+	seen := make(map[string]bool)
+	for _, column := range configDto.ColumnNames {
+		if seen[column] {
+			continue
+		}
+		seen[column] = true
+
+		samples := make([]string, 0, len(sampled))
+		for _, item := range sampled {
+			if value, ok := item[column].(string); ok && value != "" {
+				samples = append(samples, value)
+			}
+		}
+		fields = append(fields, FieldInfo{
+			Name:   column,
+			GoType: inferTsvType(samples),
+		})
+	}
+
+	code := g.generateStructCode(structName, configName, baseName, fields)
+	return g.writeStructFile(configName, code)
+}
+
+// inferTsvType 根据采样值推断 TSV 列的 Go 类型，没有采样值时为 string
+func inferTsvType(samples []string) string {
+	if len(samples) == 0 {
+		return "string"
+	}
+
+	isInt, isFloat, isBool := true, true, true
+	isInt64 := false
+	for _, value := range samples {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			if i > math.MaxInt32 || i < math.MinInt32 {
+				isInt64 = true
+			}
+		} else {
+			isInt = false
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			isFloat = false
+		}
+		if lower := strings.ToLower(value); lower != "true" && lower != "false" {
+			isBool = false
+		}
+	}
+
+	switch {
+	case isInt && isInt64:
+		return "int64"
+	case isInt:
+		return "int"
+	case isFloat:
+		return "float64"
+	case isBool:
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// GenerateStructFromJson 便捷函数：从单个 JSON 配置文件生成 struct
+func GenerateStructFromJson(jsonPath, outputDir string) error {
+	generator := NewStructGenerator(outputDir)
+	return generator.GenerateFromJson(jsonPath)
+}
+
+// GenerateStructFromTsv 便捷函数：从单个 TSV/CSV 配置文件生成 struct
+func GenerateStructFromTsv(tsvPath, outputDir string) error {
+	generator := NewStructGenerator(outputDir)
+	return generator.GenerateFromTsv(tsvPath)
+}
@@ -0,0 +1,248 @@
+package config233
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// TypeScriptGenerator Excel 配置 TypeScript interface 代码生成器
+// 与 StructGenerator 读取相同的表头结构，生成前端使用的类型定义
+type TypeScriptGenerator struct {
+	outputDir string
+}
+
+// NewTypeScriptGenerator 创建新的 TypeScript 生成器
+func NewTypeScriptGenerator(outputDir string) *TypeScriptGenerator {
+	return &TypeScriptGenerator{
+		outputDir: outputDir,
+	}
+}
+
+// tsFieldInfo TypeScript 字段信息
+type tsFieldInfo struct {
+	Name      string // camelCase 字段名
+	Comment   string // 中文注释行的内容
+	ExcelType string // Excel 中的类型
+	TsType    string // TypeScript 类型
+}
+
+// tsIdentifierPattern 无需加引号的 TypeScript 属性名
+var tsIdentifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// GenerateFromExcel 从 Excel 文件生成 TypeScript interface，输出为 <配置名>.ts
+// 空字段名的列被跳过；转换为 camelCase 后重名的列只保留第一次出现的位置，
+// 类型取最后一列（与加载配置时后面的列覆盖前面的列一致）
+func (g *TypeScriptGenerator) GenerateFromExcel(excelPath string) error {
+	f, err := excelize.OpenFile(excelPath)
+	if err != nil {
+		return fmt.Errorf("打开 Excel 文件失败: %w", err)
+	}
+	defer f.Close()
+
+	// 获取第一个工作表
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return fmt.Errorf("Excel 文件没有工作表")
+	}
+
+	rows, err := f.GetRows(sheets[0])
+	if err != nil {
+		return fmt.Errorf("读取工作表失败: %w", err)
+	}
+
+	// 固定行结构，与 StructGenerator 一致
+	const (
+		commentRowIndex = 1 // 中文注释行（第 2 行）
+		typeRowIndex    = 3 // 类型行（第 4 行）
+		serverRowIndex  = 4 // Server 字段名行（第 5 行）
+	)
+
+	if len(rows) <= serverRowIndex {
+		return fmt.Errorf("Excel 行数不足，需要至少 %d 行", serverRowIndex+1)
+	}
+
+	baseName := filepath.Base(excelPath)
+	configName := strings.TrimSuffix(baseName, filepath.Ext(baseName))
+
+	commentRow := rows[commentRowIndex]
+	typeRow := rows[typeRowIndex]
+	serverRow := rows[serverRowIndex]
+
+	var fields []tsFieldInfo
+	fieldIndex := make(map[string]int)
+	// 从第 2 列开始（跳过标识列）
+	for i := 1; i < len(serverRow); i++ {
+		fieldName := g.toFieldName(serverRow[i])
+		if fieldName == "" {
+			continue
+		}
+
+		field := tsFieldInfo{Name: fieldName}
+		if i < len(typeRow) {
+			field.ExcelType = strings.TrimSpace(typeRow[i])
+		}
+		if i < len(commentRow) {
+			field.Comment = strings.TrimSpace(commentRow[i])
+		}
+		field.TsType = g.excelTypeToTsType(field.ExcelType)
+
+		if idx, ok := fieldIndex[fieldName]; ok {
+			getLogger().Info("重复的列名，使用最后一列的类型", "file", excelPath, "field", fieldName, "column", i+1)
+			if fields[idx].Comment != "" && field.Comment == "" {
+				field.Comment = fields[idx].Comment
+			}
+			fields[idx] = field
+			continue
+		}
+		fieldIndex[fieldName] = len(fields)
+		fields = append(fields, field)
+	}
+
+	code := g.generateInterfaceCode(configName, baseName, fields)
+
+	// 确保输出目录存在
+	if err := os.MkdirAll(g.outputDir, 0755); err != nil {
+		return fmt.Errorf("创建输出目录失败: %w", err)
+	}
+
+	outputPath := filepath.Join(g.outputDir, configName+".ts")
+	if err := os.WriteFile(outputPath, []byte(code), 0644); err != nil {
+		return fmt.Errorf("写入文件失败: %w", err)
+	}
+
+	return nil
+}
+
+// GenerateFromDir 从目录下的所有 Excel 文件生成 TypeScript interface
+func (g *TypeScriptGenerator) GenerateFromDir(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if isHiddenDir(info) {
+			return filepath.SkipDir
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		if strings.ToLower(filepath.Ext(path)) == ".xlsx" {
+			// 跳过 Excel 临时文件
+			if strings.HasPrefix(filepath.Base(path), "~$") {
+				return nil
+			}
+
+			if err := g.GenerateFromExcel(path); err != nil {
+				getLogger().Error(err, "生成 TypeScript 失败", "file", path)
+				// 继续处理其他文件
+			} else {
+				getLogger().Info("生成 TypeScript 成功", "file", path)
+			}
+		}
+
+		return nil
+	})
+}
+
+// excelTypeToTsType 将 Excel 类型转换为 TypeScript 类型，数组类型（如 int[]）转为 number[]
+func (g *TypeScriptGenerator) excelTypeToTsType(excelType string) string {
+	excelType = strings.TrimSpace(strings.ToLower(excelType))
+	if strings.HasSuffix(excelType, "[]") {
+		baseType := strings.TrimSpace(strings.TrimSuffix(excelType, "[]"))
+		if baseType == "" {
+			return "string[]"
+		}
+		return g.excelTypeToTsType(baseType) + "[]"
+	}
+
+	switch excelType {
+	case "int", "int32", "long", "int64", "float", "float32", "double", "float64", "number":
+		return "number"
+	case "bool", "boolean":
+		return "boolean"
+	case "json":
+		return "string" // JSON 保持为字符串，与 StructGenerator 一致
+	default:
+		return "string"
+	}
+}
+
+// toFieldName 将字段名转为 camelCase，支持 item_id、ItemId、ID 等写法
+func (g *TypeScriptGenerator) toFieldName(name string) string {
+	parts := strings.FieldsFunc(strings.TrimSpace(name), func(r rune) bool {
+		return r == '_' || r == '-' || unicode.IsSpace(r)
+	})
+	if len(parts) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(lowerLeadingUpper(parts[0]))
+	for _, part := range parts[1:] {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	return sb.String()
+}
+
+// lowerLeadingUpper 将开头连续的大写字母转为小写，仅保留紧接小写字母的最后一个大写字母
+// 例如 ItemId -> itemId、ID -> id、IDCard -> idCard
+func lowerLeadingUpper(s string) string {
+	runes := []rune(s)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// generateInterfaceCode 生成 interface 代码
+func (g *TypeScriptGenerator) generateInterfaceCode(configName, sourceName string, fields []tsFieldInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// Code generated by config233. DO NOT EDIT.\n")
+	sb.WriteString("// Source: " + sourceName + "\n\n")
+
+	sb.WriteString(fmt.Sprintf("/** %s 配置 */\n", configName))
+	sb.WriteString(fmt.Sprintf("export interface %s {\n", configName))
+	for _, field := range fields {
+		if field.Comment != "" {
+			sb.WriteString(fmt.Sprintf("  /** %s */\n", strings.ReplaceAll(field.Comment, "*/", "* /")))
+		}
+		name := field.Name
+		if !tsIdentifierPattern.MatchString(name) {
+			name = fmt.Sprintf("%q", name)
+		}
+		sb.WriteString(fmt.Sprintf("  %s: %s;\n", name, field.TsType))
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// GenerateTypeScriptFromExcelDir 便捷函数：从 Excel 目录生成 TypeScript interface 到指定输出目录
+// 每个配置输出一个 <配置名>.ts 文件
+func GenerateTypeScriptFromExcelDir(srcDir, outDir string) error {
+	generator := NewTypeScriptGenerator(outDir)
+	return generator.GenerateFromDir(srcDir)
+}
+
+// GenerateTypeScriptFromExcel 便捷函数：从单个 Excel 文件生成 TypeScript interface
+func GenerateTypeScriptFromExcel(excelPath, outDir string) error {
+	generator := NewTypeScriptGenerator(outDir)
+	return generator.GenerateFromExcel(excelPath)
+}
@@ -1,18 +1,34 @@
 package tsv
 
 import (
-	"bufio"
-	"io/ioutil"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
 )
 
 // TsvConfigHandler TSV 配置处理器
 // 负责处理 TSV (Tab-Separated Values) 格式的配置文件，读取并解析为配置对象
-type TsvConfigHandler struct{}
+// 通过 Delimiter 指定其他分隔符即可读取 CSV（逗号）或竖线分隔的文件
+// 以 # 开头的整行视为注释跳过；多行表头时通过 HeaderRow / DataRow 指定表头与数据所在行
+type TsvConfigHandler struct {
+	// Delimiter 字段分隔符，为 0 时使用制表符 '\t'
+	Delimiter rune
+	// HeaderRow 列名所在行，从 1 开始且不计空行与注释行，为 0 时为第 1 行；之前的行（如中文说明行）被跳过
+	HeaderRow int
+	// DataRow 数据起始行，计数方式同 HeaderRow，为 0 时紧接在表头之后；表头与数据之间的行（如类型行）被跳过
+	DataRow int
+	// ColumnNameNormalizer 匹配字段前对表头的每个列名做转换（如去空格、中文转拼音、下划线转驼峰），
+	// 为 nil 时保持原样；转换结果为空字符串的列不映射
+	ColumnNameNormalizer func(raw string) string
+}
 
 // TypeName 返回处理器类型名
 // 返回值:
@@ -22,9 +38,113 @@ func (h *TsvConfigHandler) TypeName() string {
 	return "tsv"
 }
 
+// delimiter 返回实际使用的分隔符
+func (h *TsvConfigHandler) delimiter() rune {
+	if h.Delimiter == 0 {
+		return '\t'
+	}
+	return h.Delimiter
+}
+
+// suffix 返回数据传输对象中的文件后缀，逗号分隔时为 "csv"
+func (h *TsvConfigHandler) suffix() string {
+	if h.delimiter() == ',' {
+		return "csv"
+	}
+	return "tsv"
+}
+
+// tsvRecord 一行数据
+type tsvRecord struct {
+	row    int // 行号，从 1 开始且不计空行与注释行，与 HeaderRow / DataRow 的计数方式一致
+	values []string
+}
+
+// headerRow 返回实际的表头行号
+func (h *TsvConfigHandler) headerRow() int {
+	if h.HeaderRow <= 0 {
+		return 1
+	}
+	return h.HeaderRow
+}
+
+// dataRow 返回实际的数据起始行号，不早于表头的下一行
+func (h *TsvConfigHandler) dataRow() int {
+	return max(h.DataRow, h.headerRow()+1)
+}
+
+// readRecords 读取文件并按分隔符解析为表头和数据行
+func (h *TsvConfigHandler) readRecords(configFileFullPath string) ([]string, []tsvRecord, error) {
+	data, err := os.ReadFile(configFileFullPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h.parseRecords(data)
+}
+
+// parseRecords 按分隔符将文件内容解析为表头和数据行
+// 使用 encoding/csv 解析，支持带引号、含分隔符或换行的字段；
+// 会去掉 UTF-8 BOM 头，兼容 CRLF 换行，并跳过所有字段都为空的行与以 # 开头的注释行。
+// 表头中的空列名（包括行尾多余分隔符产生的空列）保留为空字符串，转换时不映射
+func (h *TsvConfigHandler) parseRecords(data []byte) ([]string, []tsvRecord, error) {
+	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = h.delimiter()
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	headerRow, dataRow := h.headerRow(), h.dataRow()
+	var headers []string
+	var records []tsvRecord
+	row := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		row++
+		switch {
+		case row == headerRow:
+			headers = make([]string, len(record))
+			for i, header := range record {
+				headers[i] = h.normalizeColumnName(strings.TrimSpace(header))
+			}
+		case row >= dataRow:
+			records = append(records, tsvRecord{row: row, values: record})
+		}
+	}
+	return headers, records, nil
+}
+
+// normalizeColumnName 用 ColumnNameNormalizer 转换非空的列名
+func (h *TsvConfigHandler) normalizeColumnName(name string) string {
+	if h.ColumnNameNormalizer == nil || name == "" {
+		return name
+	}
+	return strings.TrimSpace(h.ColumnNameNormalizer(name))
+}
+
+// isBlankRecord 判断一行的所有字段是否都为空白
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
 // ReadToFrontEndDataList 读取配置并转为前端数据列表
 // 读取 TSV 配置文件并转换为前端可用的数据传输对象
-// 第一行为表头，后续行为数据行，以制表符(\t)分隔
+// 第一行（或 HeaderRow 指定的行）为表头，后续行为数据行，以 Delimiter 分隔（默认制表符）
 // 参数:
 //
 //	configName: 配置名称
@@ -33,37 +153,51 @@ func (h *TsvConfigHandler) TypeName() string {
 // 返回值:
 //
 //	interface{}: 包含解析后数据的传输对象
-func (h *TsvConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) interface{} {
-	data, err := ioutil.ReadFile(configFileFullPath)
+//	error: 文件读取或解析失败时返回错误
+func (h *TsvConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) (interface{}, error) {
+	headers, records, err := h.readRecords(configFileFullPath)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("read %s config %q (%s) failed: %w", h.suffix(), configName, configFileFullPath, err)
 	}
+	return h.toFrontEndDto(configName, headers, records), nil
+}
 
-	content := string(data)
-	lines := strings.Split(content, "\n")
-	if len(lines) < 2 {
+// ReadToFrontEndDataListFromBytes 从内存中的 TSV 内容读取配置并转为前端数据列表
+// 用于 embed.FS 等无法直接按路径读取文件的场景
+// 参数:
+//
+//	configName: 配置名称
+//	data: TSV 文件内容
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 解析失败时返回错误
+func (h *TsvConfigHandler) ReadToFrontEndDataListFromBytes(configName string, data []byte) (interface{}, error) {
+	headers, records, err := h.parseRecords(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s config %q failed: %w", h.suffix(), configName, err)
+	}
+	return h.toFrontEndDto(configName, headers, records), nil
+}
+
+// toFrontEndDto 将表头和数据行转换为前端数据传输对象
+func (h *TsvConfigHandler) toFrontEndDto(configName string, headers []string, records []tsvRecord) *dto.FrontEndConfigDto {
+	if len(records) == 0 {
 		return &dto.FrontEndConfigDto{
 			DataList:         nil,
 			Type:             h.TypeName(),
-			Suffix:           "tsv",
+			Suffix:           h.suffix(),
 			ConfigNameSimple: configName,
 		}
 	}
 
-	headers := strings.Split(strings.TrimSpace(lines[0]), "\t")
 	var dataList []map[string]interface{}
-
-	for _, line := range lines[1:] {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-
-		values := strings.Split(line, "\t")
+	for _, record := range records {
 		item := make(map[string]interface{})
-		for i, value := range values {
-			if i < len(headers) {
-				item[headers[i]] = value
+		for i, value := range record.values {
+			if i < len(headers) && headers[i] != "" {
+				item[headers[i]] = strings.TrimSpace(value)
 			}
 		}
 		dataList = append(dataList, item)
@@ -72,80 +206,199 @@ func (h *TsvConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath
 	return &dto.FrontEndConfigDto{
 		DataList:         dataList,
 		Type:             h.TypeName(),
-		Suffix:           "tsv",
+		Suffix:           h.suffix(),
 		ConfigNameSimple: configName,
+		ColumnNames:      nonEmptyColumns(headers, 0),
+	}
+}
+
+// nonEmptyColumns 按原始顺序返回从 firstColumn 开始的非空列名
+func nonEmptyColumns(headers []string, firstColumn int) []string {
+	columns := make([]string, 0, len(headers))
+	for i := firstColumn; i < len(headers); i++ {
+		if name := strings.TrimSpace(headers[i]); name != "" {
+			columns = append(columns, name)
+		}
 	}
+	return columns
 }
 
 // ReadConfigAndORM 读取配置并转换为对象列表
-func (h *TsvConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) []interface{} {
-	data, err := ioutil.ReadFile(configFileFullPath)
+// 单元格类型转换失败时返回 dto.ConversionErrors，此时对象列表仍然有效，失败的字段保持零值
+func (h *TsvConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) ([]interface{}, error) {
+	headers, records, err := h.readRecords(configFileFullPath)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("read %s config %q (%s) failed: %w", h.suffix(), configName, configFileFullPath, err)
 	}
+	return conversionResult(h.toObjects(typ, configName, headers, records))
+}
 
-	content := string(data)
-	scanner := bufio.NewScanner(strings.NewReader(content))
+// ReadConfigAndORMFromBytes 从内存中的 TSV 内容读取配置并转换为对象列表
+func (h *TsvConfigHandler) ReadConfigAndORMFromBytes(typ reflect.Type, configName string, data []byte) ([]interface{}, error) {
+	headers, records, err := h.parseRecords(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s config %q failed: %w", h.suffix(), configName, err)
+	}
+	return conversionResult(h.toObjects(typ, configName, headers, records))
+}
 
-	var lines []string
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line != "" {
-			lines = append(lines, line)
-		}
+// conversionResult 有类型转换错误时返回 ConversionErrors，否则返回 nil error
+func conversionResult(result []interface{}, convErrs dto.ConversionErrors) ([]interface{}, error) {
+	if len(convErrs) > 0 {
+		return result, convErrs
 	}
+	return result, nil
+}
 
-	if len(lines) < 2 {
-		return nil
+// toObjects 按表头将数据行转换为 typ 类型的对象列表
+// 类型转换失败的字段保持零值，失败信息收集到返回的 ConversionErrors 中
+func (h *TsvConfigHandler) toObjects(typ reflect.Type, configName string, headers []string, records []tsvRecord) ([]interface{}, dto.ConversionErrors) {
+	if len(records) == 0 {
+		return nil, nil
 	}
 
-	headers := strings.Split(lines[0], "\t")
-	var result []interface{}
+	// 表头按字段名映射到 struct 字段（按类型缓存），支持匿名嵌入结构体提升的字段与 Reward.ItemId 形式的嵌套字段
+	columns := make([]*convert.CellField, len(headers))
+	for i, header := range headers {
+		if header == "" {
+			continue // 空列名不映射
+		}
+		columns[i] = columnFields.Lookup(typ, header)
+	}
 
-	for _, line := range lines[1:] {
-		values := strings.Split(line, "\t")
+	var result []interface{}
+	var convErrs dto.ConversionErrors
+	for _, record := range records {
 		obj := reflect.New(typ).Elem()
 
-		for i, value := range values {
-			if i >= len(headers) {
+		for i, value := range record.values {
+			if i >= len(headers) || columns[i] == nil {
 				continue
 			}
 
+			mapped := columns[i]
 			fieldName := headers[i]
-			field := obj.FieldByName(fieldName)
+			// 嵌套的结构体指针字段在首次写入时自动初始化
+			field := convert.FieldByIndexAlloc(obj, mapped.Index)
 			if !field.IsValid() || !field.CanSet() {
 				continue
 			}
 
-			h.setFieldValue(field, value)
+			value = strings.TrimSpace(value)
+			targetType := mapped.TypeName
+
+			var err error
+			switch {
+			case mapped.Kind == convert.CellKindUnmarshaler:
+				// 字段类型实现了 UnmarshalConfigCell，由业务自定义解析
+				err = convert.UnmarshalCell(field, value)
+			case mapped.JSON.Match(value):
+				// 单元格内嵌 JSON 对象或数组
+				targetType = "json"
+				err = convert.SetJSONCellValue(field, value)
+			case mapped.Kind == convert.CellKindTime:
+				// time.Time / time.Duration 字段，layout 可通过 config233_timefmt 标签指定
+				err = convert.SetTimeValue(field, value, mapped.TimeFormat)
+			case mapped.Kind == convert.CellKindSlice:
+				// 切片字段按 config233_sep 标签指定的分隔符拆分
+				err = h.setSliceFieldValue(field, value, mapped.Separator)
+			default:
+				err = h.setFieldValue(field, value)
+			}
+			if err != nil {
+				convErrs = append(convErrs, &dto.ConversionError{
+					ConfigName: configName,
+					RowIndex:   record.row,
+					ColumnName: fieldName,
+					TargetType: targetType,
+					RawValue:   value,
+					Err:        err,
+				})
+			}
 		}
 
 		result = append(result, obj.Interface())
 	}
 
-	return result
+	return result, convErrs
 }
 
-// setFieldValue 设置字段值
-func (h *TsvConfigHandler) setFieldValue(field reflect.Value, value string) {
-	switch field.Kind() {
-	case reflect.String:
+// columnFields 列名到 struct 字段的映射缓存，按 matchFieldName 匹配
+var columnFields = convert.NewFieldCache(matchFieldName)
+
+// matchFieldName 判断表头（或点分列名中的一段）是否与字段匹配，不区分大小写
+// 优先匹配 `config233_column` 标签（逗号分隔的多个别名任一命中即可），如果没有标签则使用字段名
+func matchFieldName(field reflect.StructField, name string) bool {
+	return convert.MatchColumn(field, name)
+}
+
+// setFieldValue 设置字段值，空字符串保持零值，转换失败时返回错误
+// 指针字段（如 *int、*string）空字符串保持 nil 表示未配置，有值且转换成功时才分配
+func (h *TsvConfigHandler) setFieldValue(field reflect.Value, value string) error {
+	if field.Kind() == reflect.Ptr {
+		if value == "" {
+			return nil
+		}
+		target := reflect.New(field.Type().Elem())
+		if err := h.setFieldValue(target.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(target)
+		return nil
+	}
+	if field.Kind() == reflect.String {
 		field.SetString(value)
+		return nil
+	}
+	if value == "" {
+		return nil
+	}
+
+	switch field.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if intVal, err := strconv.ParseInt(value, 10, 64); err == nil {
-			field.SetInt(intVal)
+		intVal, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
 		}
+		field.SetInt(intVal)
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if uintVal, err := strconv.ParseUint(value, 10, 64); err == nil {
-			field.SetUint(uintVal)
+		uintVal, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
 		}
+		field.SetUint(uintVal)
 	case reflect.Float32, reflect.Float64:
-		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
-			field.SetFloat(floatVal)
+		floatVal, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
 		}
+		field.SetFloat(floatVal)
 	case reflect.Bool:
-		if boolVal, err := strconv.ParseBool(value); err == nil {
-			field.SetBool(boolVal)
+		boolVal, err := convert.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(boolVal)
+	}
+	return nil
+}
+
+// setSliceFieldValue 按 sep 拆分字符串后逐元素转换为切片，空字符串得到空切片
+func (h *TsvConfigHandler) setSliceFieldValue(field reflect.Value, value, sep string) error {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	parts := strings.Split(value, sep)
+	result := reflect.MakeSlice(field.Type(), 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(strings.Trim(part, `"'`))
+		if part == "" {
+			continue
+		}
+		elem := reflect.New(field.Type().Elem()).Elem()
+		if err := h.setFieldValue(elem, part); err != nil {
+			return err
 		}
+		result = reflect.Append(result, elem)
 	}
+	field.Set(result)
+	return nil
 }
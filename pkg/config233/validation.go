@@ -0,0 +1,167 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// configCommitFunc 配置解析完成后的写入函数
+// 默认写入共享数据与缓存，严格模式加载时先暂存，全部校验通过后再统一写入
+type configCommitFunc func(configName string, dataList interface{}, configMap map[string]interface{}, slice []interface{}) error
+
+// ConfigValidationError 单个配置问题
+// 配置文件加载失败时 Id 为空，配置项 Check 或字段规则校验失败时 Id 为该配置项的 ID
+type ConfigValidationError struct {
+	ConfigName string // 配置名称
+	FilePath   string // 配置文件路径（校验单个配置时可能为空）
+	Id         string // 配置项 ID，配置文件加载失败时为空
+	Field      string // 字段规则校验失败时的字段名，其他情况为空
+	Err        error  // 原始错误
+}
+
+// Error 实现 error 接口
+func (e *ConfigValidationError) Error() string {
+	if e.Id == "" {
+		return fmt.Sprintf("配置 %s 加载失败: %v", e.ConfigName, e.Err)
+	}
+	return fmt.Sprintf("配置 %s [id=%s] 校验失败: %v", e.ConfigName, e.Id, e.Err)
+}
+
+// Unwrap 返回原始错误，支持 errors.Is / errors.As
+func (e *ConfigValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ConfigValidationErrors 多个配置问题的集合，实现 error 接口
+// 可以通过 errors.As 取出后逐项遍历，方便 CI 阶段输出完整的问题列表
+type ConfigValidationErrors []*ConfigValidationError
+
+// Error 实现 error 接口，每个问题占一行
+func (errs ConfigValidationErrors) Error() string {
+	lines := make([]string, 0, len(errs))
+	for _, err := range errs {
+		lines = append(lines, err.Error())
+	}
+	return fmt.Sprintf("共 %d 个配置问题:\n%s", len(errs), strings.Join(lines, "\n"))
+}
+
+// Unwrap 返回所有原始错误，支持 errors.Is / errors.As
+func (errs ConfigValidationErrors) Unwrap() []error {
+	result := make([]error, 0, len(errs))
+	for _, err := range errs {
+		result = append(result, err)
+	}
+	return result
+}
+
+// ValidateConfigDir 全量校验目录下的配置，适合在 CI 中合并策划表前调用
+// 使用独立的配置管理器以严格模式加载（见 LoadAllConfigsStrict），依次执行：
+//   - 每个配置文件的解析与类型转换
+//   - 每条配置的 Check()
+//   - config233_required、config233_min / config233_max 字段规则
+//   - 全局单例上通过 RegisterCrossValidator 注册的跨配置校验（单表全部通过后才执行，避免缺表导致大量误报）
+//
+// 类型注册与跨配置校验函数沿用全局单例上的注册，校验函数应通过参数 cm 读取配置；
+// 不通知业务管理器、不启动文件监听，也不影响全局单例中已加载的配置
+// 参数:
+//
+//	dir: 配置文件的目录路径
+//
+// 返回值:
+//
+//	[]error: 所有问题，每个配置问题为一个 *ConfigValidationError；全部通过时返回空
+//
+// 示例:
+//
+//	func main() {
+//	    config233.RegisterType[ItemConfig]()
+//	    if errs := config233.ValidateConfigDir("./config"); len(errs) > 0 {
+//	        for _, err := range errs {
+//	            fmt.Println(err)
+//	        }
+//	        os.Exit(1)
+//	    }
+//	}
+func ValidateConfigDir(dir string) []error {
+	cm := NewStandaloneConfigManager233(dir)
+	cm.copyValidationSetupFrom(GetInstance())
+
+	err := cm.LoadAllConfigsStrict()
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+// copyValidationSetupFrom 复制 src 上注册的配置类型与跨配置校验函数
+func (cm *ConfigManager233) copyValidationSetupFrom(src *ConfigManager233) {
+	src.registerTypeMu.RLock()
+	types := make(map[string]reflect.Type, len(src.registeredTypes))
+	for name, typ := range src.registeredTypes {
+		types[name] = typ
+	}
+	src.registerTypeMu.RUnlock()
+	for name, typ := range types {
+		cm.RegisterTypeWithName(name, typ)
+	}
+
+	src.mutex.RLock()
+	validators := append([]CrossValidatorFunc(nil), src.crossValidators...)
+	src.mutex.RUnlock()
+	for _, fn := range validators {
+		cm.RegisterCrossValidator(fn)
+	}
+}
+
+// sortValidationErrors 按配置名、ID、字段名排序，保证输出稳定
+func sortValidationErrors(errs ConfigValidationErrors) {
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].ConfigName != errs[j].ConfigName {
+			return errs[i].ConfigName < errs[j].ConfigName
+		}
+		if errs[i].Id != errs[j].Id {
+			return errs[i].Id < errs[j].Id
+		}
+		return errs[i].Field < errs[j].Field
+	})
+}
+
+// checkConfigItems 对实现了 IConfigValidator 的配置项逐个执行 Check
+// 参数:
+//
+//	configName: 配置名称
+//	configMap: ID -> 配置 映射，用于在错误中标注配置项 ID
+//	slice: 配置列表
+//
+// 返回值:
+//
+//	ConfigValidationErrors: 所有校验失败的配置项，全部通过时返回 nil
+func checkConfigItems(configName string, configMap map[string]interface{}, slice []interface{}) ConfigValidationErrors {
+	idOf := make(map[interface{}]string, len(configMap))
+	for id, item := range configMap {
+		if _, ok := item.(IConfigValidator); ok {
+			idOf[item] = id
+		}
+	}
+
+	var errs ConfigValidationErrors
+	for i, item := range slice {
+		validator, ok := item.(IConfigValidator)
+		if !ok {
+			continue
+		}
+		if err := validator.Check(); err != nil {
+			id, found := idOf[item]
+			if !found {
+				id = fmt.Sprintf("#%d", i)
+			}
+			errs = append(errs, &ConfigValidationError{ConfigName: configName, Id: id, Err: err})
+		}
+	}
+	return errs
+}
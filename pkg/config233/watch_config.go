@@ -0,0 +1,52 @@
+package config233
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// configWatchListener WatchConfig 注册到仓库的监听器，把仓库中的数据列表转换为 []*T 后回调
+type configWatchListener[T any] struct {
+	onChange func(newList []*T)
+}
+
+// OnConfigDataChange 配置数据变更时调用
+// 参数:
+//
+//	typ: 发生变化的配置数据类型
+//	dataList: 新的配置数据列表
+func (l *configWatchListener[T]) OnConfigDataChange(typ reflect.Type, dataList []interface{}) {
+	newList := make([]*T, 0, len(dataList))
+	for _, item := range dataList {
+		if v, ok := item.(*T); ok {
+			newList = append(newList, v)
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			getLogger().Error(fmt.Errorf("%v", r), "配置变更回调发生 panic", "configType", typ.String())
+		}
+	}()
+	l.onChange(newList)
+}
+
+// WatchConfig 监听单个配置类型的变更，该类型的配置加载、热重载、删除或回滚后以转换好的 []*T 调用 onChange
+// 比起在 OnConfigLoadComplete 中判断 changedConfigNameList 是否包含自己的表更直接。
+// 回调与 RegisterForHotUpdate 共用同一条仓库变更链路，在配置引用回填之后、OnConfigLoadComplete 之前同步调用；
+// 只有通过 RegisterType 注册的类型会触发，配置被删除时收到空切片，回调中的 panic 会被恢复并记录日志
+// 参数:
+//
+//	onChange: 变更回调，newList 为该类型当前的全部配置（与 GetConfigList[T] 相同）
+func WatchConfig[T any](onChange func(newList []*T)) {
+	WatchConfigFrom[T](GetInstance(), onChange)
+}
+
+// WatchConfigFrom 与 WatchConfig 相同，但监听指定的管理器 cm
+func WatchConfigFrom[T any](cm *ConfigManager233, onChange func(newList []*T)) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	cm.bridgeMu.Lock()
+	repository := cm.bridge.configRepository
+	cm.bridgeMu.Unlock()
+	repository.AddChangeListener(typ, &configWatchListener[T]{onChange: onChange})
+}
@@ -0,0 +1,390 @@
+package xml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
+)
+
+// XmlConfigHandler XML 配置处理器
+// 负责处理 XML 格式的配置文件，读取并解析为配置对象
+// 根节点下的每个子元素视为一条配置项，子元素的属性和子节点映射为字段
+//
+// 示例:
+//
+//	<root>
+//	    <data id="1" name="xml-1"/>
+//	    <data id="2"><name><![CDATA[xml-2]]></name></data>
+//	</root>
+type XmlConfigHandler struct{}
+
+// xmlElement 解析后的 XML 元素节点
+type xmlElement struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlElement
+	text     strings.Builder
+}
+
+// TypeName 返回处理器类型名
+// 返回值:
+//
+//	string: "xml"
+func (h *XmlConfigHandler) TypeName() string {
+	return "xml"
+}
+
+// ReadToFrontEndDataList 读取配置并转为前端数据列表
+// 读取 XML 配置文件并转换为前端可用的数据传输对象
+// 空文件或根节点下没有子元素时返回 DataList 为 nil 的传输对象
+// 参数:
+//
+//	configName: 配置名称
+//	configFileFullPath: XML 配置文件的完整路径
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 文件读取或解析失败时返回错误
+func (h *XmlConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) (interface{}, error) {
+	result := &dto.FrontEndConfigDto{
+		DataList:         nil,
+		Type:             h.TypeName(),
+		Suffix:           "xml",
+		ConfigNameSimple: configName,
+	}
+
+	dataList, err := readXmlDataList(configName, configFileFullPath)
+	if err != nil {
+		return nil, err
+	}
+	result.DataList = dataList
+	return result, nil
+}
+
+// ReadToFrontEndDataListFromBytes 从内存中的 XML 内容读取配置并转为前端数据列表
+// 用于 embed.FS 等无法直接按路径读取文件的场景
+// 参数:
+//
+//	configName: 配置名称
+//	data: XML 文件内容
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 解析失败时返回错误
+func (h *XmlConfigHandler) ReadToFrontEndDataListFromBytes(configName string, data []byte) (interface{}, error) {
+	dataList, err := parseXmlDataList(configName, bytesSource, data)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.FrontEndConfigDto{
+		DataList:         dataList,
+		Type:             h.TypeName(),
+		Suffix:           "xml",
+		ConfigNameSimple: configName,
+	}, nil
+}
+
+// ReadConfigAndORM 读取配置并转换为对象列表
+// 字段匹配顺序：config233_column 标签、xml 标签、json 标签、字段名（均不区分大小写）
+// 参数:
+//
+//	typ: 目标配置对象的类型
+//	configName: 配置名称
+//	configFileFullPath: XML 配置文件的完整路径
+//
+// 返回值:
+//
+//	[]interface{}: 配置对象实例列表
+//	error: 文件读取或解析失败时返回错误
+func (h *XmlConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) ([]interface{}, error) {
+	dataList, err := readXmlDataList(configName, configFileFullPath)
+	if err != nil {
+		return nil, err
+	}
+	return h.toObjects(typ, dataList), nil
+}
+
+// ReadConfigAndORMFromBytes 从内存中的 XML 内容读取配置并转换为对象列表
+// 参数:
+//
+//	typ: 目标配置对象的类型
+//	configName: 配置名称
+//	data: XML 文件内容
+//
+// 返回值:
+//
+//	[]interface{}: 配置对象实例列表
+//	error: 解析失败时返回错误
+func (h *XmlConfigHandler) ReadConfigAndORMFromBytes(typ reflect.Type, configName string, data []byte) ([]interface{}, error) {
+	dataList, err := parseXmlDataList(configName, bytesSource, data)
+	if err != nil {
+		return nil, err
+	}
+	return h.toObjects(typ, dataList), nil
+}
+
+// toObjects 将 map 数据列表填充为 typ 类型的对象列表
+func (h *XmlConfigHandler) toObjects(typ reflect.Type, dataList []map[string]interface{}) []interface{} {
+	if dataList == nil {
+		return nil
+	}
+
+	result := make([]interface{}, 0, len(dataList))
+	for _, item := range dataList {
+		obj := reflect.New(typ).Elem()
+		h.fillStruct(obj, item)
+		result = append(result, obj.Interface())
+	}
+	return result
+}
+
+// readXmlDataList 读取 XML 文件并把根节点的每个子元素转换为一条 map 数据
+func readXmlDataList(configName, configFileFullPath string) ([]map[string]interface{}, error) {
+	data, err := os.ReadFile(configFileFullPath)
+	if err != nil {
+		return nil, fmt.Errorf("read xml config %q (%s) failed: %w", configName, configFileFullPath, err)
+	}
+	return parseXmlDataList(configName, configFileFullPath, data)
+}
+
+// bytesSource 从内存内容解析时错误信息中代替文件路径的占位符
+const bytesSource = "<bytes>"
+
+// parseXmlDataList 把 XML 内容根节点的每个子元素转换为一条 map 数据，configFileFullPath 仅用于错误信息
+func parseXmlDataList(configName, configFileFullPath string, data []byte) ([]map[string]interface{}, error) {
+	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	root, err := parseXmlTree(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse xml config %q (%s) failed: %w", configName, configFileFullPath, err)
+	}
+	if root == nil || len(root.children) == 0 {
+		return nil, nil
+	}
+
+	dataList := make([]map[string]interface{}, 0, len(root.children))
+	for _, child := range root.children {
+		item := elementToMap(child)
+		if len(item) == 0 {
+			continue
+		}
+		dataList = append(dataList, item)
+	}
+	if len(dataList) == 0 {
+		return nil, nil
+	}
+	return dataList, nil
+}
+
+// parseXmlTree 将 XML 内容解析为元素树，返回根元素
+// 元素与属性名均使用去掉命名空间后的本地名，CDATA 会被当作普通文本读取
+func parseXmlTree(data []byte) (*xmlElement, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	// 兼容非 UTF-8 声明的文件，按原始字节读取
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	var root *xmlElement
+	var stack []*xmlElement
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			elem := &xmlElement{name: t.Name.Local}
+			for _, attr := range t.Attr {
+				// 跳过命名空间声明属性
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				elem.attrs = append(elem.attrs, attr)
+			}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, fmt.Errorf("xml 只能有一个根节点")
+				}
+				root = elem
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, elem)
+			}
+			stack = append(stack, elem)
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+	return root, nil
+}
+
+// elementToMap 把一个元素的属性和子节点转换为 map
+// 叶子子节点（无属性、无子元素）取其文本值，其余子节点递归转换为嵌套 map
+// 同名的多个子节点会合并为 []interface{}
+func elementToMap(elem *xmlElement) map[string]interface{} {
+	item := make(map[string]interface{}, len(elem.attrs)+len(elem.children))
+	for _, attr := range elem.attrs {
+		item[attr.Name.Local] = attr.Value
+	}
+
+	for _, child := range elem.children {
+		var value interface{}
+		if len(child.attrs) == 0 && len(child.children) == 0 {
+			value = strings.TrimSpace(child.text.String())
+		} else {
+			value = elementToMap(child)
+		}
+
+		if existing, ok := item[child.name]; ok {
+			if list, isList := existing.([]interface{}); isList {
+				item[child.name] = append(list, value)
+			} else {
+				item[child.name] = []interface{}{existing, value}
+			}
+			continue
+		}
+		item[child.name] = value
+	}
+	return item
+}
+
+// fillStruct 把 map 数据填充到结构体
+func (h *XmlConfigHandler) fillStruct(obj reflect.Value, item map[string]interface{}) {
+	typ := obj.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		fieldType := typ.Field(i)
+		field := obj.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		value, ok := lookupFieldValue(fieldType, item)
+		if !ok {
+			continue
+		}
+		h.setFieldValue(field, value)
+	}
+}
+
+// lookupFieldValue 按标签优先级在 map 中查找字段对应的值
+func lookupFieldValue(field reflect.StructField, item map[string]interface{}) (interface{}, bool) {
+	candidates := convert.ColumnAliases(field)
+	if xmlTag := strings.Split(field.Tag.Get("xml"), ",")[0]; xmlTag != "" && xmlTag != "-" {
+		candidates = append(candidates, xmlTag)
+	}
+	if jsonTag := strings.Split(field.Tag.Get("json"), ",")[0]; jsonTag != "" && jsonTag != "-" {
+		candidates = append(candidates, jsonTag)
+	}
+	candidates = append(candidates, field.Name)
+
+	for _, name := range candidates {
+		if v, ok := item[name]; ok {
+			return v, true
+		}
+	}
+	for _, name := range candidates {
+		for k, v := range item {
+			if strings.EqualFold(k, name) {
+				return v, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// setFieldValue 设置字段值，支持字符串、嵌套 map 和重复节点列表
+func (h *XmlConfigHandler) setFieldValue(field reflect.Value, value interface{}) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		h.setFieldValue(field.Elem(), value)
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if field.Kind() == reflect.Struct {
+			h.fillStruct(field, v)
+		}
+		return
+	case []interface{}:
+		if field.Kind() != reflect.Slice {
+			return
+		}
+		result := reflect.MakeSlice(field.Type(), 0, len(v))
+		for _, elemValue := range v {
+			elem := reflect.New(field.Type().Elem()).Elem()
+			h.setFieldValue(elem, elemValue)
+			result = reflect.Append(result, elem)
+		}
+		field.Set(result)
+		return
+	case string:
+		h.setStringValue(field, v)
+	}
+}
+
+// setStringValue 将字符串转换为目标字段类型
+func (h *XmlConfigHandler) setStringValue(field reflect.Value, value string) {
+	value = strings.TrimSpace(value)
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if intVal, err := strconv.ParseInt(value, 10, 64); err == nil {
+			field.SetInt(intVal)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if uintVal, err := strconv.ParseUint(value, 10, 64); err == nil {
+			field.SetUint(uintVal)
+		}
+	case reflect.Float32, reflect.Float64:
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			field.SetFloat(floatVal)
+		}
+	case reflect.Bool:
+		if boolVal, err := convert.ParseBool(value); err == nil {
+			field.SetBool(boolVal)
+		}
+	case reflect.Slice:
+		// 单个节点或逗号分隔的文本
+		if value == "" {
+			field.Set(reflect.MakeSlice(field.Type(), 0, 0))
+			return
+		}
+		parts := strings.Split(value, ",")
+		result := reflect.MakeSlice(field.Type(), 0, len(parts))
+		for _, part := range parts {
+			elem := reflect.New(field.Type().Elem()).Elem()
+			h.setStringValue(elem, part)
+			result = reflect.Append(result, elem)
+		}
+		field.Set(result)
+	}
+}
@@ -0,0 +1,258 @@
+package yaml
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/internal/convert"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YamlConfigHandler YAML 配置处理器
+// 负责处理 YAML 格式的配置文件（.yaml / .yml），读取并解析为配置对象
+// 顶层支持数组（一组配置项）和单个对象（一条配置项）两种形式
+type YamlConfigHandler struct{}
+
+// TypeName 返回处理器类型名
+// 返回值:
+//
+//	string: "yaml"
+func (h *YamlConfigHandler) TypeName() string {
+	return "yaml"
+}
+
+// ReadToFrontEndDataList 读取配置并转为前端数据列表
+// 读取 YAML 配置文件并转换为前端可用的数据传输对象
+// 空文件或只有注释的文件返回 DataList 为 nil 的传输对象
+// 参数:
+//
+//	configName: 配置名称
+//	configFileFullPath: YAML 配置文件的完整路径
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 文件读取或解析失败时返回错误
+func (h *YamlConfigHandler) ReadToFrontEndDataList(configName, configFileFullPath string) (interface{}, error) {
+	data, err := readYamlFile(configName, configFileFullPath)
+	if err != nil {
+		return nil, err
+	}
+	return h.parseFrontEndDataList(configName, configFileFullPath, data)
+}
+
+// ReadToFrontEndDataListFromBytes 从内存中的 YAML 内容读取配置并转为前端数据列表
+// 用于 embed.FS 等无法直接按路径读取文件的场景
+// 参数:
+//
+//	configName: 配置名称
+//	data: YAML 文件内容
+//
+// 返回值:
+//
+//	interface{}: 包含解析后数据的传输对象
+//	error: 解析失败时返回错误
+func (h *YamlConfigHandler) ReadToFrontEndDataListFromBytes(configName string, data []byte) (interface{}, error) {
+	return h.parseFrontEndDataList(configName, bytesSource, data)
+}
+
+// parseFrontEndDataList 将 YAML 内容解析为前端数据传输对象，configFileFullPath 仅用于错误信息
+func (h *YamlConfigHandler) parseFrontEndDataList(configName, configFileFullPath string, data []byte) (interface{}, error) {
+	result := &dto.FrontEndConfigDto{
+		DataList:         nil,
+		Type:             h.TypeName(),
+		Suffix:           "yaml",
+		ConfigNameSimple: configName,
+	}
+
+	root, err := parseYamlRoot(configName, configFileFullPath, data)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return result, nil
+	}
+
+	items, err := yamlItemNodes(configName, configFileFullPath, root)
+	if err != nil {
+		return nil, err
+	}
+
+	dataList := make([]map[string]interface{}, 0, len(items))
+	for i, node := range items {
+		var item map[string]interface{}
+		if err := node.Decode(&item); err != nil {
+			return nil, fmt.Errorf("parse yaml config %q (%s) item %d failed: %w", configName, configFileFullPath, i, err)
+		}
+		if item == nil {
+			continue
+		}
+		dataList = append(dataList, item)
+	}
+	if len(dataList) > 0 {
+		result.DataList = dataList
+	}
+
+	return result, nil
+}
+
+// ReadConfigAndORM 读取配置并转换为对象列表
+// 使用 yaml.v3 的反射解析将每个配置项解码为 typ 类型
+// 字段带有 config233_column 标签时，按标签值（逗号分隔的多个别名任一）匹配 YAML 中的 key
+// 参数:
+//
+//	typ: 目标配置对象的类型
+//	configName: 配置名称
+//	configFileFullPath: YAML 配置文件的完整路径
+//
+// 返回值:
+//
+//	[]interface{}: 配置对象实例列表
+//	error: 文件读取或解析失败时返回错误
+func (h *YamlConfigHandler) ReadConfigAndORM(typ reflect.Type, configName, configFileFullPath string) ([]interface{}, error) {
+	data, err := readYamlFile(configName, configFileFullPath)
+	if err != nil {
+		return nil, err
+	}
+	return h.parseConfigAndORM(typ, configName, configFileFullPath, data)
+}
+
+// ReadConfigAndORMFromBytes 从内存中的 YAML 内容读取配置并转换为对象列表
+// 参数:
+//
+//	typ: 目标配置对象的类型
+//	configName: 配置名称
+//	data: YAML 文件内容
+//
+// 返回值:
+//
+//	[]interface{}: 配置对象实例列表
+//	error: 解析失败时返回错误
+func (h *YamlConfigHandler) ReadConfigAndORMFromBytes(typ reflect.Type, configName string, data []byte) ([]interface{}, error) {
+	return h.parseConfigAndORM(typ, configName, bytesSource, data)
+}
+
+// parseConfigAndORM 将 YAML 内容解析为 typ 类型的对象列表，configFileFullPath 仅用于错误信息
+func (h *YamlConfigHandler) parseConfigAndORM(typ reflect.Type, configName, configFileFullPath string, data []byte) ([]interface{}, error) {
+	root, err := parseYamlRoot(configName, configFileFullPath, data)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, nil
+	}
+
+	items, err := yamlItemNodes(configName, configFileFullPath, root)
+	if err != nil {
+		return nil, err
+	}
+
+	columnToKey := buildColumnKeyMapping(typ)
+
+	result := make([]interface{}, 0, len(items))
+	for i, node := range items {
+		renameMappingKeys(node, columnToKey)
+
+		instancePtr := reflect.New(typ)
+		if err := node.Decode(instancePtr.Interface()); err != nil {
+			return nil, fmt.Errorf("parse yaml config %q (%s) item %d into %s failed: %w", configName, configFileFullPath, i, typ.String(), err)
+		}
+		result = append(result, instancePtr.Elem().Interface())
+	}
+
+	return result, nil
+}
+
+// bytesSource 从内存内容解析时错误信息中代替文件路径的占位符
+const bytesSource = "<bytes>"
+
+// readYamlFile 读取 YAML 文件内容
+func readYamlFile(configName, configFileFullPath string) ([]byte, error) {
+	data, err := os.ReadFile(configFileFullPath)
+	if err != nil {
+		return nil, fmt.Errorf("read yaml config %q (%s) failed: %w", configName, configFileFullPath, err)
+	}
+	return data, nil
+}
+
+// parseYamlRoot 解析 YAML 内容并返回文档的根内容节点
+// 空文件或只有注释的文件返回 nil
+func parseYamlRoot(configName, configFileFullPath string, data []byte) (*yaml.Node, error) {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse yaml config %q (%s) failed: %w", configName, configFileFullPath, err)
+	}
+
+	// 只有注释的文件解析后没有任何内容节点
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind == yaml.ScalarNode && root.Tag == "!!null" {
+		return nil, nil
+	}
+	return root, nil
+}
+
+// yamlItemNodes 将根节点拆分为配置项节点列表
+// 顶层为数组时每个元素一条配置，顶层为对象时整体作为一条配置
+func yamlItemNodes(configName, configFileFullPath string, root *yaml.Node) ([]*yaml.Node, error) {
+	switch root.Kind {
+	case yaml.SequenceNode:
+		return root.Content, nil
+	case yaml.MappingNode:
+		return []*yaml.Node{root}, nil
+	default:
+		return nil, fmt.Errorf("yaml config %q (%s) must be a sequence or mapping at top level, got %s", configName, configFileFullPath, root.Tag)
+	}
+}
+
+// buildColumnKeyMapping 构建 config233_column 标签值到 yaml.v3 期望 key 的映射
+// yaml.v3 默认使用 yaml 标签，没有标签时使用字段名的全小写形式
+func buildColumnKeyMapping(typ reflect.Type) map[string]string {
+	mapping := make(map[string]string)
+	if typ.Kind() != reflect.Struct {
+		return mapping
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		aliases := convert.ColumnAliases(field)
+		if len(aliases) == 0 {
+			continue
+		}
+
+		yamlKey := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if yamlKey == "-" {
+			continue
+		}
+		if yamlKey == "" {
+			yamlKey = strings.ToLower(field.Name)
+		}
+		for _, alias := range aliases {
+			mapping[alias] = yamlKey
+		}
+	}
+	return mapping
+}
+
+// renameMappingKeys 在解码前把对象节点中的列名 key 替换为结构体字段对应的 yaml key
+func renameMappingKeys(node *yaml.Node, columnToKey map[string]string) {
+	if node.Kind != yaml.MappingNode || len(columnToKey) == 0 {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		if yamlKey, ok := columnToKey[keyNode.Value]; ok {
+			keyNode.Value = yamlKey
+		}
+	}
+}
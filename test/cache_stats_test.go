@@ -0,0 +1,82 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// CacheStatConfig 用于测试缓存统计的配置
+type CacheStatConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// CacheStatMissingConfig 未提供配置文件的类型
+type CacheStatMissingConfig struct {
+	Id int `json:"id"`
+}
+
+// TestCacheStats_HitsAndMisses 测试读取接口按是否命中缓存计数，加载时按配置计重建次数
+func TestCacheStats_HitsAndMisses(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "CacheStatConfig.json", `[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[CacheStatConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if stat := manager.GetCacheStats(); stat.Rebuilds != 1 || stat.Hits != 0 || stat.Misses != 0 {
+		t.Fatalf("加载一个配置后应重建一次且无读取记录: %+v", stat)
+	}
+
+	config233.GetConfigById[CacheStatConfig](1)
+	config233.GetConfigList[CacheStatConfig]()
+	config233.GetConfigMap[CacheStatConfig]()
+	config233.GetConfigById[CacheStatConfig](99)      // ID 不存在
+	config233.GetConfigList[CacheStatMissingConfig]() // 配置未加载
+
+	want := config233.CacheStat{Hits: 3, Misses: 2, Rebuilds: 1}
+	if stat := manager.GetCacheStats(); stat != want {
+		t.Errorf("期望 %+v，实际 %+v", want, stat)
+	}
+
+	manager.ResetCacheStats()
+	if stat := manager.GetCacheStats(); stat != (config233.CacheStat{}) {
+		t.Errorf("ResetCacheStats 后应清零: %+v", stat)
+	}
+}
+
+// TestCacheStats_InvalidateCache 测试主动失效缓存后按已提交数据重建，未加载的配置返回 false
+func TestCacheStats_InvalidateCache(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "CacheStatConfig.json", `[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[CacheStatConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	before := config233.GetConfigList[CacheStatConfig]()
+
+	if !manager.InvalidateCache("CacheStatConfig") {
+		t.Fatal("已加载的配置应返回 true")
+	}
+	after := config233.GetConfigList[CacheStatConfig]()
+	if len(after) != 2 || after[0] != before[0] || after[1] != before[1] {
+		t.Errorf("重建后应保持原有数据与顺序: %+v", after)
+	}
+	if item, ok := config233.GetConfigById[CacheStatConfig](2); !ok || item.Name != "b" {
+		t.Errorf("重建后应能按 ID 读取: %+v", item)
+	}
+
+	if manager.InvalidateCache("MissingConfig") {
+		t.Error("未加载的配置应返回 false")
+	}
+	if stat := manager.GetCacheStats(); stat.Rebuilds != 2 || stat.Invalidations != 2 {
+		t.Errorf("期望重建 2 次、失效 2 次，实际 %+v", stat)
+	}
+}
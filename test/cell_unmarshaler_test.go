@@ -0,0 +1,144 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// Vector3 坐标，单元格写法为 "x,y,z"
+type Vector3 struct {
+	X, Y, Z float64
+}
+
+// UnmarshalConfigCell 解析 "x,y,z"
+func (v *Vector3) UnmarshalConfigCell(raw string) error {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 3 {
+		return fmt.Errorf("坐标需要 3 个分量: %q", raw)
+	}
+	values := make([]float64, 3)
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return err
+		}
+		values[i] = f
+	}
+	v.X, v.Y, v.Z = values[0], values[1], values[2]
+	return nil
+}
+
+// Color 颜色，单元格写法为 "#RRGGBB"
+type Color struct {
+	R, G, B uint8
+}
+
+// UnmarshalConfigCell 解析 "#RRGGBB"
+func (c *Color) UnmarshalConfigCell(raw string) error {
+	raw = strings.TrimPrefix(strings.TrimSpace(raw), "#")
+	rgb, err := strconv.ParseUint(raw, 16, 32)
+	if err != nil || len(raw) != 6 {
+		return fmt.Errorf("非法的颜色: %q", raw)
+	}
+	c.R, c.G, c.B = uint8(rgb>>16), uint8(rgb>>8), uint8(rgb)
+	return nil
+}
+
+// DecoderConfig 含自定义解码字段的配置
+type DecoderConfig struct {
+	Id    int      `json:"id"`
+	Pos   Vector3  `json:"pos"`
+	Color *Color   `json:"color"`
+	Spawn *Vector3 `json:"spawn"`
+}
+
+var (
+	wantPos   = Vector3{X: 1, Y: 2.5, Z: -3}
+	wantColor = Color{R: 0xFF, G: 0xAA, B: 0x00}
+)
+
+// checkDecoderConfig 校验自定义解码结果：第一条全部合法，第二条解码失败与空单元格保持零值
+func checkDecoderConfig(t *testing.T, first, second DecoderConfig) {
+	t.Helper()
+	if first.Pos != wantPos {
+		t.Errorf("坐标应由 UnmarshalConfigCell 解析: %+v", first.Pos)
+	}
+	if first.Color == nil || *first.Color != wantColor {
+		t.Errorf("指针字段应由 UnmarshalConfigCell 解析: %+v", first.Color)
+	}
+	if first.Spawn != nil {
+		t.Errorf("空单元格不应调用解码器，指针字段应保持 nil: %+v", first.Spawn)
+	}
+	if second.Pos != (Vector3{}) || second.Color != nil {
+		t.Errorf("解码失败时字段应保持零值: %+v", second)
+	}
+}
+
+// TestCellUnmarshaler_Excel 测试 Excel 转换路径调用自定义解码器
+func TestCellUnmarshaler_Excel(t *testing.T) {
+	path := createExcelWithRows(t, "DecoderConfig.xlsx", [][]interface{}{
+		{"Client", "id", "pos", "color", "spawn"},
+		{"type", "int", "string", "string", "string"},
+		{"Server", "id", "pos", "color", "spawn"},
+		{"", 1, "1,2.5,-3", "#FFAA00", ""},
+		{"", 2, "1,2", "red", ""},
+	})
+
+	handler := &excel.ExcelConfigHandler{}
+	var list []interface{}
+	captureStdout(t, func() {
+		list, _ = handler.ReadConfigAndORM(reflect.TypeOf(DecoderConfig{}), "DecoderConfig", path)
+	})
+	if len(list) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(list))
+	}
+	checkDecoderConfig(t, list[0].(DecoderConfig), list[1].(DecoderConfig))
+}
+
+// TestCellUnmarshaler_Tsv 测试 TSV 转换路径调用自定义解码器，解码失败返回转换错误
+func TestCellUnmarshaler_Tsv(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "DecoderConfig.tsv",
+		"Id\tPos\tColor\tSpawn\n1\t1,2.5,-3\t#FFAA00\t\n2\t1,2\tred\t\n")
+
+	handler := &tsv.TsvConfigHandler{}
+	var list []interface{}
+	var err error
+	captureStdout(t, func() {
+		list, err = handler.ReadConfigAndORM(reflect.TypeOf(DecoderConfig{}), "DecoderConfig", path)
+	})
+	if err == nil || !strings.Contains(err.Error(), "坐标需要 3 个分量") {
+		t.Errorf("解码失败应作为转换错误返回: %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(list))
+	}
+	checkDecoderConfig(t, list[0].(DecoderConfig), list[1].(DecoderConfig))
+}
+
+// TestCellUnmarshaler_ConfigManagerJson 测试 ConfigManager233 的 JSON 转换路径调用自定义解码器
+func TestCellUnmarshaler_ConfigManagerJson(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "DecoderConfig.json",
+		`[{"id": 1, "pos": "1,2.5,-3", "color": "#FFAA00", "spawn": ""}, {"id": 2, "pos": "1,2", "color": "red"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[DecoderConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	first, ok1 := config233.GetConfigById[DecoderConfig](1)
+	second, ok2 := config233.GetConfigById[DecoderConfig](2)
+	if !ok1 || !ok2 {
+		t.Fatal("配置应被加载")
+	}
+	checkDecoderConfig(t, *first, *second)
+}
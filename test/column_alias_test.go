@@ -0,0 +1,63 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// ColumnAliasConfig 用于测试列名别名与大小写匹配的结构体
+type ColumnAliasConfig struct {
+	Id     int
+	ItemId int    `config233_column:"itemId,item_id"`
+	Name   string `config233_column:"name,displayName"`
+	Count  int
+}
+
+// TestColumnAlias_Tsv 测试 TSV 表头不区分大小写，且逗号分隔的任一别名都能对应字段
+func TestColumnAlias_Tsv(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "ColumnAliasConfig.tsv",
+		"ID\titem_id\tDisplayName\tCOUNT\n1\t1001\tsword\t3\n")
+
+	handler := &tsv.TsvConfigHandler{}
+	list := mustReadORM(t, handler, reflect.TypeOf(ColumnAliasConfig{}), "ColumnAliasConfig", path)
+	want := ColumnAliasConfig{Id: 1, ItemId: 1001, Name: "sword", Count: 3}
+	if len(list) != 1 || list[0].(ColumnAliasConfig) != want {
+		t.Errorf("期望 %+v，实际 %+v", want, list)
+	}
+}
+
+// TestColumnAlias_Excel 测试 Excel 表头使用别名与不同大小写时仍能映射到字段
+func TestColumnAlias_Excel(t *testing.T) {
+	path := createExcelWithRows(t, "ColumnAliasConfig.xlsx", [][]interface{}{
+		{"注释", "ID", "道具", "名称", "数量"},
+		{"", "ID", "道具", "名称", "数量"},
+		{"CLIENT", "id", "ItemID", "name", "count"},
+		{"type", "int", "int", "string", "int"},
+		{"SERVER", "Id", "ITEM_ID", "displayname", "Count"},
+		{"", 1, 1001, "sword", 3},
+	})
+
+	handler := &excel.ExcelConfigHandler{}
+	list := mustReadORM(t, handler, reflect.TypeOf(ColumnAliasConfig{}), "ColumnAliasConfig", path)
+	want := ColumnAliasConfig{Id: 1, ItemId: 1001, Name: "sword", Count: 3}
+	if len(list) != 1 || list[0].(ColumnAliasConfig) != want {
+		t.Errorf("期望 %+v，实际 %+v", want, list)
+	}
+
+	// 通过 ConfigManager233 加载时同样生效
+	manager := config233.NewConfigManager233(filepath.Dir(path))
+	config233.Instance = manager
+	config233.RegisterType[ColumnAliasConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	loaded := config233.GetConfigList[ColumnAliasConfig]()
+	if len(loaded) != 1 || *loaded[0] != want {
+		t.Errorf("管理器加载结果错误，期望 %+v，实际 %+v", want, loaded)
+	}
+}
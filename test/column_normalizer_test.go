@@ -0,0 +1,76 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// NormalizedItemConfig 表头为中文的配置
+type NormalizedItemConfig struct {
+	Id    int
+	Name  string
+	Count int
+}
+
+// chineseColumnNormalizer 去掉空格后按对照表把中文列名转为字段名，没有对照的列原样返回
+func chineseColumnNormalizer(raw string) string {
+	names := map[string]string{"物品ID": "Id", "名称": "Name", "数量": "Count"}
+	raw = strings.ReplaceAll(raw, " ", "")
+	if name, ok := names[raw]; ok {
+		return name
+	}
+	return raw
+}
+
+// TestColumnNameNormalizer_Excel 测试自定义 normalizer 下 Excel 中文表头映射到字段
+func TestColumnNameNormalizer_Excel(t *testing.T) {
+	path := createExcelWithRows(t, "NormalizedItemConfig.xlsx", [][]interface{}{
+		{"物品 ID", "名称", "数量", "备注"},
+		{1, "sword", 3, "x"},
+		{2, "bow", 5, "y"},
+	})
+
+	manager := config233.NewConfigManager233(filepath.Dir(path))
+	config233.Instance = manager
+	config233.RegisterType[NormalizedItemConfig]()
+	manager.SetColumnNameNormalizer(chineseColumnNormalizer)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	item, ok := config233.GetConfigById[NormalizedItemConfig](2)
+	if !ok || item.Name != "bow" || item.Count != 5 {
+		t.Errorf("中文表头应通过 normalizer 映射到字段，并按 Id 建立索引: %+v", item)
+	}
+}
+
+// TestColumnNameNormalizer_Tsv 测试 TSV 处理器的 ColumnNameNormalizer，返回空字符串的列被忽略
+func TestColumnNameNormalizer_Tsv(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "NormalizedItemConfig.tsv",
+		"物品 ID\t 名称 \t数量\t备注\n1\tsword\t3\tx\n")
+
+	handler := &tsv.TsvConfigHandler{ColumnNameNormalizer: func(raw string) string {
+		if raw == "备注" {
+			return ""
+		}
+		return chineseColumnNormalizer(raw)
+	}}
+	result := mustReadDataList(t, handler, "NormalizedItemConfig", path)
+	if len(result.DataList) != 1 || result.DataList[0]["Name"] != "sword" {
+		t.Fatalf("DataList 的 key 应为转换后的列名: %v", result.DataList)
+	}
+	if _, ok := result.DataList[0]["备注"]; ok || len(result.DataList[0]) != 3 {
+		t.Errorf("normalizer 返回空字符串的列应被忽略: %v", result.DataList[0])
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(NormalizedItemConfig{}), "NormalizedItemConfig", path)
+	want := NormalizedItemConfig{Id: 1, Name: "sword", Count: 3}
+	if len(list) != 1 || list[0].(NormalizedItemConfig) != want {
+		t.Errorf("期望 %+v，实际 %+v", want, list)
+	}
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+)
+
+// TestConfig233_Stop 测试停止监听后不再响应文件变化，且重复调用安全
+func TestConfig233_Stop(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "HotUpdateMethodConfig.json", `[{"id": 1, "name": "v1"}]`)
+
+	typ := reflect.TypeOf(HotUpdateMethodConfig{})
+	cfg := config233.NewConfig233().
+		Directory(tempDir).
+		AddConfigHandler("json", &json.JsonConfigHandler{}).
+		RegisterConfigClass("HotUpdateMethodConfig", typ).
+		Start()
+
+	var funcCount atomic.Int32
+	cfg.RegisterHotUpdateFunc(typ, func() {
+		funcCount.Add(1)
+	})
+	holder := &hotUpdateMethodHolder{}
+	cfg.RegisterForHotUpdate(holder)
+
+	// 停止前文件变化会触发监听器
+	path := filepath.Join(tempDir, "HotUpdateMethodConfig.json")
+	if err := os.WriteFile(path, []byte(`[{"id": 1, "name": "v2"}]`), 0644); err != nil {
+		t.Fatalf("写入配置文件失败: %v", err)
+	}
+	if !waitUntil(3*time.Second, func() bool { return funcCount.Load() > 0 }) {
+		t.Fatal("停止前文件变化应触发热更新回调")
+	}
+
+	if err := cfg.Stop(); err != nil {
+		t.Fatalf("停止监听失败: %v", err)
+	}
+	if err := cfg.Stop(); err != nil {
+		t.Errorf("重复停止应是安全的: %v", err)
+	}
+
+	// 等待已触发的事件处理完毕后再计数
+	time.Sleep(100 * time.Millisecond)
+	before := funcCount.Load()
+	if err := os.WriteFile(path, []byte(`[{"id": 1, "name": "v3"}]`), 0644); err != nil {
+		t.Fatalf("写入配置文件失败: %v", err)
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	if funcCount.Load() != before {
+		t.Errorf("停止后不应再触发热更新回调: before=%d, after=%d", before, funcCount.Load())
+	}
+	if name, _ := holder.lastInjectedName.Load().(string); name == "v3" {
+		t.Error("停止后不应重新加载配置")
+	}
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// CopyItemConfig 用于测试配置副本的结构体
+type CopyItemConfig struct {
+	Id     int               `json:"id"`
+	Name   string            `json:"name"`
+	Tags   []string          `json:"tags"`
+	Attrs  map[string]string `json:"attrs"`
+	Weight *int              `json:"weight"`
+	Reward CopyReward        `json:"reward"`
+}
+
+// CopyReward 嵌套结构体
+type CopyReward struct {
+	Gold  int   `json:"gold"`
+	Items []int `json:"items"`
+}
+
+// TestGetConfigByIdCopy 测试修改返回的副本（包括切片、map 与指针指向的数据）不影响下次查询
+func TestGetConfigByIdCopy(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "CopyItemConfig.json", `[{
+		"id": 1, "name": "sword", "tags": "a,b", "attrs": "{\"atk\": \"10\"}", "weight": 5,
+		"reward": {"gold": 100, "items": "1,2"}
+	}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[CopyItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	copied, ok := config233.GetConfigByIdCopy[CopyItemConfig](1)
+	if !ok {
+		t.Fatal("配置 1 应存在")
+	}
+	if copied.Name != "sword" || len(copied.Tags) != 2 || copied.Attrs["atk"] != "10" ||
+		copied.Weight == nil || *copied.Weight != 5 || copied.Reward.Gold != 100 || len(copied.Reward.Items) != 2 {
+		t.Fatalf("副本内容应与配置一致: %+v", copied)
+	}
+
+	copied.Name = "changed"
+	copied.Tags[0] = "changed"
+	copied.Attrs["atk"] = "999"
+	*copied.Weight = 99
+	copied.Reward.Gold = 0
+	copied.Reward.Items[0] = 42
+
+	cfg, _ := config233.GetConfigById[CopyItemConfig](1)
+	if cfg.Name != "sword" || cfg.Tags[0] != "a" || cfg.Attrs["atk"] != "10" ||
+		*cfg.Weight != 5 || cfg.Reward.Gold != 100 || cfg.Reward.Items[0] != 1 {
+		t.Errorf("修改副本不应影响缓存中的配置: %+v", cfg)
+	}
+	if again, _ := config233.GetConfigByIdCopy[CopyItemConfig](1); again.Name != "sword" || again.Tags[0] != "a" {
+		t.Errorf("再次获取的副本应为原始数据: %+v", again)
+	}
+
+	if _, ok := config233.GetConfigByIdCopyFrom[CopyItemConfig](manager, 404); ok {
+		t.Error("不存在的 ID 应返回 false")
+	}
+}
+
+// TestGetConfigByIdCopy_RefShared 测试 config233_ref 引用字段在副本中仍指向共享的被引用配置
+func TestGetConfigByIdCopy_RefShared(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "RefItemConfig.json", `[{"id": 1, "name": "sword", "skillId": 101}]`)
+	writeTextFile(t, tempDir, "RefSkillConfig.json", `[{"id": 101, "power": 10, "itemId": 1}]`)
+
+	manager := loadRefConfigs(t, tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	copied, ok := config233.GetConfigByIdCopy[RefItemConfig](1)
+	skill, _ := config233.GetConfigById[RefSkillConfig](101)
+	if !ok || copied.Skill != skill {
+		t.Errorf("引用字段应指向共享的被引用配置: %+v", copied)
+	}
+}
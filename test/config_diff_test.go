@@ -0,0 +1,122 @@
+package test
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// DiffReward 嵌套结构体字段
+type DiffReward struct {
+	Gold int
+	Exp  int
+}
+
+// DiffItemConfig 用于 diff 测试的配置
+type DiffItemConfig struct {
+	Id     int            `json:"id"`
+	Name   string         `json:"name"`
+	Tags   []string       `json:"tags"`
+	Attrs  map[string]int `json:"attrs"`
+	Reward DiffReward     `json:"reward"`
+}
+
+func diffItemId(item *DiffItemConfig) string {
+	return strconv.Itoa(item.Id)
+}
+
+// TestDiffConfig_AddedRemovedModified 测试新增、删除与修改三种情形
+func TestDiffConfig_AddedRemovedModified(t *testing.T) {
+	oldList := []*DiffItemConfig{
+		{Id: 1, Name: "Sword", Tags: []string{"a"}, Attrs: map[string]int{"atk": 1}},
+		{Id: 2, Name: "Axe"},
+		{Id: 4, Name: "Shield", Tags: nil, Attrs: map[string]int{}},
+	}
+	newList := []*DiffItemConfig{
+		{Id: 1, Name: "Blade", Tags: []string{"a", "b"}, Attrs: map[string]int{"atk": 2}, Reward: DiffReward{Gold: 10}},
+		{Id: 3, Name: "Bow"},
+		{Id: 4, Name: "Shield", Tags: []string{}, Attrs: nil}, // nil 与空切片/map 视为相同
+	}
+
+	diff := config233.DiffConfig(oldList, newList, diffItemId)
+	if len(diff.Added) != 1 || diff.Added[0] != newList[1] {
+		t.Errorf("应新增 ID 3: %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0] != oldList[1] {
+		t.Errorf("应删除 ID 2: %+v", diff.Removed)
+	}
+	if len(diff.Modified) != 1 {
+		t.Fatalf("应只修改 ID 1，实际 %d 条: %+v", len(diff.Modified), diff.Modified)
+	}
+
+	change := diff.Modified[0]
+	if change.Id != "1" || change.Old != oldList[0] || change.New != newList[0] {
+		t.Errorf("修改项不符合预期: %+v", change)
+	}
+	want := []config233.FieldDiff{
+		{Field: "Name", Old: "Sword", New: "Blade"},
+		{Field: "Tags", Old: []string{"a"}, New: []string{"a", "b"}},
+		{Field: "Attrs", Old: map[string]int{"atk": 1}, New: map[string]int{"atk": 2}},
+		{Field: "Reward.Gold", Old: 0, New: 10},
+	}
+	if !reflect.DeepEqual(change.Fields, want) {
+		t.Errorf("字段级差异不符合预期:\n got: %+v\nwant: %+v", change.Fields, want)
+	}
+
+	log := diff.Format(diffItemId)
+	for _, line := range []string{"+ 3\n", "- 2\n", `~ 1 Name: "Sword" -> "Blade"; Tags: []string{"a"} -> []string{"a", "b"}`} {
+		if !strings.Contains(log, line) {
+			t.Errorf("变更日志应包含 %q:\n%s", line, log)
+		}
+	}
+}
+
+// TestDiffConfig_Identical 测试内容相同的两版配置没有差异
+func TestDiffConfig_Identical(t *testing.T) {
+	oldList := []*DiffItemConfig{{Id: 1, Name: "Sword", Tags: []string{"a"}}}
+	newList := []*DiffItemConfig{{Id: 1, Name: "Sword", Tags: []string{"a"}}}
+
+	diff := config233.DiffConfig(oldList, newList, diffItemId)
+	if !diff.IsEmpty() || diff.String() != "" {
+		t.Errorf("内容相同时不应有差异: %+v", diff)
+	}
+}
+
+// TestDiffConfig_WithSnapshot 测试热更新后用快照中的旧版配置输出 diff
+func TestDiffConfig_WithSnapshot(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "DiffItemConfig.json", `[{"id": 1, "name": "Sword"}, {"id": 2, "name": "Axe"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[DiffItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	snapshots := manager.ListSnapshots()
+	if len(snapshots) == 0 {
+		t.Fatal("加载后应保存快照")
+	}
+	before := snapshots[len(snapshots)-1].Id
+
+	writeTextFile(t, tempDir, "DiffItemConfig.json", `[{"id": 1, "name": "Blade"}, {"id": 3, "name": "Bow"}]`)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+
+	oldList, ok := config233.GetSnapshotConfigListFrom[DiffItemConfig](manager, before)
+	if !ok || len(oldList) != 2 {
+		t.Fatalf("应能从快照读取旧版配置: %v, %d", ok, len(oldList))
+	}
+	diff := config233.DiffConfig(oldList, config233.GetConfigListFrom[DiffItemConfig](manager), diffItemId)
+	if len(diff.Added) != 1 || len(diff.Removed) != 1 || len(diff.Modified) != 1 || diff.Modified[0].Fields[0].Field != "Name" {
+		t.Errorf("与快照的 diff 不符合预期:\n%s", diff.Format(diffItemId))
+	}
+
+	if _, ok := config233.GetSnapshotConfigListFrom[DiffItemConfig](manager, -1); ok {
+		t.Error("不存在的快照应返回 false")
+	}
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// setupConfigGroupDir 创建按业务域分目录的配置：combat（含子目录 skill）、economy 与根目录
+func setupConfigGroupDir(t *testing.T) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	for _, dir := range []string{"combat/skill", "economy"} {
+		if err := os.MkdirAll(filepath.Join(tempDir, dir), 0755); err != nil {
+			t.Fatalf("创建分组目录失败: %v", err)
+		}
+	}
+	writeTextFile(t, filepath.Join(tempDir, "combat"), "GroupMonsterConfig.json", `[{"id": 1}]`)
+	writeTextFile(t, filepath.Join(tempDir, "combat", "skill"), "GroupSkillConfig.json", `[{"id": 1}]`)
+	writeTextFile(t, filepath.Join(tempDir, "economy"), "GroupShopConfig.json", `[{"id": 1}]`)
+	writeTextFile(t, tempDir, "GroupGlobalConfig.json", `[{"id": 1}]`)
+	return tempDir
+}
+
+// TestLoadConfigGroup 测试只加载某分组（含子目录）的配置
+func TestLoadConfigGroup(t *testing.T) {
+	tempDir := setupConfigGroupDir(t)
+	manager := config233.NewConfigManager233(tempDir)
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+
+	if err := manager.LoadConfigGroup("combat"); err != nil {
+		t.Fatalf("加载分组失败: %v", err)
+	}
+	want := []string{"GroupMonsterConfig", "GroupSkillConfig"}
+	got := manager.GetLoadedConfigNames()
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("只应加载 combat 分组的配置，实际 %v", got)
+	}
+	if batches := recorder.snapshot(); len(batches) != 1 || len(batches[0]) != 2 {
+		t.Errorf("应只对分组内的配置通知一次，实际 %v", batches)
+	}
+
+	if err := manager.LoadConfigGroup("./economy/"); err != nil {
+		t.Fatalf("加载分组失败: %v", err)
+	}
+	if got := manager.GetLoadedConfigNamesByGroup("economy"); !reflect.DeepEqual(got, []string{"GroupShopConfig"}) {
+		t.Errorf("economy 分组的配置不符合预期: %v", got)
+	}
+	if got := manager.GetLoadedConfigNamesByGroup("combat"); !reflect.DeepEqual(got, want) {
+		t.Errorf("加载其他分组不应影响已加载的分组: %v", got)
+	}
+	if got := manager.GetLoadedConfigNamesByGroup(""); len(got) != 3 {
+		t.Errorf("根目录的配置尚未加载，实际 %v", got)
+	}
+
+	if err := manager.LoadConfigGroup("missing"); err == nil {
+		t.Error("分组下没有配置文件时应返回错误")
+	}
+	if err := manager.LoadConfigGroup("../outside"); err == nil {
+		t.Error("跳出配置目录的分组应返回错误")
+	}
+}
+
+// TestLoadConfigGroup_Reload 测试重载分组：更新已有配置、移除文件已删除的配置
+func TestLoadConfigGroup_Reload(t *testing.T) {
+	tempDir := setupConfigGroupDir(t)
+	manager := config233.NewConfigManager233(tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if got := manager.GetLoadedConfigNamesByGroup("combat/skill"); !reflect.DeepEqual(got, []string{"GroupSkillConfig"}) {
+		t.Errorf("全量加载后应能按子分组查询: %v", got)
+	}
+
+	writeTextFile(t, filepath.Join(tempDir, "combat"), "GroupMonsterConfig.json", `[{"id": 1}, {"id": 2}]`)
+	if err := os.Remove(filepath.Join(tempDir, "combat", "skill", "GroupSkillConfig.json")); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+	if err := manager.LoadConfigGroup("combat"); err != nil {
+		t.Fatalf("重载分组失败: %v", err)
+	}
+
+	if got := manager.GetLoadedConfigNamesByGroup("combat"); !reflect.DeepEqual(got, []string{"GroupMonsterConfig"}) {
+		t.Errorf("文件已删除的配置应被移除: %v", got)
+	}
+	if got := manager.GetConfigCount("GroupMonsterConfig"); got != 2 {
+		t.Errorf("重载后应读到 2 条配置，实际 %d 条", got)
+	}
+	if got := manager.GetLoadedConfigNamesByGroup(""); len(got) != 3 {
+		t.Errorf("其他分组的配置应保持加载: %v", got)
+	}
+}
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// IndexItemConfig 用于测试二级索引查询的结构体
+type IndexItemConfig struct {
+	Id      int    `json:"id"`
+	Type    int    `json:"type"`
+	BagType string `json:"bagType"`
+}
+
+func groupIndexItemByType(item *IndexItemConfig) int {
+	return item.Type
+}
+
+func loadIndexItemConfig(t *testing.T, dir string, content string) *config233.ConfigManager233 {
+	t.Helper()
+	writeTextFile(t, dir, "IndexItemConfig.json", content)
+
+	manager := config233.NewConfigManager233(dir)
+	config233.Instance = manager
+	config233.RegisterType[IndexItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	return manager
+}
+
+// TestGetConfigGroupBy 测试按自定义 key 分组，并在重新加载后重建缓存
+func TestGetConfigGroupBy(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := loadIndexItemConfig(t, tempDir, `[
+		{"id": 1, "type": 1, "bagType": "equip"},
+		{"id": 2, "type": 2, "bagType": "item"},
+		{"id": 3, "type": 1, "bagType": "equip"}
+	]`)
+
+	groups := config233.GetConfigGroupBy[IndexItemConfig](groupIndexItemByType)
+	if len(groups) != 2 || len(groups[1]) != 2 || len(groups[2]) != 1 {
+		t.Fatalf("分组结果错误: %v", groups)
+	}
+
+	// 再次调用应命中缓存，返回同一份分组
+	again := config233.GetConfigGroupBy[IndexItemConfig](groupIndexItemByType)
+	if len(again[1]) == 0 || again[1][0] != groups[1][0] {
+		t.Error("重复调用应复用缓存的分组")
+	}
+
+	// 重新加载后旧分组失效
+	writeTextFile(t, tempDir, "IndexItemConfig.json", `[
+		{"id": 1, "type": 3, "bagType": "equip"},
+		{"id": 2, "type": 3, "bagType": "item"}
+	]`)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+
+	reloaded := config233.GetConfigGroupBy[IndexItemConfig](groupIndexItemByType)
+	if len(reloaded) != 1 || len(reloaded[3]) != 2 {
+		t.Fatalf("重新加载后分组未重建: %v", reloaded)
+	}
+
+	if empty := config233.GetConfigGroupBy[struct{ Missing bool }](func(*struct{ Missing bool }) int { return 0 }); len(empty) != 0 {
+		t.Errorf("未加载的配置应返回空分组，实际: %v", empty)
+	}
+}
+
+// TestGetConfigByIndex 测试按字段名或 tag 名称建立索引查询
+func TestGetConfigByIndex(t *testing.T) {
+	tempDir := t.TempDir()
+	manager := loadIndexItemConfig(t, tempDir, `[
+		{"id": 1, "type": 1, "bagType": "equip"},
+		{"id": 2, "type": 2, "bagType": "item"},
+		{"id": 3, "type": 1, "bagType": "equip"}
+	]`)
+
+	if list := config233.GetConfigByIndex[IndexItemConfig]("BagType", "equip"); len(list) != 2 {
+		t.Fatalf("按字段名查询期望 2 条，实际 %d 条", len(list))
+	}
+	if list := config233.GetConfigByIndex[IndexItemConfig]("bagType", "item"); len(list) != 1 || list[0].Id != 2 {
+		t.Fatalf("按 json tag 查询结果错误: %v", list)
+	}
+	if list := config233.GetConfigByIndex[IndexItemConfig]("type", 1); len(list) != 2 {
+		t.Fatalf("按 int 字段查询期望 2 条，实际 %d 条", len(list))
+	}
+	if list := config233.GetConfigByIndex[IndexItemConfig]("type", 9); len(list) != 0 {
+		t.Errorf("不存在的值应返回空结果，实际: %v", list)
+	}
+	if list := config233.GetConfigByIndex[IndexItemConfig]("unknown", 1); list != nil {
+		t.Errorf("不存在的字段应返回 nil，实际: %v", list)
+	}
+
+	writeTextFile(t, tempDir, "IndexItemConfig.json", `[{"id": 4, "type": 1, "bagType": "item"}]`)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+	if list := config233.GetConfigByIndex[IndexItemConfig]("bagType", "equip"); len(list) != 0 {
+		t.Errorf("重新加载后索引应重建，实际: %v", list)
+	}
+	if list := config233.GetConfigByIndex[IndexItemConfig]("bagType", "item"); len(list) != 1 || list[0].Id != 4 {
+		t.Errorf("重新加载后索引查询错误: %v", list)
+	}
+}
@@ -0,0 +1,74 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// UnregisteredListConfig 没有对应配置文件的类型
+type UnregisteredListConfig struct {
+	Id int `json:"id"`
+}
+
+// TestGetConfigListE 测试配置未加载时返回 ErrConfigTypeNotLoaded，已加载时返回列表
+func TestGetConfigListE(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "UnregisteredListConfig.json", `[{"id": 1}, {"id": 2}]`)
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	config233.RegisterType[UnregisteredListConfig]()
+
+	list, err := config233.GetConfigListE[UnregisteredListConfig]()
+	if !errors.Is(err, config233.ErrConfigTypeNotLoaded) {
+		t.Fatalf("配置未加载时应返回 ErrConfigTypeNotLoaded: %v", err)
+	}
+	if list != nil || !strings.Contains(err.Error(), "UnregisteredListConfig") {
+		t.Errorf("错误信息应包含配置名: %v", err)
+	}
+
+	manager = config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[UnregisteredListConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	list, err = config233.GetConfigListE[UnregisteredListConfig]()
+	if err != nil || len(list) != 2 {
+		t.Errorf("配置已加载时应返回 2 条，实际 %d 条: %v", len(list), err)
+	}
+}
+
+// TestGetConfigList_WarnsOnceForMissingType 测试查询未加载的配置时只提示一次
+func TestGetConfigList_WarnsOnceForMissingType(t *testing.T) {
+	logger := &recordingLogger{}
+	config233.SetLogger(logger)
+	defer config233.SetLogger(nil)
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	logger.reset()
+
+	for i := 0; i < 3; i++ {
+		if list := config233.GetConfigList[UnregisteredListConfig](); len(list) != 0 {
+			t.Fatalf("未加载的配置应返回空列表，实际 %d 条", len(list))
+		}
+	}
+
+	_, errs := logger.snapshot()
+	warnings := 0
+	for _, msg := range errs {
+		if strings.Contains(msg, "配置未加载") {
+			warnings++
+		}
+	}
+	if warnings != 1 {
+		t.Errorf("多次查询应只提示一次，实际 %d 次: %v", warnings, errs)
+	}
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// RefItemConfig 通过 config233_ref 引用技能配置的物品配置
+type RefItemConfig struct {
+	Id    int             `json:"id"`
+	Name  string          `json:"name"`
+	Skill *RefSkillConfig `json:"skillId" config233_ref:"RefSkillConfig"`
+}
+
+// RefSkillConfig 技能配置，反向引用物品配置构成循环引用
+type RefSkillConfig struct {
+	Id    int            `json:"id"`
+	Power int            `json:"power"`
+	Item  *RefItemConfig `json:"itemId" config233_ref:"RefItemConfig"`
+}
+
+// refCheckManager 在 OnFirstAllConfigDone 时读取引用字段
+type refCheckManager struct {
+	skillAtFirstDone *RefSkillConfig
+}
+
+func (m *refCheckManager) OnConfigLoadComplete(changedConfigNameList []string) {}
+
+func (m *refCheckManager) OnFirstAllConfigDone() {
+	if item, ok := config233.GetConfigById[RefItemConfig](1); ok {
+		m.skillAtFirstDone = item.Skill
+	}
+}
+
+func loadRefConfigs(t *testing.T, dir string) *config233.ConfigManager233 {
+	t.Helper()
+	manager := config233.NewConfigManager233(dir)
+	config233.Instance = manager
+	config233.RegisterType[RefItemConfig]()
+	config233.RegisterType[RefSkillConfig]()
+	return manager
+}
+
+// TestConfigRef_ResolvedAfterLoad 测试所有配置加载完成后按 ID 回填引用指针，循环引用不会死循环
+func TestConfigRef_ResolvedAfterLoad(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "RefItemConfig.json",
+		`[{"id": 1, "name": "sword", "skillId": 101}, {"id": 2, "name": "shield", "skillId": "999"}, {"id": 3, "name": "stone"}]`)
+	writeTextFile(t, tempDir, "RefSkillConfig.json",
+		`[{"id": 101, "power": 10, "itemId": 1}]`)
+
+	manager := loadRefConfigs(t, tempDir)
+	business := &refCheckManager{}
+	manager.RegisterBusinessManager(business)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	skill, _ := config233.GetConfigById[RefSkillConfig](101)
+	sword, _ := config233.GetConfigById[RefItemConfig](1)
+	if sword == nil || sword.Skill != skill {
+		t.Fatalf("引用字段应回填为技能配置的指针: %+v", sword)
+	}
+	if business.skillAtFirstDone != skill {
+		t.Error("OnFirstAllConfigDone 时引用字段应已回填")
+	}
+	if skill.Item != sword || sword.Skill.Item.Skill != skill {
+		t.Error("循环引用应互相持有指针")
+	}
+
+	if shield, _ := config233.GetConfigById[RefItemConfig](2); shield.Skill != nil {
+		t.Errorf("引用的 ID 不存在时字段应保持 nil: %+v", shield.Skill)
+	}
+	if stone, _ := config233.GetConfigById[RefItemConfig](3); stone.Skill != nil {
+		t.Errorf("未填写引用 ID 时字段应保持 nil: %+v", stone.Skill)
+	}
+}
+
+// TestConfigRef_ReresolvedAfterReload 测试被引用的配置重新加载后，引用字段指向新的对象
+func TestConfigRef_ReresolvedAfterReload(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "RefItemConfig.json", `[{"id": 1, "name": "sword", "skillId": 101}]`)
+	writeTextFile(t, tempDir, "RefSkillConfig.json", `[{"id": 101, "power": 10}]`)
+
+	manager := loadRefConfigs(t, tempDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	writeTextFile(t, tempDir, "RefSkillConfig.json", `[{"id": 101, "power": 20}]`)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+	sword, _ := config233.GetConfigById[RefItemConfig](1)
+	if sword == nil || sword.Skill == nil || sword.Skill.Power != 20 {
+		t.Errorf("重新加载后引用字段应指向新的技能配置: %+v", sword)
+	}
+}
@@ -0,0 +1,157 @@
+package test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// requireConversionErrors 断言 err 为包含 n 个 ConversionError 的 ConversionErrors
+func requireConversionErrors(t *testing.T, err error, n int) config233.ConversionErrors {
+	t.Helper()
+	var convErrs config233.ConversionErrors
+	if !errors.As(err, &convErrs) {
+		t.Fatalf("期望返回 ConversionErrors，实际: %v", err)
+	}
+	if len(convErrs) != n {
+		t.Fatalf("期望 %d 个转换错误，实际 %d 个: %v", n, len(convErrs), convErrs)
+	}
+	return convErrs
+}
+
+// ConversionErrorConfig 包含坏数据的配置
+type ConversionErrorConfig struct {
+	Id       int     `config233_column:"id"`
+	BadInt   int     `config233_column:"badInt"`
+	BadFloat float64 `config233_column:"badFloat"`
+	Flag     bool    `config233_column:"flag"`
+}
+
+// TestConversionError_Excel 测试 Excel 转换失败时报告行号和列名
+func TestConversionError_Excel(t *testing.T) {
+	data := buildExcelBytes(t, [][]interface{}{
+		{"id", "badInt", "badFloat", "flag"},
+		{1, "10", "0.5", "是"},
+		{2, "abc", "x.y", "true"},
+		{3, "", "", "maybe"},
+	})
+	list, err := (&excel.ExcelConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(ConversionErrorConfig{}), "ConversionErrorConfig", data)
+	convErrs := requireConversionErrors(t, err, 3)
+
+	want := []config233.ConversionError{
+		{ConfigName: "ConversionErrorConfig", RowIndex: 3, ColumnName: "badInt", TargetType: "int", RawValue: "abc"},
+		{ConfigName: "ConversionErrorConfig", RowIndex: 3, ColumnName: "badFloat", TargetType: "float64", RawValue: "x.y"},
+		{ConfigName: "ConversionErrorConfig", RowIndex: 4, ColumnName: "flag", TargetType: "bool", RawValue: "maybe"},
+	}
+	for i, w := range want {
+		got := *convErrs[i]
+		if got.Err == nil {
+			t.Errorf("第 %d 个错误缺少底层错误", i)
+		}
+		got.Err = nil
+		if got != w {
+			t.Errorf("第 %d 个错误 = %+v, 期望 %+v", i, got, w)
+		}
+	}
+	if msg := convErrs[0].Error(); !strings.Contains(msg, "第 3 行 badInt 列") || !strings.Contains(msg, "int") {
+		t.Errorf("错误信息应包含行号和列名: %s", msg)
+	}
+
+	// 对象列表仍然有效，失败字段保持零值
+	if len(list) != 3 {
+		t.Fatalf("期望 3 个对象，实际 %d 个", len(list))
+	}
+	if first := list[0].(ConversionErrorConfig); first.BadInt != 10 || !first.Flag {
+		t.Errorf("正常行应转换成功: %+v", first)
+	}
+	if second := list[1].(ConversionErrorConfig); second.BadInt != 0 || second.BadFloat != 0 || !second.Flag {
+		t.Errorf("失败字段应保持零值，其他字段正常: %+v", second)
+	}
+}
+
+// TsvConversionErrorConfig TSV 表头即字段名
+type TsvConversionErrorConfig struct {
+	Id     int
+	BadInt int
+}
+
+// TestConversionError_Tsv 测试 TSV 转换失败时报告行号和列名
+func TestConversionError_Tsv(t *testing.T) {
+	data := []byte("Id\tBadInt\n1\t10\n\n2\tabc\n3\t\n")
+	list, err := (&tsv.TsvConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(TsvConversionErrorConfig{}), "TsvConversionErrorConfig", data)
+	convErrs := requireConversionErrors(t, err, 1)
+
+	// 表头为第 1 行，空行不计
+	if c := convErrs[0]; c.RowIndex != 3 || c.ColumnName != "BadInt" || c.TargetType != "int" || c.RawValue != "abc" {
+		t.Errorf("ConversionError 内容错误: %+v", c)
+	}
+	if len(list) != 3 || list[1].(TsvConversionErrorConfig).Id != 2 {
+		t.Errorf("对象列表应完整返回: %+v", list)
+	}
+}
+
+// TestConversionError_Config233KeepsData 测试 Config233 遇到转换错误时记录日志并继续使用数据
+func TestConversionError_Config233KeepsData(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "TsvConversionErrorConfig.tsv", "Id\tBadInt\n1\t10\n2\tabc\n")
+
+	typ := reflect.TypeOf(TsvConversionErrorConfig{})
+	cfg := config233.NewConfig233().
+		Directory(tempDir).
+		AddConfigHandler("tsv", &tsv.TsvConfigHandler{}).
+		AddConfigHandler("json", &json.JsonConfigHandler{}).
+		RegisterConfigClass("TsvConversionErrorConfig", typ).
+		Start()
+
+	list, _ := cfg.GetConfigList(typ).([]interface{})
+	if len(list) != 2 {
+		t.Fatalf("转换错误不应导致整表丢弃，实际: %+v", cfg.GetConfigList(typ))
+	}
+}
+
+// TestConversionError_ManagerLogsExcelCells 测试 LoadAllConfigs 加载 Excel 时按类型行转换失败的单元格通过 Logger 报告
+func TestConversionError_ManagerLogsExcelCells(t *testing.T) {
+	logger := &recordingLogger{}
+	config233.SetLogger(logger)
+	defer config233.SetLogger(nil)
+
+	path := createExcelWithRows(t, "ExcelCellErrorConfig.xlsx", [][]interface{}{
+		{"type", "int", "int"},
+		{"Server", "id", "count"},
+		{"", 1, "abc"},
+	})
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("读取 Excel 失败: %v", err)
+	}
+	result, err := (&excel.ExcelConfigHandler{}).ReadToFrontEndDataListFromBytes("ExcelCellErrorConfig", data)
+	if err != nil {
+		t.Fatalf("单元格转换失败不应导致读取失败: %v", err)
+	}
+	if convErrs := result.(*dto.FrontEndConfigDto).ConversionErrors; len(convErrs) != 1 || convErrs[0].RowIndex != 3 || convErrs[0].ColumnName != "count" {
+		t.Errorf("应收集转换失败的单元格: %v", convErrs)
+	}
+
+	manager := config233.NewConfigManager233(filepath.Dir(path))
+	config233.Instance = manager
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("单元格转换失败不应导致加载失败: %v", err)
+	}
+	if manager.GetConfigCount("ExcelCellErrorConfig") != 1 {
+		t.Error("转换失败的单元格不应影响整表加载")
+	}
+	_, errs := logger.snapshot()
+	if !slices.Contains(errs, "字段类型转换失败") {
+		t.Errorf("转换失败的单元格应输出到自定义 Logger，实际: %v", errs)
+	}
+}
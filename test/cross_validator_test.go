@@ -0,0 +1,82 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// CrossItemConfig 引用 CrossJumpConfig 的物品配置
+type CrossItemConfig struct {
+	Id     int `json:"id"`
+	JumpId int `json:"jumpId"`
+}
+
+// CrossJumpConfig 被引用的跳转配置
+type CrossJumpConfig struct {
+	Id   int    `json:"id"`
+	Page string `json:"page"`
+}
+
+// checkJumpIds 校验所有物品的 jumpId 都存在
+func checkJumpIds(cm *config233.ConfigManager233) error {
+	var errs []error
+	for _, item := range config233.GetConfigListFrom[CrossItemConfig](cm) {
+		if _, ok := config233.GetConfigByIdFrom[CrossJumpConfig](cm, item.JumpId); !ok {
+			errs = append(errs, fmt.Errorf("物品 %d 的 jumpId %d 不存在", item.Id, item.JumpId))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func setupCrossManager(t *testing.T, itemContent string) (*config233.ConfigManager233, string) {
+	t.Helper()
+	manager, tempDir := newTestManager(t, map[string]string{
+		"CrossItemConfig.json": itemContent,
+		"CrossJumpConfig.json": `[{"id": 10, "page": "shop"}, {"id": 20, "page": "bag"}]`,
+	}, config233.RegisterType[CrossItemConfig], config233.RegisterType[CrossJumpConfig])
+	manager.RegisterCrossValidator(checkJumpIds)
+	return manager, tempDir
+}
+
+// TestCrossValidator_AggregatesErrors 测试全部配置加载后执行跨配置校验并汇总错误，配置仍保持加载
+func TestCrossValidator_AggregatesErrors(t *testing.T) {
+	manager, _ := setupCrossManager(t, `[{"id": 1, "jumpId": 10}, {"id": 2, "jumpId": 30}, {"id": 3, "jumpId": 40}]`)
+	manager.RegisterCrossValidator(func(cm *config233.ConfigManager233) error {
+		panic("校验函数出错")
+	})
+
+	err := manager.LoadAllConfigs()
+	if err == nil {
+		t.Fatal("存在不存在的引用时应返回错误")
+	}
+	for _, want := range []string{"物品 2 的 jumpId 30 不存在", "物品 3 的 jumpId 40 不存在", "校验函数出错"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("错误应包含 %q: %v", want, err)
+		}
+	}
+	if count := config233.GetConfigListCount[CrossItemConfig](); count != 3 {
+		t.Errorf("跨配置校验失败时配置仍应保持加载，实际 %d 条", count)
+	}
+}
+
+// TestCrossValidator_Passed 测试引用全部存在时加载成功，并可手动再次校验
+func TestCrossValidator_Passed(t *testing.T) {
+	manager, tempDir := setupCrossManager(t, `[{"id": 1, "jumpId": 10}, {"id": 2, "jumpId": 20}]`)
+
+	if err := manager.LoadAllConfigsStrict(); err != nil {
+		t.Fatalf("引用全部存在时不应返回错误: %v", err)
+	}
+
+	// 热重载后手动校验
+	writeTextFile(t, tempDir, "CrossJumpConfig.json", `[{"id": 10, "page": "shop"}]`)
+	if err := manager.ReloadConfig("CrossJumpConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if err := manager.ValidateCrossReferences(); err == nil || !strings.Contains(err.Error(), "jumpId 20") {
+		t.Errorf("手动校验应发现 jumpId 20 不存在: %v", err)
+	}
+}
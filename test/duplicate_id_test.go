@@ -0,0 +1,75 @@
+package test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// DuplicateIdConfig 含重复 ID 的配置
+type DuplicateIdConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// newDuplicateIdManager 创建加载含重复 ID 配置的管理器：ID 1 出现两次，ID 2 出现一次
+func newDuplicateIdManager(t *testing.T, policy config233.DuplicateIdPolicy) *config233.ConfigManager233 {
+	t.Helper()
+	path := createExcelWithRows(t, "DuplicateIdConfig.xlsx", [][]interface{}{
+		{"Client", "id", "name"},
+		{"type", "int", "string"},
+		{"Server", "id", "name"},
+		{"", 1, "first"},
+		{"", 2, "other"},
+		{"", 1, "last"},
+	})
+
+	return newTestManagerAt(t, filepath.Dir(path), config233.RegisterType[DuplicateIdConfig]).SetDuplicateIdPolicy(policy)
+}
+
+// TestDuplicateId_KeepPolicies 测试保留第一条 / 最后一条时 ID 映射与列表保持一致
+func TestDuplicateId_KeepPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   config233.DuplicateIdPolicy
+		wantName string
+	}{
+		{"默认保留最后一条", config233.DuplicateIdKeepLast, "last"},
+		{"保留第一条", config233.DuplicateIdKeepFirst, "first"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := newDuplicateIdManager(t, tt.policy)
+			if err := manager.LoadAllConfigsStrict(); err != nil {
+				t.Fatalf("重复 ID 不应导致加载失败: %v", err)
+			}
+
+			list := config233.GetConfigList[DuplicateIdConfig]()
+			configMap := config233.GetConfigMap[DuplicateIdConfig]()
+			if len(list) != 2 || len(configMap) != len(list) {
+				t.Fatalf("列表与 ID 映射应各有 2 条，实际列表 %d 条、映射 %d 条", len(list), len(configMap))
+			}
+			// 保留的配置位于该 ID 首次出现的位置，且列表与映射指向同一对象
+			if list[0].Id != 1 || list[0].Name != tt.wantName || list[1].Id != 2 {
+				t.Errorf("列表内容不符合预期: %+v, %+v", *list[0], *list[1])
+			}
+			if got, ok := config233.GetConfigById[DuplicateIdConfig](1); !ok || got != list[0] {
+				t.Errorf("ID 映射应与列表中保留的配置一致: %+v", got)
+			}
+		})
+	}
+}
+
+// TestDuplicateId_Error 测试 DuplicateIdError 策略下重复 ID 视为加载失败
+func TestDuplicateId_Error(t *testing.T) {
+	manager := newDuplicateIdManager(t, config233.DuplicateIdError)
+	err := manager.LoadAllConfigsStrict()
+	if err == nil || !strings.Contains(err.Error(), "重复的 ID: 1") {
+		t.Fatalf("期望返回重复 ID 错误，实际: %v", err)
+	}
+	if list := config233.GetConfigList[DuplicateIdConfig](); len(list) != 0 {
+		t.Errorf("加载失败的配置不应被写入，实际 %d 条", len(list))
+	}
+}
@@ -0,0 +1,119 @@
+package test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// DuplicateNameConfig 用于测试同名多扩展名配置文件
+type DuplicateNameConfig struct {
+	Id   int    `json:"id" config233:"uid"`
+	Name string `json:"name"`
+}
+
+// writeDuplicateNameFiles 在同一目录写入同名的 json 与 tsv 配置，以及一个不冲突的配置
+func writeDuplicateNameFiles(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeTextFile(t, dir, "DuplicateNameConfig.json", `[{"id": 1, "name": "from-json"}]`)
+	writeTextFile(t, dir, "DuplicateNameConfig.tsv", "Id\tName\n1\tfrom-tsv\n")
+	writeTextFile(t, dir, "MultiDirShopConfig.json", `[{"id": 1, "price": 100}]`)
+	return dir
+}
+
+// TestConfigManager233_DuplicateNameDefaultError 测试默认策略下只跳过冲突的配置，不冲突的配置照常加载
+func TestConfigManager233_DuplicateNameDefaultError(t *testing.T) {
+	manager := config233.NewConfigManager233(writeDuplicateNameFiles(t))
+	config233.Instance = manager
+	config233.RegisterType[DuplicateNameConfig]()
+	config233.RegisterType[MultiDirShopConfig]()
+
+	err := manager.LoadAllConfigs()
+	var problems config233.ConfigValidationErrors
+	if !errors.As(err, &problems) {
+		t.Fatalf("同一目录存在同名配置文件时应返回 ConfigValidationErrors，实际: %v", err)
+	}
+	if len(problems) != 1 || problems[0].ConfigName != "DuplicateNameConfig" || !strings.Contains(err.Error(), "同名文件") {
+		t.Errorf("错误应只包含冲突的配置: %v", err)
+	}
+	if got := manager.GetLoadedConfigNames(); len(got) != 1 || got[0] != "MultiDirShopConfig" {
+		t.Errorf("只应跳过冲突的配置，实际已加载: %v", got)
+	}
+	if len(config233.GetConfigList[MultiDirShopConfig]()) != 1 {
+		t.Error("不冲突的配置应正常加载")
+	}
+}
+
+// TestConfigManager233_DuplicateNameFirstAndLastWins 测试保留第一个或最后一个同名文件
+func TestConfigManager233_DuplicateNameFirstAndLastWins(t *testing.T) {
+	cases := []struct {
+		policy config233.DuplicateNamePolicy
+		want   string
+	}{
+		{config233.DuplicateNameFirstWins, "from-json"},
+		{config233.DuplicateNameLastWins, "from-tsv"},
+	}
+	for _, c := range cases {
+		manager := config233.NewConfigManager233(writeDuplicateNameFiles(t))
+		config233.Instance = manager
+		manager.SetDuplicateNamePolicy(c.policy)
+		config233.RegisterType[DuplicateNameConfig]()
+
+		if err := manager.LoadAllConfigs(); err != nil {
+			t.Fatalf("加载配置失败: %v", err)
+		}
+		item, ok := config233.GetConfigById[DuplicateNameConfig](1)
+		if !ok || item.Name != c.want {
+			t.Errorf("策略 %d 应保留 %s，实际: %+v", c.policy, c.want, item)
+		}
+		if len(config233.GetConfigList[MultiDirShopConfig]()) != 1 {
+			t.Errorf("策略 %d 下不冲突的配置应正常加载", c.policy)
+		}
+	}
+}
+
+// TestConfigManager233_DuplicateNamePanic 测试 Panic 策略保持原有的 panic 行为
+func TestConfigManager233_DuplicateNamePanic(t *testing.T) {
+	manager := config233.NewConfigManager233(writeDuplicateNameFiles(t))
+	config233.Instance = manager
+	manager.SetDuplicateNamePolicy(config233.DuplicateNamePanic)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Panic 策略下同名配置文件应 panic")
+		}
+	}()
+	manager.LoadAllConfigs()
+}
+
+// TestConfig233_DuplicateNamePolicy 测试 Config233 默认跳过冲突配置而不是 panic，并支持其他策略
+func TestConfig233_DuplicateNamePolicy(t *testing.T) {
+	dir := writeDuplicateNameFiles(t)
+	typ := reflect.TypeOf(DuplicateNameConfig{})
+	newCfg := func() *config233.Config233 {
+		return config233.NewConfig233().
+			Directory(dir).
+			AddConfigHandler("json", &json.JsonConfigHandler{}).
+			AddConfigHandler("tsv", &tsv.TsvConfigHandler{}).
+			RegisterConfigClass("DuplicateNameConfig", typ)
+	}
+
+	cfg := newCfg().Start()
+	defer cfg.Stop()
+	if list, _ := cfg.GetConfigList(typ).([]interface{}); len(list) != 0 {
+		t.Errorf("默认策略下冲突的配置不应加载，实际: %+v", list)
+	}
+
+	lastWins := newCfg().SetDuplicateNamePolicy(config233.DuplicateNameLastWins).Start()
+	defer lastWins.Stop()
+	list, _ := lastWins.GetConfigList(typ).([]interface{})
+	if len(list) != 1 || list[0].(DuplicateNameConfig).Name != "from-tsv" {
+		t.Errorf("LastWins 应加载 tsv 文件，实际: %+v", list)
+	}
+}
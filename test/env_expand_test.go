@@ -0,0 +1,122 @@
+package test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// EnvServerConfig 用于测试占位符展开的配置
+type EnvServerConfig struct {
+	Id      int               `json:"id"`
+	Addr    string            `json:"addr"`
+	Price   string            `json:"price"`
+	LogDir  *string           `json:"logDir"`
+	Mirrors []string          `json:"mirrors"`
+	Labels  map[string]string `json:"labels"`
+	Backup  EnvBackupConfig   `json:"backup"`
+	Home    string            `json:"home" config233_default:"${CONFIG233_TEST_HOME}/data"`
+}
+
+// EnvBackupConfig 嵌套结构体
+type EnvBackupConfig struct {
+	Path string `json:"path"`
+}
+
+// loadEnvServerConfig 写入带占位符的配置并按给定策略加载
+func loadEnvServerConfig(t *testing.T, policy config233.EnvExpandPolicy) *EnvServerConfig {
+	t.Helper()
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "EnvServerConfig.json", `[{
+		"id": 1,
+		"addr": "${CONFIG233_TEST_HOST}:${CONFIG233_TEST_PORT}",
+		"price": "$5",
+		"logDir": "${CONFIG233_TEST_HOME}/logs",
+		"mirrors": ["${CONFIG233_TEST_HOST}", "${CONFIG233_TEST_MISSING}"],
+		"labels": "{\"owner\": \"${CONFIG233_TEST_HOST}\"}",
+		"backup": {"path": "${CONFIG233_TEST_HOME}/backup"}
+	}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[EnvServerConfig]()
+	manager.SetEnvExpandPolicy(policy)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	cfg, ok := config233.GetConfigById[EnvServerConfig](1)
+	if !ok {
+		t.Fatal("配置 1 应存在")
+	}
+	return cfg
+}
+
+// TestEnvExpand_Placeholders 测试字符串字段（含指针、切片、map、嵌套结构体与默认值）中的占位符被展开
+func TestEnvExpand_Placeholders(t *testing.T) {
+	t.Setenv("CONFIG233_TEST_HOST", "10.0.0.1")
+	t.Setenv("CONFIG233_TEST_PORT", "8080")
+	t.Setenv("CONFIG233_TEST_HOME", "/srv/game")
+
+	cfg := loadEnvServerConfig(t, config233.EnvExpandKeepUndefined)
+	if cfg.Addr != "10.0.0.1:8080" {
+		t.Errorf("Addr 应展开为 10.0.0.1:8080，实际 %q", cfg.Addr)
+	}
+	if cfg.Price != "$5" {
+		t.Errorf("不带花括号的 $ 文本应保持不变，实际 %q", cfg.Price)
+	}
+	if cfg.LogDir == nil || *cfg.LogDir != "/srv/game/logs" {
+		t.Errorf("*string 字段应被展开: %v", cfg.LogDir)
+	}
+	if !slices.Equal(cfg.Mirrors, []string{"10.0.0.1", "${CONFIG233_TEST_MISSING}"}) {
+		t.Errorf("切片元素应被展开，未定义变量保留原文: %v", cfg.Mirrors)
+	}
+	if cfg.Labels["owner"] != "10.0.0.1" {
+		t.Errorf("map 的值应被展开: %v", cfg.Labels)
+	}
+	if cfg.Backup.Path != "/srv/game/backup" {
+		t.Errorf("嵌套结构体字段应被展开: %q", cfg.Backup.Path)
+	}
+	if cfg.Home != "/srv/game/data" {
+		t.Errorf("默认值中的占位符应被展开: %q", cfg.Home)
+	}
+}
+
+// TestEnvExpand_OffByDefault 测试默认不展开占位符
+func TestEnvExpand_OffByDefault(t *testing.T) {
+	t.Setenv("CONFIG233_TEST_HOST", "10.0.0.1")
+	t.Setenv("CONFIG233_TEST_PORT", "8080")
+
+	cfg := loadEnvServerConfig(t, config233.EnvExpandOff)
+	if cfg.Addr != "${CONFIG233_TEST_HOST}:${CONFIG233_TEST_PORT}" {
+		t.Errorf("默认关闭时应保持原文，实际 %q", cfg.Addr)
+	}
+}
+
+// TestEnvExpand_UndefinedVariable 测试未定义变量按策略保留原文或记录错误
+func TestEnvExpand_UndefinedVariable(t *testing.T) {
+	t.Setenv("CONFIG233_TEST_HOST", "10.0.0.1")
+	t.Setenv("CONFIG233_TEST_PORT", "")
+	t.Setenv("CONFIG233_TEST_HOME", "/srv/game")
+
+	logger := &recordingLogger{}
+	config233.SetLogger(logger)
+	defer config233.SetLogger(nil)
+
+	cfg := loadEnvServerConfig(t, config233.EnvExpandKeepUndefined)
+	if cfg.Addr != "10.0.0.1:" {
+		t.Errorf("定义为空字符串的变量应展开为空，实际 %q", cfg.Addr)
+	}
+	if _, errs := logger.snapshot(); len(errs) != 0 {
+		t.Errorf("EnvExpandKeepUndefined 不应记录错误: %v", errs)
+	}
+
+	logger.reset()
+	cfg = loadEnvServerConfig(t, config233.EnvExpandErrorUndefined)
+	if cfg.Mirrors[1] != "${CONFIG233_TEST_MISSING}" {
+		t.Errorf("未定义变量应保留原文: %q", cfg.Mirrors[1])
+	}
+	if _, errs := logger.snapshot(); !slices.Contains(errs, "配置中的占位符无法展开，已保留原文") {
+		t.Errorf("EnvExpandErrorUndefined 应记录未定义变量的错误: %v", errs)
+	}
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/xuri/excelize/v2"
+)
+
+// AllSheetShop 按工作表名 AllSheetShop 注册的配置
+type AllSheetShop struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// createAllSheetsExcel 在 dir 中创建包含已注册、未注册、隐藏与空工作表的 Excel 文件 Tables.xlsx
+func createAllSheetsExcel(t *testing.T, dir string) string {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", "AllSheetShop"); err != nil {
+		t.Fatalf("重命名工作表失败: %v", err)
+	}
+	for _, sheet := range []string{"Drop", "Hidden", "Empty"} {
+		if _, err := f.NewSheet(sheet); err != nil {
+			t.Fatalf("创建工作表失败: %v", err)
+		}
+	}
+	writeStandardSheet(t, f, "AllSheetShop", [][]interface{}{{"", 1, "potion"}, {"", 2, "elixir"}})
+	writeStandardSheet(t, f, "Drop", [][]interface{}{{"", 10, "gold"}})
+	writeStandardSheet(t, f, "Hidden", [][]interface{}{{"", 99, "secret"}})
+	if err := f.SetSheetVisible("Hidden", false); err != nil {
+		t.Fatalf("隐藏工作表失败: %v", err)
+	}
+
+	path := filepath.Join(dir, "Tables.xlsx")
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("保存 Excel 失败: %v", err)
+	}
+	return path
+}
+
+// TestLoadExcelAllSheets 测试每个工作表分别加载为独立配置，跳过隐藏与空工作表
+func TestLoadExcelAllSheets(t *testing.T) {
+	path := createAllSheetsExcel(t, t.TempDir())
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	config233.RegisterType[AllSheetShop]()
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+
+	if err := manager.LoadExcelAllSheets(path); err != nil {
+		t.Fatalf("加载多工作表 Excel 失败: %v", err)
+	}
+
+	// 工作表名注册了类型时使用工作表名，否则为 文件名_工作表名
+	want := []string{"AllSheetShop", "Tables_Drop"}
+	got := manager.GetLoadedConfigNames()
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("加载的配置不符合预期: %v", got)
+	}
+	if batches := recorder.snapshot(); len(batches) != 1 || len(batches[0]) != 2 {
+		t.Errorf("应对所有工作表通知一次，实际 %v", batches)
+	}
+
+	shop, ok := config233.GetConfigById[AllSheetShop](2)
+	if !ok || shop.Name != "elixir" {
+		t.Errorf("已注册的工作表应转换为结构体: %+v", shop)
+	}
+	if got := manager.GetConfigCount("Tables_Drop"); got != 1 {
+		t.Errorf("未注册的工作表应按 map 加载 1 条，实际 %d 条", got)
+	}
+}
+
+// sheetItemRecorder 记录 OnConfigItemsChanged 收到的配置名与新增、删除的 ID 数
+type sheetItemRecorder struct {
+	reloadRecorder
+	itemMu  sync.Mutex
+	changes map[string][2]int
+}
+
+func (r *sheetItemRecorder) OnConfigItemsChanged(configName string, added, modified, removed []string) {
+	r.itemMu.Lock()
+	defer r.itemMu.Unlock()
+	r.changes[configName] = [2]int{len(added), len(removed)}
+}
+
+func (r *sheetItemRecorder) takeChanges() map[string][2]int {
+	r.itemMu.Lock()
+	defer r.itemMu.Unlock()
+	changes := r.changes
+	r.changes = make(map[string][2]int)
+	return changes
+}
+
+// TestLoadExcelAllSheets_InConfigDir 测试配置目录中的多工作表文件：
+// LoadExcelAllSheets 发出配置项变更与重载事件，之后全量加载与重载都按工作表处理，删除文件时移除所有工作表的配置
+func TestLoadExcelAllSheets_InConfigDir(t *testing.T) {
+	dir := t.TempDir()
+	path := createAllSheetsExcel(t, dir)
+
+	manager := config233.NewConfigManager233(dir)
+	config233.Instance = manager
+	config233.RegisterType[AllSheetShop]()
+	recorder := &sheetItemRecorder{changes: make(map[string][2]int)}
+	manager.RegisterBusinessManager(recorder)
+	events := manager.SubscribeReload()
+	defer manager.Unsubscribe(events)
+
+	if err := manager.LoadExcelAllSheets(path); err != nil {
+		t.Fatalf("加载多工作表 Excel 失败: %v", err)
+	}
+	want := []string{"AllSheetShop", "Tables_Drop"}
+	select {
+	case event := <-events:
+		if !reflect.DeepEqual(event.ConfigNames, want) {
+			t.Errorf("重载事件应包含所有工作表的配置，实际 %v", event.ConfigNames)
+		}
+	default:
+		t.Error("LoadExcelAllSheets 应发出重载事件")
+	}
+	if changes := recorder.takeChanges(); changes["AllSheetShop"] != [2]int{2, 0} || changes["Tables_Drop"] != [2]int{1, 0} {
+		t.Errorf("首次加载时每个工作表的 ID 应视为新增，实际 %v", changes)
+	}
+
+	// 全量加载不再把整个文件当作名为 Tables 的单个配置
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	got := manager.GetLoadedConfigNames()
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("全量加载应按工作表加载，实际 %v", got)
+	}
+
+	// 文件删除后重载移除所有工作表的配置
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("删除文件失败: %v", err)
+	}
+	if err := manager.TriggerReloadAndWait([]string{"Tables"}); err != nil {
+		t.Fatalf("重载失败: %v", err)
+	}
+	select {
+	case event := <-events:
+		if !reflect.DeepEqual(event.RemovedConfigs, want) {
+			t.Errorf("删除事件应包含所有工作表的配置，实际 %v", event.RemovedConfigs)
+		}
+	default:
+		t.Error("删除文件后应发出重载事件")
+	}
+	if got := manager.GetLoadedConfigNames(); len(got) != 0 {
+		t.Errorf("删除文件后不应再有工作表配置，实际 %v", got)
+	}
+	if changes := recorder.takeChanges(); changes["AllSheetShop"] != [2]int{0, 2} || changes["Tables_Drop"] != [2]int{0, 1} {
+		t.Errorf("删除文件后每个工作表的 ID 应视为删除，实际 %v", changes)
+	}
+}
@@ -0,0 +1,141 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/xuri/excelize/v2"
+)
+
+// createExcelWithRows 按给定的行内容创建只有一个工作表的 Excel 文件
+func createExcelWithRows(t *testing.T, name string, rows [][]interface{}) string {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatalf("写入 Excel 行失败: %v", err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("保存 Excel 失败: %v", err)
+	}
+	return path
+}
+
+// TestExcelHeaderMode_MultiRowMarkers 测试按 Client/type/Server 标记识别多行表头
+func TestExcelHeaderMode_MultiRowMarkers(t *testing.T) {
+	// 表头前多一行注释，标记行整体下移一行
+	path := createExcelWithRows(t, "MarkerConfig.xlsx", [][]interface{}{
+		{"说明"},
+		{"注释", "ID", "名称"},
+		{"", "ID", "名称"},
+		{"CLIENT", "id", "clientName"},
+		{"type", "int", "string"},
+		{"SERVER", "id", "name"},
+		{"", 1, "sword"},
+		{"", 2, "shield"},
+	})
+
+	handler := &excel.ExcelConfigHandler{}
+	result := mustReadDataList(t, handler, "MarkerConfig", path)
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	if result.DataList[0]["id"] != 1 || result.DataList[0]["name"] != "sword" {
+		t.Errorf("应使用 Server 行作为字段名、type 行作为类型，实际: %v", result.DataList[0])
+	}
+	if _, ok := result.DataList[0]["clientName"]; ok {
+		t.Error("存在 Server 行时不应使用 Client 行作为字段名")
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(SheetItemConfig{}), "MarkerConfig", path)
+	if len(list) != 2 || list[1].(SheetItemConfig).Id != 2 || list[1].(SheetItemConfig).Name != "shield" {
+		t.Errorf("多行表头 ORM 解析错误: %+v", list)
+	}
+}
+
+// TestExcelHeaderMode_WithoutTypeMarker 测试 type 行没有标记时仍能识别类型行
+func TestExcelHeaderMode_WithoutTypeMarker(t *testing.T) {
+	path := createExcelWithRows(t, "NoTypeMarkerConfig.xlsx", [][]interface{}{
+		{},
+		{"", "唯一标识", "名字"},
+		{"CLIENT", "id", "name"},
+		{"", "int", "string"},
+		{"SERVER", "id", "name"},
+		{"默认", 1001, "武器1"},
+	})
+
+	handler := &excel.ExcelConfigHandler{}
+	result := mustReadDataList(t, handler, "NoTypeMarkerConfig", path)
+	if len(result.DataList) != 1 {
+		t.Fatalf("期望 1 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	if result.DataList[0]["id"] != 1001 {
+		t.Errorf("期望 id 按 int 转换为 1001，实际 %v (%T)", result.DataList[0]["id"], result.DataList[0]["id"])
+	}
+}
+
+// TestExcelHeaderMode_ClientOnly 测试没有 Server 行时使用 Client 行作为字段名
+func TestExcelHeaderMode_ClientOnly(t *testing.T) {
+	path := createExcelWithRows(t, "ClientOnlyConfig.xlsx", [][]interface{}{
+		{"Client", "id", "name"},
+		{"type", "int", "string"},
+		{"", 7, "bow"},
+	})
+
+	handler := &excel.ExcelConfigHandler{}
+	result := mustReadDataList(t, handler, "ClientOnlyConfig", path)
+	if len(result.DataList) != 1 || result.DataList[0]["id"] != 7 || result.DataList[0]["name"] != "bow" {
+		t.Fatalf("应使用 Client 行作为字段名，实际: %v", result.DataList)
+	}
+}
+
+// TestExcelHeaderMode_SingleRowFallback 测试没有表头标记时默认回退为单行表头
+func TestExcelHeaderMode_SingleRowFallback(t *testing.T) {
+	path := createExcelWithRows(t, "PlainConfig.xlsx", [][]interface{}{
+		{"id", "name"},
+		{1, "sword"},
+		{2, "shield"},
+	})
+
+	for _, mode := range []excel.HeaderMode{excel.HeaderModeAuto, excel.HeaderModeSingleRow} {
+		handler := &excel.ExcelConfigHandler{HeaderMode: mode}
+		result := mustReadDataList(t, handler, "PlainConfig", path)
+		if len(result.DataList) != 2 {
+			t.Fatalf("mode=%d: 期望 2 条数据，实际 %d 条", mode, len(result.DataList))
+		}
+		if result.DataList[0]["id"] != "1" || result.DataList[1]["name"] != "shield" {
+			t.Errorf("mode=%d: 单行表头解析错误: %v", mode, result.DataList)
+		}
+
+		list := mustReadORM(t, handler, reflect.TypeOf(SheetItemConfig{}), "PlainConfig", path)
+		if len(list) != 2 || list[0].(SheetItemConfig).Id != 1 {
+			t.Errorf("mode=%d: 单行表头 ORM 解析错误: %+v", mode, list)
+		}
+	}
+}
+
+// TestExcelHeaderMode_ForceMultiRow 测试强制多行表头时，没有标记也按固定行号解析
+func TestExcelHeaderMode_ForceMultiRow(t *testing.T) {
+	path := createExcelWithRows(t, "FixedLayoutConfig.xlsx", [][]interface{}{
+		{"", "注释"},
+		{"", "ID", "名称"},
+		{"", "id", "name"},
+		{"", "int", "string"},
+		{"", "id", "name"},
+		{"", 3, "axe"},
+	})
+
+	handler := &excel.ExcelConfigHandler{HeaderMode: excel.HeaderModeMultiRow}
+	result := mustReadDataList(t, handler, "FixedLayoutConfig", path)
+	if len(result.DataList) != 1 || result.DataList[0]["id"] != 3 || result.DataList[0]["name"] != "axe" {
+		t.Fatalf("强制多行表头解析错误: %v", result.DataList)
+	}
+}
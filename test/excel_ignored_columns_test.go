@@ -0,0 +1,94 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+)
+
+// IgnoredColumnConfig 含与被忽略列同名字段的配置
+type IgnoredColumnConfig struct {
+	Id    int    `json:"id"`
+	Name  string `json:"name"`
+	Level int    `json:"level"`
+	Note  string `json:"note"`
+}
+
+// createIgnoredColumnExcel 创建含 # 注释列与 skip/ignore 类型列的 Excel
+// 被忽略的 level 列填写了无法按 int 转换的内容
+func createIgnoredColumnExcel(t *testing.T) string {
+	return createExcelWithRows(t, "IgnoredColumnConfig.xlsx", [][]interface{}{
+		{"Client", "id", "name", "#策划备注", "level", "note"},
+		{"type", "int", "string", "int", "IGNORE", "skip"},
+		{"Server", "id", "name", "#策划备注", "level", "note"},
+		{"", 1, "sword", "不是数字", "高级", "内部说明"},
+		{"", 2, "shield", "", "", ""},
+	})
+}
+
+// TestExcelIgnoredColumns_Skipped 测试默认跳过 # 开头与类型为 skip/ignore 的列
+func TestExcelIgnoredColumns_Skipped(t *testing.T) {
+	path := createIgnoredColumnExcel(t)
+	handler := &excel.ExcelConfigHandler{}
+
+	result := mustReadDataList(t, handler, "IgnoredColumnConfig", path)
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(result.DataList))
+	}
+	for _, column := range []string{"#策划备注", "level", "note"} {
+		if _, ok := result.DataList[0][column]; ok {
+			t.Errorf("被忽略的列 %q 不应出现在 DataList 中: %v", column, result.DataList[0])
+		}
+	}
+	if !reflect.DeepEqual(result.ColumnNames, []string{"id", "name"}) {
+		t.Errorf("被忽略的列不应出现在 ColumnNames 中: %v", result.ColumnNames)
+	}
+
+	// 被忽略的列不参与结构体映射，也不产生转换错误
+	list := mustReadORM(t, handler, reflect.TypeOf(IgnoredColumnConfig{}), "IgnoredColumnConfig", path)
+	if got := list[0].(IgnoredColumnConfig); got != (IgnoredColumnConfig{Id: 1, Name: "sword"}) {
+		t.Errorf("被忽略的列不应映射到结构体字段: %+v", got)
+	}
+}
+
+// TestExcelIgnoredColumns_SingleRowHeader 测试单行表头同样跳过 # 开头的列
+func TestExcelIgnoredColumns_SingleRowHeader(t *testing.T) {
+	path := createExcelWithRows(t, "IgnoredColumnConfig.xlsx", [][]interface{}{
+		{"id", "#note", "name"},
+		{1, "备注", "sword"},
+	})
+	handler := &excel.ExcelConfigHandler{HeaderMode: excel.HeaderModeSingleRow}
+
+	result := mustReadDataList(t, handler, "IgnoredColumnConfig", path)
+	if len(result.DataList) != 1 || len(result.DataList[0]) != 2 || result.DataList[0]["name"] != "sword" {
+		t.Errorf("单行表头应跳过 # 开头的列，实际: %v", result.DataList)
+	}
+}
+
+// TestExcelIgnoredColumns_Keep 测试开启 KeepIgnoredColumns 后保留被忽略的列
+func TestExcelIgnoredColumns_Keep(t *testing.T) {
+	path := createIgnoredColumnExcel(t)
+	handler := &excel.ExcelConfigHandler{KeepIgnoredColumns: true}
+
+	var result interface{}
+	var list []interface{}
+	var ormErr error
+	captureStdout(t, func() {
+		result, _ = handler.ReadToFrontEndDataList("IgnoredColumnConfig", path)
+		list, ormErr = handler.ReadConfigAndORM(reflect.TypeOf(IgnoredColumnConfig{}), "IgnoredColumnConfig", path)
+	})
+	row := result.(*dto.FrontEndConfigDto).DataList[0]
+	if row["#策划备注"] == nil || row["note"] != "内部说明" {
+		t.Errorf("KeepIgnoredColumns 为 true 时应保留全部列: %v", row)
+	}
+
+	// 保留后 level 列按声明的类型转换，会产生转换错误
+	if ormErr == nil {
+		t.Error("保留的 level 列无法转换时应返回转换错误")
+	}
+	if got := list[0].(IgnoredColumnConfig); got.Note != "内部说明" {
+		t.Errorf("KeepIgnoredColumns 为 true 时 note 列应映射到字段: %+v", got)
+	}
+}
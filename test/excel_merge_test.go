@@ -0,0 +1,96 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/xuri/excelize/v2"
+)
+
+// MergeItemConfig 用于测试合并单元格回填的配置
+type MergeItemConfig struct {
+	Id    int    `json:"id"`
+	Group string `json:"group"`
+	Name  string `json:"name"`
+	Desc  string `json:"desc"`
+}
+
+// createMergedExcel 创建包含横向、纵向以及跨表头合并单元格的 Excel 文件
+func createMergedExcel(t *testing.T) string {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+
+	rows := [][]interface{}{
+		{"注释", "基础信息", "", "名称", "描述"},
+		{"中文", "ID", "分组", "名称", "描述"},
+		{"Client", "id", "group", "name", "desc"},
+		{"type", "int", "string", "string", "string"},
+		{"Server", "id", "group", "name", "desc"},
+		{"", 1, "weapon", "sword"},
+		{"", 2, "", "shield"},
+		{"", 3, "", "bow"},
+		{"", 4, "armor", "same"},
+	}
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatalf("写入 Excel 行失败: %v", err)
+		}
+	}
+
+	merges := [][2]string{
+		{"B1", "C1"}, // 表头内的横向合并
+		{"C6", "C8"}, // 数据区的纵向合并
+		{"D9", "E9"}, // 数据区的横向合并
+		{"E5", "E7"}, // 从 Server 行跨入数据行的合并
+	}
+	for _, m := range merges {
+		if err := f.MergeCell("Sheet1", m[0], m[1]); err != nil {
+			t.Fatalf("合并单元格 %s:%s 失败: %v", m[0], m[1], err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "MergeItemConfig.xlsx")
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("保存 Excel 失败: %v", err)
+	}
+	return path
+}
+
+// TestExcelMergedCells_Fill 测试合并区域内的空单元格回填为左上角的值
+func TestExcelMergedCells_Fill(t *testing.T) {
+	path := createMergedExcel(t)
+	handler := &excel.ExcelConfigHandler{}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(MergeItemConfig{}), "MergeItemConfig", path)
+	if len(list) != 4 {
+		t.Fatalf("期望 4 条数据，实际 %d 条: %+v", len(list), list)
+	}
+
+	expected := []MergeItemConfig{
+		{Id: 1, Group: "weapon", Name: "sword"},
+		{Id: 2, Group: "weapon", Name: "shield"},
+		{Id: 3, Group: "weapon", Name: "bow"},
+		{Id: 4, Group: "armor", Name: "same", Desc: "same"},
+	}
+	for i, want := range expected {
+		if got := list[i].(MergeItemConfig); got != want {
+			t.Errorf("第 %d 行回填错误: 期望 %+v，实际 %+v", i+1, want, got)
+		}
+	}
+
+	result := mustReadDataList(t, handler, "MergeItemConfig", path)
+	if len(result.DataList) != 4 {
+		t.Fatalf("期望 4 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	if result.DataList[2]["group"] != "weapon" {
+		t.Errorf("纵向合并应回填到数据行: %v", result.DataList[2])
+	}
+	// 跨表头的合并不应把表头文字当作数据
+	if desc := result.DataList[0]["desc"]; desc != nil && desc != "" {
+		t.Errorf("表头跨入数据行的合并不应回填数据行: %v", result.DataList[0])
+	}
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+)
+
+// NumberCellConfig 用于测试数字单元格规整的配置
+type NumberCellConfig struct {
+	Id     int     `json:"id"`
+	ItemId int64   `json:"itemId"`
+	Count  uint32  `json:"count"`
+	Price  float64 `json:"price"`
+}
+
+// numberCellRows 以字符串写入的数字单元格，模拟 GetRows 返回的科学计数法、千分位与空白
+var numberCellRows = [][]interface{}{
+	{"", 1, "9.007199254740993E+15", "1,000", "1,234.5"},
+	{"", 2, "1.001E+09", " 65535 ", "1.5E+03"},
+	{"", 3, " 1001 ", "2.0", "3"},
+}
+
+// TestExcelNumberCells_Untyped 测试没有类型行时按字段类型规整数字单元格
+func TestExcelNumberCells_Untyped(t *testing.T) {
+	rows := [][]interface{}{
+		{"Server", "id", "itemId", "count", "price"},
+	}
+	path := createExcelWithRows(t, "NumberCellConfig.xlsx", append(rows, numberCellRows...))
+
+	list := mustReadORM(t, &excel.ExcelConfigHandler{HeaderMode: excel.HeaderModeMultiRow}, reflect.TypeOf(NumberCellConfig{}), "NumberCellConfig", path)
+	assertNumberCells(t, list)
+}
+
+// TestExcelNumberCells_Typed 测试类型行声明为 long 时规整数字单元格
+func TestExcelNumberCells_Typed(t *testing.T) {
+	rows := [][]interface{}{
+		{"type", "int", "long", "int", "double"},
+		{"Server", "id", "itemId", "count", "price"},
+	}
+	path := createExcelWithRows(t, "NumberCellConfig.xlsx", append(rows, numberCellRows...))
+	handler := &excel.ExcelConfigHandler{}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(NumberCellConfig{}), "NumberCellConfig", path)
+	assertNumberCells(t, list)
+
+	result := mustReadDataList(t, handler, "NumberCellConfig", path)
+	if len(result.DataList) != 3 {
+		t.Fatalf("期望 3 条数据，实际 %d 条", len(result.DataList))
+	}
+	if v := result.DataList[0]["itemId"]; v != int64(9007199254740993) {
+		t.Errorf("long 类型的科学计数法应精确解析为 int64，实际: %v (%T)", v, v)
+	}
+	if v := result.DataList[0]["count"]; v != 1000 {
+		t.Errorf("int 类型的千分位应去掉逗号，实际: %v (%T)", v, v)
+	}
+}
+
+func assertNumberCells(t *testing.T, list []interface{}) {
+	t.Helper()
+	expected := []NumberCellConfig{
+		{Id: 1, ItemId: 9007199254740993, Count: 1000, Price: 1234.5},
+		{Id: 2, ItemId: 1001000000, Count: 65535, Price: 1500},
+		{Id: 3, ItemId: 1001, Count: 2, Price: 3},
+	}
+	if len(list) != len(expected) {
+		t.Fatalf("期望 %d 条数据，实际 %d 条: %+v", len(expected), len(list), list)
+	}
+	for i, want := range expected {
+		if got := list[i].(NumberCellConfig); got != want {
+			t.Errorf("第 %d 行数字规整错误: 期望 %+v，实际 %+v", i+1, want, got)
+		}
+	}
+}
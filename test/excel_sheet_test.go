@@ -0,0 +1,122 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/xuri/excelize/v2"
+)
+
+// SheetItemConfig 用于测试按工作表读取的结构体
+type SheetItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// writeStandardSheet 按标准表头结构（注释/中文/Client/type/Server）写入一个工作表
+func writeStandardSheet(t *testing.T, f *excelize.File, sheet string, rows [][]interface{}) {
+	t.Helper()
+	header := [][]interface{}{
+		{"注释", "ID", "名称"},
+		{"中文", "ID", "名称"},
+		{"Client", "id", "name"},
+		{"type", "int", "string"},
+		{"Server", "id", "name"},
+	}
+	for i, row := range append(header, rows...) {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			t.Fatalf("写入工作表 %s 失败: %v", sheet, err)
+		}
+	}
+}
+
+// createMultiSheetExcel 创建包含多个自定义名称工作表的 Excel 文件
+func createMultiSheetExcel(t *testing.T, hideFirst bool) string {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", "说明"); err != nil {
+		t.Fatalf("重命名工作表失败: %v", err)
+	}
+	if _, err := f.NewSheet("Items"); err != nil {
+		t.Fatalf("创建工作表失败: %v", err)
+	}
+	writeStandardSheet(t, f, "说明", [][]interface{}{{"", 1, "readme"}})
+	writeStandardSheet(t, f, "Items", [][]interface{}{{"", 1001, "sword"}, {"", 1002, "shield"}})
+
+	if hideFirst {
+		index, _ := f.GetSheetIndex("Items")
+		f.SetActiveSheet(index)
+		if err := f.SetSheetVisible("说明", false); err != nil {
+			t.Fatalf("隐藏工作表失败: %v", err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "SheetItemConfig.xlsx")
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("保存 Excel 失败: %v", err)
+	}
+	return path
+}
+
+// TestExcelConfigHandler_SheetName 测试通过 SheetName 字段和方法参数指定工作表
+func TestExcelConfigHandler_SheetName(t *testing.T) {
+	path := createMultiSheetExcel(t, false)
+
+	// 未指定时读取第一个工作表（名称不是 Sheet1）
+	handler := &excel.ExcelConfigHandler{}
+	defaultResult := mustReadDataList(t, handler, "SheetItemConfig", path)
+	if len(defaultResult.DataList) != 1 || defaultResult.DataList[0]["name"] != "readme" {
+		t.Fatalf("默认应读取第一个工作表，实际: %v", defaultResult.DataList)
+	}
+
+	// 通过字段指定
+	handler = &excel.ExcelConfigHandler{SheetName: "Items"}
+	itemsResult := mustReadDataList(t, handler, "SheetItemConfig", path)
+	if len(itemsResult.DataList) != 2 {
+		t.Fatalf("期望 Items 工作表 2 条数据，实际 %d 条", len(itemsResult.DataList))
+	}
+	list := mustReadORM(t, handler, reflect.TypeOf(SheetItemConfig{}), "SheetItemConfig", path)
+	if len(list) != 2 || list[1].(SheetItemConfig).Name != "shield" {
+		t.Fatalf("Items 工作表 ORM 解析错误: %+v", list)
+	}
+
+	// 通过方法参数指定
+	result, err := (&excel.ExcelConfigHandler{}).ReadToFrontEndDataListFromSheet("SheetItemConfig", path, "Items")
+	if err != nil {
+		t.Fatalf("读取指定工作表失败: %v", err)
+	}
+	if len(result.(*dto.FrontEndConfigDto).DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(result.(*dto.FrontEndConfigDto).DataList))
+	}
+}
+
+// TestExcelConfigHandler_FirstVisibleSheet 测试未指定时跳过隐藏工作表
+func TestExcelConfigHandler_FirstVisibleSheet(t *testing.T) {
+	path := createMultiSheetExcel(t, true)
+
+	handler := &excel.ExcelConfigHandler{}
+	result := mustReadDataList(t, handler, "SheetItemConfig", path)
+	if len(result.DataList) != 2 || result.DataList[0]["name"] != "sword" {
+		t.Fatalf("应读取第一个可见工作表 Items，实际: %v", result.DataList)
+	}
+}
+
+// TestExcelConfigHandler_SheetNotExist 测试指定的工作表不存在时返回错误
+func TestExcelConfigHandler_SheetNotExist(t *testing.T) {
+	path := createMultiSheetExcel(t, false)
+	handler := &excel.ExcelConfigHandler{}
+
+	if _, err := handler.ReadToFrontEndDataListFromSheet("SheetItemConfig", path, "Missing"); err == nil || !strings.Contains(err.Error(), "Missing") {
+		t.Fatalf("期望返回工作表不存在的错误，实际: %v", err)
+	}
+	if _, err := handler.ReadConfigAndORMFromSheet(reflect.TypeOf(SheetItemConfig{}), "SheetItemConfig", path, "Missing"); err == nil {
+		t.Fatal("期望 ORM 读取不存在的工作表返回错误")
+	}
+}
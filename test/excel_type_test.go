@@ -0,0 +1,132 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+)
+
+// TypedRowConfig 用于测试 type 行驱动转换的结构体
+type TypedRowConfig struct {
+	Id     int32       `json:"id"`
+	Count  int64       `json:"count"`
+	Rate   float64     `json:"rate"`
+	Open   bool        `json:"open"`
+	Name   string      `json:"name"`
+	Weight float64     `json:"weight"`
+	Extra  interface{} `json:"extra"`
+}
+
+// captureStdout 捕获函数执行期间的标准输出
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	old := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("创建管道失败: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = old }()
+
+	fn()
+
+	w.Close()
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	return buf.String()
+}
+
+func createTypedRowExcel(t *testing.T) string {
+	return createExcelWithRows(t, "TypedRowConfig.xlsx", [][]interface{}{
+		{"", "注释"},
+		{"", "ID", "数量", "比例", "开启", "名称", "权重", "扩展"},
+		{"Client", "id", "count", "rate", "open", "name", "weight", "extra"},
+		{"type", "int", "long", "float", "bool", "string", "int", "LONG"},
+		{"Server", "id", "count", "rate", "open", "name", "weight", "extra"},
+		{"", 1, 10000000000, 0.5, "yes", "001", 3, 7},
+		{"", 2, "abc", 1.5, "off", "b", 4, 8},
+	})
+}
+
+// TestExcelTypeRow_FrontEndDataList 测试前端数据按 type 行声明转换为对应 Go 类型
+func TestExcelTypeRow_FrontEndDataList(t *testing.T) {
+	path := createTypedRowExcel(t)
+	handler := &excel.ExcelConfigHandler{}
+
+	result := mustReadDataList(t, handler, "TypedRowConfig", path)
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(result.DataList))
+	}
+
+	first := result.DataList[0]
+	expected := map[string]interface{}{
+		"id":     1,
+		"count":  int64(10000000000),
+		"rate":   float32(0.5),
+		"open":   true,
+		"name":   "001",
+		"weight": 3,
+		"extra":  int64(7),
+	}
+	for key, want := range expected {
+		if !reflect.DeepEqual(first[key], want) {
+			t.Errorf("字段 %s: 期望 %v (%T)，实际 %v (%T)", key, want, want, first[key], first[key])
+		}
+	}
+
+	// 转换失败时保留原始字符串，并收集带行号和列名的错误
+	if result.DataList[1]["count"] != "abc" {
+		t.Errorf("转换失败时应保留原始字符串，实际 %v", result.DataList[1]["count"])
+	}
+	if result.DataList[1]["open"] != false {
+		t.Errorf("期望 open=false，实际 %v", result.DataList[1]["open"])
+	}
+	if convErrs := result.ConversionErrors; len(convErrs) != 1 || !strings.Contains(convErrs[0].Error(), "第 7 行 count 列") {
+		t.Errorf("应收集带行号和列名的转换错误，实际: %v", convErrs)
+	}
+}
+
+// TestExcelTypeRow_ORM 测试 ORM 按 type 行声明的类型转换后再赋值给字段
+func TestExcelTypeRow_ORM(t *testing.T) {
+	path := createTypedRowExcel(t)
+	handler := &excel.ExcelConfigHandler{}
+
+	list, err := handler.ReadConfigAndORM(reflect.TypeOf(TypedRowConfig{}), "TypedRowConfig", path)
+	// 转换失败时返回 ConversionErrors，对象列表仍然有效
+	var convErrs dto.ConversionErrors
+	if !errors.As(err, &convErrs) || len(convErrs) != 1 {
+		t.Fatalf("期望返回 1 个 ConversionError，实际: %v", err)
+	}
+	if c := convErrs[0]; c.RowIndex != 7 || c.ColumnName != "count" || c.TargetType != "long" || c.RawValue != "abc" {
+		t.Errorf("ConversionError 内容错误: %+v", c)
+	}
+	if len(list) != 2 {
+		t.Fatalf("期望 2 个对象，实际 %d 个", len(list))
+	}
+
+	first := list[0].(TypedRowConfig)
+	if first.Id != 1 || first.Count != 10000000000 || first.Rate != 0.5 || !first.Open {
+		t.Errorf("基础类型转换错误: %+v", first)
+	}
+	if first.Name != "001" {
+		t.Errorf("string 类型应保留原文，实际 %q", first.Name)
+	}
+	if first.Weight != 3 {
+		t.Errorf("int 声明应能赋值给 float64 字段，实际 %v", first.Weight)
+	}
+	if first.Extra != int64(7) {
+		t.Errorf("interface{} 字段应得到声明的类型 int64，实际 %v (%T)", first.Extra, first.Extra)
+	}
+
+	second := list[1].(TypedRowConfig)
+	if second.Count != 0 {
+		t.Errorf("转换失败的字段应保持零值，实际 %d", second.Count)
+	}
+}
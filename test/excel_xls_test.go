@@ -0,0 +1,275 @@
+package test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+)
+
+// XlsItemConfig 用于测试旧版 .xls 读取的配置
+type XlsItemConfig struct {
+	Id    int    `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+var xlsItemRows = [][]interface{}{
+	{"注释", "ID", "名称", "数量"},
+	{"中文", "ID", "名称", "数量"},
+	{"Client", "id", "name", "count"},
+	{"type", "int", "string", "int"},
+	{"Server", "id", "name", "count"},
+	{"", 1, "sword", 10},
+	{"", 2, "盾牌", 20},
+}
+
+// biffRecord 编码一条 BIFF8 记录
+func biffRecord(id uint16, data []byte) []byte {
+	buf := make([]byte, 4, 4+len(data))
+	binary.LittleEndian.PutUint16(buf, id)
+	binary.LittleEndian.PutUint16(buf[2:], uint16(len(data)))
+	return append(buf, data...)
+}
+
+// biffString 编码 BIFF8 的 UTF-16 字符串（不含长度前缀）
+func biffString(s string) []byte {
+	buf := []byte{0x01}
+	for _, u := range utf16.Encode([]rune(s)) {
+		buf = binary.LittleEndian.AppendUint16(buf, u)
+	}
+	return buf
+}
+
+// buildXlsWorkbook 按 BIFF8 格式生成只含一个工作表的 Workbook 数据流
+func buildXlsWorkbook(sheetName string, rows [][]interface{}) []byte {
+	bof := func(typ uint16) []byte {
+		data := make([]byte, 16)
+		binary.LittleEndian.PutUint16(data, 0x0600)
+		binary.LittleEndian.PutUint16(data[2:], typ)
+		return biffRecord(0x0809, data)
+	}
+
+	var sst []string
+	var cells bytes.Buffer
+	for r, row := range rows {
+		for c, value := range row {
+			head := make([]byte, 6)
+			binary.LittleEndian.PutUint16(head, uint16(r))
+			binary.LittleEndian.PutUint16(head[2:], uint16(c))
+			switch v := value.(type) {
+			case string:
+				if v == "" {
+					continue
+				}
+				cells.Write(biffRecord(0x00FD, binary.LittleEndian.AppendUint32(head, uint32(len(sst)))))
+				sst = append(sst, v)
+			case int:
+				cells.Write(biffRecord(0x0203, binary.LittleEndian.AppendUint64(head, math.Float64bits(float64(v)))))
+			}
+		}
+	}
+
+	sstData := binary.LittleEndian.AppendUint32(nil, uint32(len(sst)))
+	sstData = binary.LittleEndian.AppendUint32(sstData, uint32(len(sst)))
+	for _, s := range sst {
+		sstData = binary.LittleEndian.AppendUint16(sstData, uint16(len([]rune(s))))
+		sstData = append(sstData, biffString(s)...)
+	}
+
+	codepage := biffRecord(0x0042, binary.LittleEndian.AppendUint16(nil, 1200))
+	xf := biffRecord(0x00E0, make([]byte, 20))
+	sstRecord := biffRecord(0x00FC, sstData)
+	eof := biffRecord(0x000A, nil)
+
+	boundsheet := func(pos uint32) []byte {
+		data := binary.LittleEndian.AppendUint32(nil, pos)
+		data = append(data, 0, 0, byte(len([]rune(sheetName))))
+		return biffRecord(0x0085, append(data, biffString(sheetName)...))
+	}
+
+	globalsLen := len(bof(0x0005)) + len(codepage) + len(xf) + len(boundsheet(0)) + len(sstRecord) + len(eof)
+
+	var stream bytes.Buffer
+	stream.Write(bof(0x0005))
+	stream.Write(codepage)
+	stream.Write(xf)
+	stream.Write(boundsheet(uint32(globalsLen)))
+	stream.Write(sstRecord)
+	stream.Write(eof)
+	stream.Write(bof(0x0010))
+	stream.Write(cells.Bytes())
+	stream.Write(eof)
+	return stream.Bytes()
+}
+
+// buildOleFile 将 Workbook 数据流封装为 OLE2 复合文档
+// 扇区布局: 0 为 FAT，1 为目录，之后为 Workbook 数据流（至少 4096 字节，避免使用短流）
+func buildOleFile(workbook []byte) []byte {
+	const sectorSize = 512
+	const endOfChain, freeSect, fatSect, noStream = 0xFFFFFFFE, 0xFFFFFFFF, 0xFFFFFFFD, 0xFFFFFFFF
+
+	streamSize := len(workbook)
+	if streamSize < 4096 {
+		streamSize = 4096
+	}
+	streamSectors := (streamSize + sectorSize - 1) / sectorSize
+	padded := make([]byte, streamSectors*sectorSize)
+	copy(padded, workbook)
+
+	header := make([]byte, sectorSize)
+	copy(header, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1})
+	binary.LittleEndian.PutUint16(header[0x18:], 0x003E)
+	binary.LittleEndian.PutUint16(header[0x1A:], 0x0003)
+	binary.LittleEndian.PutUint16(header[0x1C:], 0xFFFE)
+	binary.LittleEndian.PutUint16(header[0x1E:], 9)
+	binary.LittleEndian.PutUint16(header[0x20:], 6)
+	binary.LittleEndian.PutUint32(header[0x2C:], 1) // FAT 扇区数
+	binary.LittleEndian.PutUint32(header[0x30:], 1) // 目录起始扇区
+	binary.LittleEndian.PutUint32(header[0x38:], 4096)
+	binary.LittleEndian.PutUint32(header[0x3C:], endOfChain)
+	binary.LittleEndian.PutUint32(header[0x44:], endOfChain)
+	for i := 0; i < 109; i++ {
+		binary.LittleEndian.PutUint32(header[0x4C+i*4:], freeSect)
+	}
+	binary.LittleEndian.PutUint32(header[0x4C:], 0)
+
+	fat := make([]byte, sectorSize)
+	for i := 0; i < sectorSize/4; i++ {
+		binary.LittleEndian.PutUint32(fat[i*4:], freeSect)
+	}
+	binary.LittleEndian.PutUint32(fat[0:], fatSect)
+	binary.LittleEndian.PutUint32(fat[4:], endOfChain)
+	for i := 0; i < streamSectors; i++ {
+		next := uint32(i + 3)
+		if i == streamSectors-1 {
+			next = endOfChain
+		}
+		binary.LittleEndian.PutUint32(fat[(i+2)*4:], next)
+	}
+
+	dirEntry := func(name string, typ byte, child, start, size uint32) []byte {
+		entry := make([]byte, 128)
+		units := utf16.Encode([]rune(name))
+		for i, u := range units {
+			binary.LittleEndian.PutUint16(entry[i*2:], u)
+		}
+		binary.LittleEndian.PutUint16(entry[0x40:], uint16((len(units)+1)*2))
+		entry[0x42] = typ
+		entry[0x43] = 1
+		binary.LittleEndian.PutUint32(entry[0x44:], noStream)
+		binary.LittleEndian.PutUint32(entry[0x48:], noStream)
+		binary.LittleEndian.PutUint32(entry[0x4C:], child)
+		binary.LittleEndian.PutUint32(entry[0x74:], start)
+		binary.LittleEndian.PutUint32(entry[0x78:], size)
+		return entry
+	}
+	dir := make([]byte, 0, sectorSize)
+	dir = append(dir, dirEntry("Root Entry", 5, 1, endOfChain, 0)...)
+	dir = append(dir, dirEntry("Workbook", 2, noStream, 2, uint32(len(padded)))...)
+	dir = append(dir, make([]byte, sectorSize-len(dir))...)
+
+	file := append(header, fat...)
+	file = append(file, dir...)
+	return append(file, padded...)
+}
+
+// writeXlsFile 在 dir 下生成一个真实的 BIFF8 .xls 文件
+func writeXlsFile(t *testing.T, dir, name string, rows [][]interface{}) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, buildOleFile(buildXlsWorkbook("Sheet1", rows)), 0644); err != nil {
+		t.Fatalf("写入 .xls 文件失败: %v", err)
+	}
+	return path
+}
+
+func assertXlsItems(t *testing.T, items []*XlsItemConfig) {
+	t.Helper()
+	expected := []XlsItemConfig{{1, "sword", 10}, {2, "盾牌", 20}}
+	if len(items) != len(expected) {
+		t.Fatalf("期望 %d 条配置，实际 %d 条: %+v", len(expected), len(items), items)
+	}
+	for i, want := range expected {
+		if *items[i] != want {
+			t.Errorf("第 %d 条配置错误: 期望 %+v，实际 %+v", i, want, items[i])
+		}
+	}
+}
+
+// TestExcelHandler_ReadXls 测试 ExcelConfigHandler 按扩展名读取旧版 .xls
+func TestExcelHandler_ReadXls(t *testing.T) {
+	path := writeXlsFile(t, t.TempDir(), "XlsItemConfig.xls", xlsItemRows)
+
+	handler := &excel.ExcelConfigHandler{}
+	list := mustReadORM(t, handler, reflect.TypeOf(XlsItemConfig{}), "XlsItemConfig", path)
+	items := make([]*XlsItemConfig, 0, len(list))
+	for _, item := range list {
+		v := item.(XlsItemConfig)
+		items = append(items, &v)
+	}
+	assertXlsItems(t, items)
+}
+
+// TestExcelHandler_ReadXlsFromBytes 测试内存内容按文件头识别为 .xls
+func TestExcelHandler_ReadXlsFromBytes(t *testing.T) {
+	data := buildOleFile(buildXlsWorkbook("Sheet1", xlsItemRows))
+
+	handler := &excel.ExcelConfigHandler{}
+	result, err := handler.ReadToFrontEndDataListFromBytes("XlsItemConfig", data)
+	if err != nil {
+		t.Fatalf("读取 .xls 内容失败: %v", err)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
+	if len(configDto.DataList) != 2 || configDto.DataList[1]["name"] != "盾牌" {
+		t.Errorf("读取的数据错误: %+v", configDto.DataList)
+	}
+}
+
+// TestExcelHandler_ReadXlsMissingSheet 测试 .xls 中指定不存在的工作表时返回错误
+func TestExcelHandler_ReadXlsMissingSheet(t *testing.T) {
+	path := writeXlsFile(t, t.TempDir(), "XlsItemConfig.xls", xlsItemRows)
+
+	handler := &excel.ExcelConfigHandler{SheetName: "Missing"}
+	if _, err := handler.ReadToFrontEndDataList("XlsItemConfig", path); err == nil || !strings.Contains(err.Error(), "Missing") {
+		t.Errorf("指定不存在的工作表时应返回错误: %v", err)
+	}
+}
+
+// TestExcelHandler_ReadCorruptXls 测试损坏的 .xls 返回清晰的错误而不是 panic
+func TestExcelHandler_ReadCorruptXls(t *testing.T) {
+	data := buildOleFile(buildXlsWorkbook("Sheet1", xlsItemRows))
+	path := filepath.Join(t.TempDir(), "CorruptConfig.xls")
+	if err := os.WriteFile(path, data[:600], 0644); err != nil {
+		t.Fatalf("写入 .xls 文件失败: %v", err)
+	}
+
+	handler := &excel.ExcelConfigHandler{}
+	_, err := handler.ReadToFrontEndDataList("CorruptConfig", path)
+	if err == nil || !strings.Contains(err.Error(), "xlsx") {
+		t.Errorf("损坏的 .xls 应返回提示另存为 xlsx 的错误: %v", err)
+	}
+}
+
+// TestConfigManager233_LoadXls 测试配置管理器加载目录中的 .xls 配置
+func TestConfigManager233_LoadXls(t *testing.T) {
+	tempDir := t.TempDir()
+	writeXlsFile(t, tempDir, "XlsItemConfig.xls", xlsItemRows)
+
+	manager := config233.NewStandaloneConfigManager233(tempDir)
+	manager.RegisterType(reflect.TypeOf(XlsItemConfig{}))
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	assertXlsItems(t, config233.GetConfigListFrom[XlsItemConfig](manager))
+}
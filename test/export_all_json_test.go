@@ -0,0 +1,75 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// ExportAllItemConfig 聚合导出测试使用的已注册配置
+type ExportAllItemConfig struct {
+	Id    int      `json:"id"`
+	Level int      `json:"level"`
+	Tags  []string `json:"tags"`
+}
+
+// TestExportAllConfigsToJSON 测试所有配置按 配置名 -> ID -> 配置 聚合导出，已注册类型按结构体序列化
+func TestExportAllConfigsToJSON(t *testing.T) {
+	tempDir := t.TempDir()
+	// TSV 中的值都是字符串，转换为结构体后 level 为数字、tags 为数组
+	writeTextFile(t, tempDir, "ExportAllItemConfig.tsv", "id\tlevel\ttags\n1\t5\ta,b\n2\t7\t\n")
+	writeTextFile(t, tempDir, "ExportAllRawConfig.json", `[{"id": "x", "value": 1.5}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[ExportAllItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := manager.ExportAllConfigsToJSON(&buf); err != nil {
+		t.Fatalf("导出失败: %v", err)
+	}
+
+	var got map[string]map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("导出内容不是合法 JSON: %v\n%s", err, buf.String())
+	}
+	want := map[string]map[string]interface{}{
+		"ExportAllItemConfig": {
+			"1": map[string]interface{}{"id": 1.0, "level": 5.0, "tags": []interface{}{"a", "b"}},
+			"2": map[string]interface{}{"id": 2.0, "level": 7.0, "tags": []interface{}{}},
+		},
+		"ExportAllRawConfig": {
+			"x": map[string]interface{}{"id": "x", "value": 1.5},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("聚合导出内容不正确:\n期望 %v\n实际 %v", want, got)
+	}
+
+	// 相同数据两次导出结果一致，便于 diff
+	var again bytes.Buffer
+	if err := manager.ExportAllConfigsToJSON(&again); err != nil {
+		t.Fatalf("导出失败: %v", err)
+	}
+	if again.String() != buf.String() {
+		t.Error("相同数据的导出结果应保持一致")
+	}
+}
+
+// TestExportAllConfigsToJSON_Empty 测试没有加载任何配置时导出空对象
+func TestExportAllConfigsToJSON_Empty(t *testing.T) {
+	manager := config233.NewStandaloneConfigManager233(t.TempDir())
+	var buf bytes.Buffer
+	if err := manager.ExportAllConfigsToJSON(&buf); err != nil {
+		t.Fatalf("导出失败: %v", err)
+	}
+	if got := bytes.TrimSpace(buf.Bytes()); string(got) != "{}" {
+		t.Errorf("期望 {}，实际 %s", got)
+	}
+}
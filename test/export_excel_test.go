@@ -0,0 +1,115 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportReward 导出测试中的嵌套结构体
+type ExportReward struct {
+	ItemId int `json:"itemId"`
+	Count  int `json:"count"`
+}
+
+// ExportItemConfig 用于测试导出 Excel 的配置，包含嵌套结构体与切片字段
+type ExportItemConfig struct {
+	Id      int            `json:"id"`
+	Name    string         `json:"name"`
+	Reward  ExportReward   `json:"reward"`
+	Bonus   *ExportReward  `json:"bonus"`
+	Tags    []string       `json:"tags" config233_sep:"|"`
+	Levels  []int          `json:"levels"`
+	Rewards []ExportReward `json:"rewards"`
+	Secret  string         `json:"-"`
+}
+
+// readExportedRows 读取导出的 Excel 文件第一个工作表
+func readExportedRows(t *testing.T, path string) [][]string {
+	t.Helper()
+	f, err := excelize.OpenFile(path)
+	if err != nil {
+		t.Fatalf("打开导出的 Excel 失败: %v", err)
+	}
+	defer f.Close()
+	rows, err := f.GetRows(f.GetSheetName(0))
+	if err != nil {
+		t.Fatalf("读取导出的 Excel 失败: %v", err)
+	}
+	return rows
+}
+
+// TestConfigManager233_ExportConfigToExcel 测试嵌套结构体与切片被扁平化导出，导出目录自动创建
+func TestConfigManager233_ExportConfigToExcel(t *testing.T) {
+	exportDir := filepath.Join(t.TempDir(), "nested", "export")
+	manager := config233.NewStandaloneConfigManager233(t.TempDir())
+	manager.SetLoadDoneWriteConfigFileDir(exportDir).SetIsOpenWriteExcelFileToSeeMemoryConfig(true)
+
+	manager.ExportConfigToExcel("ExportItemConfig", []interface{}{
+		&ExportItemConfig{
+			Id: 1, Name: "sword",
+			Reward:  ExportReward{ItemId: 100, Count: 2},
+			Bonus:   &ExportReward{ItemId: 200, Count: 1},
+			Tags:    []string{"a", "b"},
+			Levels:  []int{1, 2, 3},
+			Rewards: []ExportReward{{ItemId: 1, Count: 1}},
+			Secret:  "x",
+		},
+		ExportItemConfig{Id: 2, Name: "shield", Reward: ExportReward{ItemId: 101, Count: 3}},
+	})
+
+	rows := readExportedRows(t, filepath.Join(exportDir, "ExportItemConfig.xlsx"))
+	expected := [][]string{
+		{"id", "name", "reward.itemId", "reward.count", "bonus.itemId", "bonus.count", "tags", "levels", "rewards"},
+		{"1", "sword", "100", "2", "200", "1", "a|b", "1,2,3", `[{"itemId":1,"count":1}]`},
+		{"2", "shield", "101", "3"},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("导出内容错误:\n期望 %q\n实际 %q", expected, rows)
+	}
+}
+
+// TestConfigManager233_ExportConfigToExcelOnLoad 测试加载完成后自动导出，未注册类型的配置按 key 排序展开
+func TestConfigManager233_ExportConfigToExcelOnLoad(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "ExportItemConfig.json", `[{"id": 1, "name": "sword", "tags": ["a", "b"], "levels": [1, 2]}]`)
+	writeTextFile(t, tempDir, "ExportMapConfig.json", `[{"name": "raw", "id": 7}]`)
+	exportDir := filepath.Join(t.TempDir(), "export")
+
+	manager := config233.NewStandaloneConfigManager233(tempDir)
+	manager.RegisterType(reflect.TypeOf(ExportItemConfig{}))
+	manager.SetLoadDoneWriteConfigFileDir(exportDir).SetIsOpenWriteExcelFileToSeeMemoryConfig(true)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	rows := readExportedRows(t, filepath.Join(exportDir, "ExportItemConfig.xlsx"))
+	if len(rows) != 2 || rows[1][0] != "1" || rows[1][1] != "sword" || rows[1][6] != "a|b" || rows[1][7] != "1,2" {
+		t.Errorf("已注册类型的导出内容错误: %q", rows)
+	}
+
+	rows = readExportedRows(t, filepath.Join(exportDir, "ExportMapConfig.xlsx"))
+	if !reflect.DeepEqual(rows, [][]string{{"id", "name"}, {"7", "raw"}}) {
+		t.Errorf("未注册类型的导出内容错误: %q", rows)
+	}
+}
+
+// TestConfigManager233_ExportConfigToExcelDisabled 测试未开启时不导出
+func TestConfigManager233_ExportConfigToExcelDisabled(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "ExportItemConfig.json", `[{"id": 1, "name": "sword"}]`)
+	exportDir := filepath.Join(t.TempDir(), "export")
+
+	manager := config233.NewStandaloneConfigManager233(tempDir)
+	manager.SetLoadDoneWriteConfigFileDir(exportDir)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if _, err := excelize.OpenFile(filepath.Join(exportDir, "ExportItemConfig.xlsx")); err == nil {
+		t.Error("未开启 Excel 导出时不应生成文件")
+	}
+}
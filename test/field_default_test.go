@@ -0,0 +1,86 @@
+package test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// DefaultItemConfig 用于测试字段默认值的配置
+type DefaultItemConfig struct {
+	Id       int           `json:"id"`
+	Stack    int           `json:"stack" config233_default:"100"`
+	Quality  string        `json:"quality" config233_default:"common"`
+	Tradable bool          `json:"tradable" config233_default:"true"`
+	Rate     float64       `json:"rate" config233_default:"0.5"`
+	Cooldown time.Duration `json:"cooldown" config233_default:"5s"`
+	Limit    *int          `json:"limit" config233_default:"3"`
+	Name     string        `json:"name" config233_required:"true" config233_default:"unnamed"`
+}
+
+// checkDefaultItems 校验第 1 条全部走默认值、第 2 条填写的值不被覆盖
+func checkDefaultItems(t *testing.T) {
+	t.Helper()
+	empty, ok := config233.GetConfigById[DefaultItemConfig](1)
+	if !ok {
+		t.Fatal("配置 1 应被加载")
+	}
+	if empty.Stack != 100 || empty.Quality != "common" || !empty.Tradable || empty.Rate != 0.5 ||
+		empty.Cooldown != 5*time.Second || empty.Name != "unnamed" {
+		t.Errorf("空值字段应使用默认值: %+v", empty)
+	}
+	if empty.Limit == nil || *empty.Limit != 3 {
+		t.Errorf("未填写的指针字段应分配默认值: %v", empty.Limit)
+	}
+
+	filled, ok := config233.GetConfigById[DefaultItemConfig](2)
+	if !ok {
+		t.Fatal("配置 2 应被加载")
+	}
+	if filled.Stack != 20 || filled.Quality != "rare" || filled.Rate != 1.5 ||
+		filled.Cooldown != time.Second || filled.Name != "potion" {
+		t.Errorf("已填写的值不应被默认值覆盖: %+v", filled)
+	}
+	if filled.Limit == nil || *filled.Limit != 0 {
+		t.Errorf("指针字段填写了 0 时不应被默认值覆盖: %v", filled.Limit)
+	}
+}
+
+// TestFieldDefault_Json 测试 JSON 缺省字段使用默认值，有值时不覆盖
+func TestFieldDefault_Json(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "DefaultItemConfig.json", `[
+		{"id": 1},
+		{"id": 2, "stack": 20, "quality": "rare", "tradable": false, "rate": 1.5, "cooldown": "1s", "limit": 0, "name": "potion"}
+	]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[DefaultItemConfig]()
+	// 有默认值的必填字段不算缺失，严格模式下也能加载
+	if err := manager.LoadAllConfigsStrict(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	checkDefaultItems(t)
+}
+
+// TestFieldDefault_Excel 测试 Excel 空单元格使用默认值，有值时不覆盖
+func TestFieldDefault_Excel(t *testing.T) {
+	path := createExcelWithRows(t, "DefaultItemConfig.xlsx", [][]interface{}{
+		{"Client", "id", "stack", "quality", "tradable", "rate", "cooldown", "limit", "name"},
+		{"type", "int", "int", "string", "bool", "float", "string", "int", "string"},
+		{"Server", "id", "stack", "quality", "tradable", "rate", "cooldown", "limit", "name"},
+		{"", 1, "", "", "", "", "", "", ""},
+		{"", 2, 20, "rare", "false", 1.5, "1s", 0, "potion"},
+	})
+
+	manager := config233.NewConfigManager233(filepath.Dir(path))
+	config233.Instance = manager
+	config233.RegisterType[DefaultItemConfig]()
+	if err := manager.LoadAllConfigsStrict(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	checkDefaultItems(t)
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// RequiredItemConfig 用于测试必填字段校验的配置
+type RequiredItemConfig struct {
+	Id     int      `json:"id"`
+	Name   string   `json:"name" config233_required:"true"`
+	Level  int      `json:"level" config233_required:"true"`
+	Weight int      `json:"weight" config233_required:"true" config233_allow_zero:"true"`
+	Tags   []string `json:"tags" config233_required:"true"`
+	Desc   string   `json:"desc"`
+}
+
+func setupRequiredManager(t *testing.T, content string) *config233.ConfigManager233 {
+	t.Helper()
+	manager, _ := newTestManager(t, map[string]string{"RequiredItemConfig.json": content},
+		config233.RegisterType[RequiredItemConfig])
+	return manager
+}
+
+// TestFieldRules_RequiredStrict 测试严格模式下必填字段缺失导致加载失败，错误包含配置名、ID 与字段名
+func TestFieldRules_RequiredStrict(t *testing.T) {
+	manager := setupRequiredManager(t, `[
+		{"id": 1, "name": "sword", "level": 1, "weight": 0, "tags": ["a"]},
+		{"id": 2, "name": " ", "level": 0, "weight": 0, "tags": ["a"]},
+		{"id": 3, "name": "bow", "level": 3, "tags": []}
+	]`)
+
+	err := manager.LoadAllConfigsStrict()
+	if err == nil {
+		t.Fatal("存在必填字段缺失时严格模式加载应失败")
+	}
+	var problems config233.ConfigValidationErrors
+	if !errors.As(err, &problems) {
+		t.Fatalf("错误类型应为 ConfigValidationErrors: %T", err)
+	}
+
+	got := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		if problem.ConfigName != "RequiredItemConfig" {
+			t.Errorf("配置名应为 RequiredItemConfig，实际 %s", problem.ConfigName)
+		}
+		got = append(got, problem.Id+"."+problem.Field)
+	}
+	expected := []string{"2.Level", "2.Name", "3.Tags"}
+	if len(got) != len(expected) {
+		t.Fatalf("期望问题 %v，实际 %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("期望问题 %v，实际 %v", expected, got)
+			break
+		}
+	}
+	if count := config233.GetConfigListCount[RequiredItemConfig](); count != 0 {
+		t.Errorf("严格模式失败时不应写入任何配置，实际 %d 条", count)
+	}
+}
+
+// TestFieldRules_RequiredLenient 测试宽松模式下必填字段缺失只记录日志，配置照常加载
+func TestFieldRules_RequiredLenient(t *testing.T) {
+	manager := setupRequiredManager(t, `[
+		{"id": 1, "name": "sword", "level": 1, "tags": ["a"]},
+		{"id": 2, "level": 2, "tags": ["a"]}
+	]`)
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("宽松模式不应因必填字段返回错误: %v", err)
+	}
+	if count := config233.GetConfigListCount[RequiredItemConfig](); count != 2 {
+		t.Errorf("宽松模式应照常加载全部配置，实际 %d 条", count)
+	}
+}
+
+// TestFieldRules_RequiredAllValid 测试允许零值的必填字段为 0 时视为已填写
+func TestFieldRules_RequiredAllValid(t *testing.T) {
+	manager := setupRequiredManager(t, `[{"id": 1, "name": "sword", "level": 1, "weight": 0, "tags": ["a"]}]`)
+
+	if err := manager.LoadAllConfigsStrict(); err != nil {
+		t.Fatalf("必填字段均已填写时不应返回错误: %v", err)
+	}
+	if item, ok := config233.GetConfigById[RequiredItemConfig](1); !ok || item.Weight != 0 {
+		t.Errorf("配置应被加载: %+v", item)
+	}
+}
+
+// RangeItemConfig 用于测试数值范围校验的配置
+type RangeItemConfig struct {
+	Id    int     `json:"id"`
+	Level int     `json:"level" config233_min:"1" config233_max:"100"`
+	Rate  float64 `json:"rate" config233_min:"0" config233_max:"1"`
+	Stack uint32  `json:"stack" config233_max:"99"`
+}
+
+// TestFieldRules_Range 测试越界值被收集为错误，边界值合法
+func TestFieldRules_Range(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "RangeItemConfig.json", `[
+		{"id": 1, "level": 1, "rate": 0, "stack": 0},
+		{"id": 2, "level": 100, "rate": 1, "stack": 99},
+		{"id": 3, "level": 0, "rate": 0.5, "stack": 1},
+		{"id": 4, "level": 101, "rate": 1.01, "stack": 100},
+		{"id": 5, "level": 50, "rate": -0.1, "stack": 1}
+	]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[RangeItemConfig]()
+
+	err := manager.LoadAllConfigsStrict()
+	if err == nil {
+		t.Fatal("存在越界值时严格模式加载应失败")
+	}
+	var problems config233.ConfigValidationErrors
+	if !errors.As(err, &problems) {
+		t.Fatalf("错误类型应为 ConfigValidationErrors: %T", err)
+	}
+
+	got := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		got = append(got, problem.Id+"."+problem.Field)
+	}
+	expected := []string{"3.Level", "4.Level", "4.Rate", "4.Stack", "5.Rate"}
+	if len(got) != len(expected) {
+		t.Fatalf("期望问题 %v，实际 %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("期望问题 %v，实际 %v", expected, got)
+			break
+		}
+	}
+	if msg := problems[1].Error(); !strings.Contains(msg, "Level") || !strings.Contains(msg, "101") || !strings.Contains(msg, "100") {
+		t.Errorf("错误信息应包含字段名、实际值与边界: %s", msg)
+	}
+
+	// 宽松模式照常加载
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("宽松模式不应因范围校验返回错误: %v", err)
+	}
+	if count := config233.GetConfigListCount[RangeItemConfig](); count != 5 {
+		t.Errorf("宽松模式应照常加载全部配置，实际 %d 条", count)
+	}
+}
@@ -54,7 +54,10 @@ func TestFishingWeaponConfig_Parse(t *testing.T) {
 	t.Run("debug_read_headers_and_dump_json", func(t *testing.T) {
 		h := &excel.ExcelConfigHandler{}
 		full := filepath.Join(testDir, "FishingWeaponConfig.xlsx")
-		res := h.ReadToFrontEndDataList("FishingWeaponConfig", full)
+		res, err := h.ReadToFrontEndDataList("FishingWeaponConfig", full)
+		if err != nil {
+			t.Fatalf("读取 FishingWeaponConfig 失败: %v", err)
+		}
 		d, ok := res.(*dto.FrontEndConfigDto)
 		if !ok {
 			t.Fatalf("无法将结果断言为 FrontEndConfigDto，可能解析失败: %#v", res)
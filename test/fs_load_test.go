@@ -0,0 +1,177 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// FsJsonConfig 用于测试从 fs.FS 加载 JSON 配置
+type FsJsonConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// FsTsvConfig 用于测试从 fs.FS 加载 TSV 配置
+type FsTsvConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// FsYamlConfig 用于测试从 fs.FS 加载 YAML 配置
+type FsYamlConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// FsXmlConfig 用于测试从 fs.FS 加载 XML 配置
+type FsXmlConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// FsExcelConfig 用于测试从 fs.FS 加载 Excel 配置
+type FsExcelConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// buildExcelBytes 在内存中生成一个单行表头的 xlsx 文件
+func buildExcelBytes(t *testing.T, rows [][]interface{}) []byte {
+	t.Helper()
+	f := excelize.NewFile()
+	defer f.Close()
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatalf("写入 Excel 行失败: %v", err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatalf("生成 Excel 内容失败: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// TestConfigManager233_LoadFromFS 测试从 fs.FS 加载所有格式的配置
+func TestConfigManager233_LoadFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"configs/FsJsonConfig.json":      {Data: []byte(`[{"id": 1, "name": "json"}]`)},
+		"configs/sub/FsTsvConfig.tsv":    {Data: []byte("id\tname\n1\ttsv\n")},
+		"configs/FsYamlConfig.yaml":      {Data: []byte("- id: 1\n  name: yaml\n")},
+		"configs/FsXmlConfig.xml":        {Data: []byte(`<root><item id="1" name="xml"/></root>`)},
+		"configs/FsExcelConfig.xlsx":     {Data: buildExcelBytes(t, [][]interface{}{{"id", "name"}, {1, "excel"}})},
+		"configs/.hidden/Ignored.json":   {Data: []byte(`[{"id": 1}]`)},
+		"configs/~$FsExcelConfig.xlsx":   {Data: []byte("lock file")},
+		"other/OutsideRootConfig.json":   {Data: []byte(`[{"id": 1}]`)},
+		"configs/readme.txt":             {Data: []byte("not a config")},
+		"configs/sub/FsEmptyConfig.json": {Data: []byte("")},
+	}
+
+	manager := config233.NewConfigManager233FromFS(fsys, "configs")
+	config233.Instance = manager
+	config233.RegisterType[FsJsonConfig]()
+	config233.RegisterType[FsTsvConfig]()
+	config233.RegisterType[FsYamlConfig]()
+	config233.RegisterType[FsXmlConfig]()
+	config233.RegisterType[FsExcelConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("从 fs.FS 加载配置失败: %v", err)
+	}
+
+	if cfg, ok := config233.GetConfigById[FsJsonConfig](1); !ok || cfg.Name != "json" {
+		t.Errorf("JSON 配置加载错误: %+v", cfg)
+	}
+	if cfg, ok := config233.GetConfigById[FsTsvConfig](1); !ok || cfg.Name != "tsv" {
+		t.Errorf("子目录中的 TSV 配置加载错误: %+v", cfg)
+	}
+	if cfg, ok := config233.GetConfigById[FsYamlConfig](1); !ok || cfg.Name != "yaml" {
+		t.Errorf("YAML 配置加载错误: %+v", cfg)
+	}
+	if cfg, ok := config233.GetConfigById[FsXmlConfig](1); !ok || cfg.Name != "xml" {
+		t.Errorf("XML 配置加载错误: %+v", cfg)
+	}
+	if cfg, ok := config233.GetConfigById[FsExcelConfig](1); !ok || cfg.Name != "excel" {
+		t.Errorf("Excel 配置加载错误: %+v", cfg)
+	}
+
+	for _, name := range manager.GetLoadedConfigNames() {
+		if name == "Ignored" || name == "OutsideRootConfig" {
+			t.Errorf("配置 %s 不应被加载", name)
+		}
+	}
+
+	// embed 模式下文件监听为 no-op
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("fs.FS 模式下 StartWatching 不应返回错误: %v", err)
+	}
+	if err := manager.StopWatching(); err != nil {
+		t.Fatalf("fs.FS 模式下 StopWatching 不应返回错误: %v", err)
+	}
+
+	// 重新使用磁盘目录后不再读取 fs.FS
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "FsJsonConfig.json", `[{"id": 1, "name": "disk"}]`)
+	manager = config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[FsJsonConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("从磁盘加载配置失败: %v", err)
+	}
+	if cfg, ok := config233.GetConfigById[FsJsonConfig](1); !ok || cfg.Name != "disk" {
+		t.Errorf("切换回磁盘目录后应读取磁盘配置: %+v", cfg)
+	}
+}
+
+// TestConfigManager233_LoadFromFSRoot 测试 root 为空时遍历整个 fs.FS
+func TestConfigManager233_LoadFromFSRoot(t *testing.T) {
+	fsys := fstest.MapFS{
+		"FsJsonConfig.json": {Data: []byte(`[{"id": 2, "name": "root"}]`)},
+	}
+
+	manager := config233.NewConfigManager233FromFS(fsys, "")
+	config233.Instance = manager
+	config233.RegisterType[FsJsonConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("从 fs.FS 根目录加载配置失败: %v", err)
+	}
+	if cfg, ok := config233.GetConfigById[FsJsonConfig](2); !ok || cfg.Name != "root" {
+		t.Errorf("根目录配置加载错误: %+v", cfg)
+	}
+}
+
+// TestExcelConfigHandler_FromBytes 测试 Excel 处理器从内存内容读取
+func TestExcelConfigHandler_FromBytes(t *testing.T) {
+	data := buildExcelBytes(t, [][]interface{}{{"id", "name"}, {1, "sword"}, {2, "shield"}})
+	handler := &excel.ExcelConfigHandler{}
+
+	result, err := handler.ReadToFrontEndDataListFromBytes("FsExcelConfig", data)
+	if err != nil {
+		t.Fatalf("从内存读取 Excel 失败: %v", err)
+	}
+	configDto := result.(*dto.FrontEndConfigDto)
+	if len(configDto.DataList) != 2 || configDto.DataList[1]["name"] != "shield" {
+		t.Fatalf("Excel 内容解析错误: %v", configDto.DataList)
+	}
+
+	list, err := handler.ReadConfigAndORMFromBytes(reflect.TypeOf(FsExcelConfig{}), "FsExcelConfig", data)
+	if err != nil {
+		t.Fatalf("从内存读取 Excel 对象失败: %v", err)
+	}
+	if len(list) != 2 || list[0].(FsExcelConfig).Name != "sword" {
+		t.Errorf("Excel 对象解析错误: %+v", list)
+	}
+
+	if _, err := handler.ReadToFrontEndDataListFromBytes("Broken", []byte("not a zip file")); err == nil {
+		t.Error("无法解析的 Excel 内容应返回错误")
+	}
+}
@@ -37,3 +37,238 @@ func TestGenericAccess_ItemConfig(t *testing.T) {
 		t.Fatalf("expected unloaded config count to be 0, got %d", count)
 	}
 }
+
+func TestGenericAccess_GetConfigListByFilter(t *testing.T) {
+	manager := config233.NewConfigManager233("../testdata")
+	config233.Instance = manager
+	config233.RegisterType[ItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("load configs failed: %v", err)
+	}
+
+	all := config233.GetConfigList[ItemConfig]()
+	expected := 0
+	for _, item := range all {
+		if item.Quality > 3 {
+			expected++
+		}
+	}
+
+	filtered := config233.GetConfigListByFilter[ItemConfig](func(item *ItemConfig) bool {
+		return item.Quality > 3
+	})
+	if len(filtered) != expected {
+		t.Fatalf("expected %d items with quality>3, got %d", expected, len(filtered))
+	}
+	for _, item := range filtered {
+		if item.Quality <= 3 {
+			t.Fatalf("unexpected item in filtered list: %+v", item)
+		}
+	}
+
+	if list := config233.GetConfigListByFilter[ItemConfig](nil); len(list) != len(all) {
+		t.Fatalf("nil predicate should return all items, got %d want %d", len(list), len(all))
+	}
+
+	missing := config233.GetConfigListByFilter[struct{ Missing bool }](func(*struct{ Missing bool }) bool { return true })
+	if missing == nil || len(missing) != 0 {
+		t.Fatalf("expected empty non-nil slice for unloaded config, got %v", missing)
+	}
+}
+
+func TestGenericAccess_GetConfigListSorted(t *testing.T) {
+	manager := config233.NewConfigManager233("../testdata")
+	config233.Instance = manager
+	config233.RegisterType[ItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("load configs failed: %v", err)
+	}
+
+	before := config233.GetConfigList[ItemConfig]()
+	if len(before) < 2 {
+		t.Fatalf("expected at least 2 ItemConfig items, got %d", len(before))
+	}
+
+	sorted := config233.GetConfigListSorted[ItemConfig](func(a, b *ItemConfig) bool {
+		return a.Itemid > b.Itemid
+	})
+	if len(sorted) != len(before) {
+		t.Fatalf("sorted list length mismatch, got %d want %d", len(sorted), len(before))
+	}
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Itemid < sorted[i].Itemid {
+			t.Fatalf("list not sorted by Itemid desc at %d: %d < %d", i, sorted[i-1].Itemid, sorted[i].Itemid)
+		}
+	}
+
+	// 排序不影响内部存储的顺序
+	after := config233.GetConfigList[ItemConfig]()
+	for i := range before {
+		if before[i] != after[i] {
+			t.Fatalf("internal list order changed at %d", i)
+		}
+	}
+
+	// 比较结果相同的配置保持加载顺序
+	stable := config233.GetConfigListSorted[ItemConfig](func(a, b *ItemConfig) bool { return false })
+	for i := range before {
+		if stable[i] != before[i] {
+			t.Fatalf("equal elements should keep load order at %d", i)
+		}
+	}
+
+	missing := config233.GetConfigListSorted[struct{ Missing bool }](nil)
+	if missing == nil || len(missing) != 0 {
+		t.Fatalf("expected empty non-nil slice for unloaded config, got %v", missing)
+	}
+}
+
+func TestGenericAccess_ForEachConfig(t *testing.T) {
+	manager := config233.NewConfigManager233("../testdata")
+	config233.Instance = manager
+	config233.RegisterType[ItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("load configs failed: %v", err)
+	}
+
+	list := config233.GetConfigList[ItemConfig]()
+	if len(list) < 2 {
+		t.Fatalf("expected at least 2 ItemConfig items, got %d", len(list))
+	}
+
+	var visited []*ItemConfig
+	config233.ForEachConfig[ItemConfig](func(item *ItemConfig) bool {
+		visited = append(visited, item)
+		return true
+	})
+	if len(visited) != len(list) {
+		t.Fatalf("expected to visit %d items, got %d", len(list), len(visited))
+	}
+	for i := range list {
+		if visited[i] != list[i] {
+			t.Fatalf("ForEachConfig should visit cached items in load order, mismatch at %d", i)
+		}
+	}
+
+	// fn 返回 false 时提前终止
+	count := 0
+	config233.ForEachConfig[ItemConfig](func(item *ItemConfig) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Fatalf("expected iteration to stop after first item, got %d calls", count)
+	}
+
+	config233.ForEachConfig[struct{ Missing bool }](func(*struct{ Missing bool }) bool {
+		t.Fatal("fn should not be called for unloaded config")
+		return true
+	})
+}
+
+func TestGenericAccess_GetConfigByIds(t *testing.T) {
+	manager := config233.NewConfigManager233("../testdata")
+	config233.Instance = manager
+	config233.RegisterType[ItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("load configs failed: %v", err)
+	}
+
+	found, missing := config233.GetConfigByIds[ItemConfig]([]string{"999999", "1", "888888"})
+	if len(found) != 1 || found["1"] == nil || found["1"].Itemid != 1 {
+		t.Fatalf("expected id=1 to be found, got %v", found)
+	}
+	if len(missing) != 2 || missing[0] != "999999" || missing[1] != "888888" {
+		t.Fatalf("expected missing ids in original order, got %v", missing)
+	}
+
+	found, missing = config233.GetConfigByIds[ItemConfig](nil)
+	if found == nil || len(found) != 0 || len(missing) != 0 {
+		t.Fatalf("expected empty result for empty input, got found=%v missing=%v", found, missing)
+	}
+
+	unloaded, missing := config233.GetConfigByIds[struct{ Missing bool }]([]string{"1"})
+	if len(unloaded) != 0 || len(missing) != 1 {
+		t.Fatalf("expected all ids missing for unloaded config, got found=%v missing=%v", unloaded, missing)
+	}
+}
+
+func TestGenericAccess_GetConfigMapReturnsCopy(t *testing.T) {
+	manager := config233.NewConfigManager233("../testdata")
+	config233.Instance = manager
+	config233.RegisterType[ItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("load configs failed: %v", err)
+	}
+
+	first := config233.GetConfigMap[ItemConfig]()
+	if len(first) == 0 || first["1"] == nil {
+		t.Fatalf("expected non-empty ItemConfig map containing id=1, got %v", first)
+	}
+	size := len(first)
+
+	delete(first, "1")
+	first["injected"] = &ItemConfig{Itemid: -1}
+
+	second := config233.GetConfigMap[ItemConfig]()
+	if len(second) != size {
+		t.Fatalf("modifying returned map should not affect the next call, got len=%d want %d", len(second), size)
+	}
+	if second["1"] == nil {
+		t.Fatal("deleted key should still exist in the next GetConfigMap result")
+	}
+	if _, ok := second["injected"]; ok {
+		t.Fatal("added key should not leak into the next GetConfigMap result")
+	}
+	if cfg, ok := config233.GetConfigById[ItemConfig]("1"); !ok || cfg != second["1"] {
+		t.Fatal("map values should share the cached *T pointers")
+	}
+}
+
+// NormalizeIdConfig is loaded from JSON, where numeric ids are decoded as float64
+type NormalizeIdConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestGenericAccess_GetConfigByIdNormalizesId(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "NormalizeIdConfig.json",
+		`[{"id": 1, "name": "one"}, {"id": 1000000, "name": "million"}]`)
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[NormalizeIdConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("load configs failed: %v", err)
+	}
+
+	want, ok := config233.GetConfigById[NormalizeIdConfig](1)
+	if !ok || want.Name != "one" {
+		t.Fatalf("expected id=1 to be found, got %v", want)
+	}
+	for _, id := range []interface{}{"1", " 1 ", int64(1), 1.0, float32(1)} {
+		if cfg, ok := config233.GetConfigById[NormalizeIdConfig](id); !ok || cfg != want {
+			t.Errorf("id %#v should hit the same item as 1, got %v", id, cfg)
+		}
+	}
+	if cfg, ok := config233.GetConfigById[NormalizeIdConfig](1000000.0); !ok || cfg.Name != "million" {
+		t.Errorf("large float id should not be indexed in scientific notation, got %v", cfg)
+	}
+	if _, ok := config233.GetConfigById[NormalizeIdConfig](1.5); ok {
+		t.Error("non-integral float id should not match id=1")
+	}
+
+	found, missing := config233.GetConfigByIds[NormalizeIdConfig]([]string{" 1", "1000000", "2"})
+	if len(found) != 2 || found[" 1"] != want || found["1000000"] == nil {
+		t.Fatalf("expected ids to be normalized before lookup, got %v", found)
+	}
+	if len(missing) != 1 || missing[0] != "2" {
+		t.Fatalf("expected only id=2 to be missing, got %v", missing)
+	}
+}
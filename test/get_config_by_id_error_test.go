@@ -0,0 +1,50 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// ByIdErrorConfig 已注册的配置
+type ByIdErrorConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ByIdMissingConfig 没有配置文件的配置
+type ByIdMissingConfig struct {
+	Id int `json:"id"`
+}
+
+// TestGetConfigByIdE 测试找到配置、表中没有该 ID 与配置表未加载三种情况
+func TestGetConfigByIdE(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "ByIdErrorConfig.json", `[{"id": 1, "name": "sword"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[ByIdErrorConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	item, err := config233.GetConfigByIdE[ByIdErrorConfig](1)
+	if err != nil || item == nil || item.Name != "sword" {
+		t.Errorf("应找到配置: %+v, %v", item, err)
+	}
+
+	if _, err := config233.GetConfigByIdE[ByIdErrorConfig]("404"); !errors.Is(err, config233.ErrConfigIdNotFound) {
+		t.Errorf("表已加载但没有该 ID 时应返回 ErrConfigIdNotFound，实际: %v", err)
+	}
+	_, err = config233.GetConfigByIdEFrom[ByIdMissingConfig](manager, 1)
+	if !errors.Is(err, config233.ErrConfigTypeNotLoaded) || errors.Is(err, config233.ErrConfigIdNotFound) {
+		t.Errorf("没有配置文件时应返回 ErrConfigTypeNotLoaded，实际: %v", err)
+	}
+
+	// 布尔版本保持不变
+	if _, ok := config233.GetConfigById[ByIdErrorConfig]("404"); ok {
+		t.Error("GetConfigById 找不到时应返回 false")
+	}
+}
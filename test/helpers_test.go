@@ -0,0 +1,63 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// writeTextFile 在 dir 中写入测试文件，返回文件路径
+func writeTextFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("写入测试文件失败: %v", err)
+	}
+	return path
+}
+
+// writeTestFiles 在新的临时目录中写入测试文件，返回该目录
+// 参数:
+//
+//	files: 文件名到文件内容的映射
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		writeTextFile(t, dir, name, content)
+	}
+	return dir
+}
+
+// newTestManagerAt 以 dir 为配置目录重置全局管理器并设为全局实例，再依次执行类型注册
+// 参数:
+//
+//	dir: 配置目录
+//	register: 注册配置类型的函数，如 config233.RegisterType[ItemConfig]
+func newTestManagerAt(t *testing.T, dir string, register ...func()) *config233.ConfigManager233 {
+	t.Helper()
+	manager := config233.NewConfigManager233(dir)
+	config233.Instance = manager
+	for _, fn := range register {
+		fn()
+	}
+	return manager
+}
+
+// newTestManager 在临时目录写入测试配置文件，创建管理器并设为全局实例，再依次执行类型注册
+// 参数:
+//
+//	files: 文件名到文件内容的映射
+//	register: 注册配置类型的函数，如 config233.RegisterType[ItemConfig]
+//
+// 返回值:
+//
+//	*config233.ConfigManager233: 新建的管理器
+//	string: 配置目录
+func newTestManager(t *testing.T, files map[string]string, register ...func()) (*config233.ConfigManager233, string) {
+	t.Helper()
+	dir := writeTestFiles(t, files)
+	return newTestManagerAt(t, dir, register...), dir
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// BridgeItemConfig 用于测试 ConfigManager233.RegisterForHotUpdate 的配置（没有 uid 标签，按 Id 字段注入）
+type BridgeItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// bridgeHolder 通过 inject 字段持有配置的业务对象
+type bridgeHolder struct {
+	Items   map[int]*BridgeItemConfig   `config233:"inject"`
+	ByName  map[string]BridgeItemConfig `config233:"inject"`
+	updates int
+}
+
+// OnBridgeItemConfigHotUpdate BridgeItemConfig 变更后调用
+func (h *bridgeHolder) OnBridgeItemConfigHotUpdate() {
+	h.updates++
+}
+
+// TestConfigManager233_RegisterForHotUpdate 测试字段注入与 GetConfigById[T] 读取同一份数据，热重载后同步更新
+func TestConfigManager233_RegisterForHotUpdate(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "BridgeItemConfig.json", `[{"id": 1, "name": "sword"}, {"id": 2, "name": "bow"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[BridgeItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	holder := &bridgeHolder{}
+	manager.RegisterForHotUpdate(holder)
+
+	sword, _ := config233.GetConfigById[BridgeItemConfig](1)
+	if len(holder.Items) != 2 || holder.Items[1] != sword {
+		t.Fatalf("注册时应注入与 GetConfigById 相同的对象: %v", holder.Items)
+	}
+	if holder.ByName["2"].Name != "bow" {
+		t.Errorf("值类型的注入字段应按 ID 转换 key: %v", holder.ByName)
+	}
+
+	writeTextFile(t, tempDir, "BridgeItemConfig.json", `[{"id": 1, "name": "blade"}]`)
+	if err := manager.ReloadConfigs([]string{"BridgeItemConfig"}); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+
+	blade, ok := config233.GetConfigById[BridgeItemConfig](1)
+	if !ok || blade.Name != "blade" {
+		t.Fatalf("重载后应读到新数据: %+v", blade)
+	}
+	if len(holder.Items) != 1 || holder.Items[1] != blade {
+		t.Errorf("重载后注入字段应与 GetConfigById 一致: %v", holder.Items)
+	}
+	if holder.updates != 1 {
+		t.Errorf("重载后应调用一次 On<配置名>HotUpdate，实际 %d 次", holder.updates)
+	}
+}
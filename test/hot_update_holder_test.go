@@ -0,0 +1,135 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// HolderItemConfig 用于测试热更新持有器的配置
+type HolderItemConfig struct {
+	Id    int `json:"id"`
+	Level int `json:"level"`
+}
+
+func writeHolderItems(t *testing.T, dir string, level int) {
+	t.Helper()
+	writeTextFile(t, dir, "HolderItemConfig.json",
+		fmt.Sprintf(`[{"id": 1, "level": %d}, {"id": 2, "level": %d}]`, level, level))
+}
+
+// TestHotUpdateHolder_ReplacedOnReload 测试注册后加载、重载、清空时原子替换 holder 指向的 map
+func TestHotUpdateHolder_ReplacedOnReload(t *testing.T) {
+	tempDir := t.TempDir()
+	writeHolderItems(t, tempDir, 1)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[HolderItemConfig]()
+
+	var holder atomic.Pointer[map[string]*HolderItemConfig]
+	config233.RegisterHotUpdateHolder[HolderItemConfig](&holder)
+	if current := holder.Load(); current == nil || len(*current) != 0 {
+		t.Fatalf("注册后尚未加载时 holder 应为空 map，实际 %v", current)
+	}
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	first := holder.Load()
+	if item := (*first)["1"]; item == nil || item.Level != 1 || len(*first) != 2 {
+		t.Fatalf("加载后 holder 应包含全部配置，实际 %v", *first)
+	}
+
+	writeHolderItems(t, tempDir, 2)
+	if err := manager.ReloadConfig("HolderItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if item := (*holder.Load())["1"]; item == nil || item.Level != 2 {
+		t.Errorf("重载后 holder 应指向新数据，实际 %+v", item)
+	}
+	if (*first)["1"].Level != 1 {
+		t.Error("旧 map 不应被修改")
+	}
+
+	// 取消注册后不再更新，保留最后一次的数据
+	config233.UnregisterHotUpdateHolder[HolderItemConfig](&holder)
+	writeHolderItems(t, tempDir, 3)
+	if err := manager.ReloadConfig("HolderItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if item := (*holder.Load())["1"]; item.Level != 2 {
+		t.Errorf("取消注册后 holder 不应再更新，实际 %+v", item)
+	}
+
+	// 清空配置后 holder 替换为空 map
+	var cleared atomic.Pointer[map[string]*HolderItemConfig]
+	config233.RegisterHotUpdateHolder[HolderItemConfig](&cleared)
+	if current := cleared.Load(); len(*current) != 2 {
+		t.Fatalf("注册时应立即写入已加载的数据，实际 %v", *current)
+	}
+	manager.ClearConfigs()
+	if current := cleared.Load(); len(*current) != 0 {
+		t.Errorf("清空配置后 holder 应为空 map，实际 %v", *current)
+	}
+}
+
+// TestHotUpdateHolder_ConcurrentReadDuringReload 测试并发无锁读取 holder 与热更新替换（需配合 -race 运行）
+func TestHotUpdateHolder_ConcurrentReadDuringReload(t *testing.T) {
+	tempDir := t.TempDir()
+	writeHolderItems(t, tempDir, 0)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[HolderItemConfig]()
+	var holder atomic.Pointer[map[string]*HolderItemConfig]
+	config233.RegisterHotUpdateHolder[HolderItemConfig](&holder)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	const reloads = 20
+	var wg sync.WaitGroup
+	var stop atomic.Bool
+	errCh := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lastLevel := 0
+			for !stop.Load() {
+				current := *holder.Load()
+				first, second := current["1"], current["2"]
+				if first == nil || second == nil {
+					errCh <- fmt.Errorf("读到不完整的 map: %v", current)
+					return
+				}
+				// 同一份 map 内两条配置来自同一次加载，且版本只会前进
+				if first.Level != second.Level || first.Level < lastLevel {
+					errCh <- fmt.Errorf("读到不一致的数据: %d %d (上次 %d)", first.Level, second.Level, lastLevel)
+					return
+				}
+				lastLevel = first.Level
+			}
+		}()
+	}
+
+	for level := 1; level <= reloads; level++ {
+		writeHolderItems(t, tempDir, level)
+		if err := manager.ReloadConfig("HolderItemConfig"); err != nil {
+			t.Fatalf("重载配置失败: %v", err)
+		}
+	}
+	stop.Store(true)
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		t.Error(err)
+	}
+	if item := (*holder.Load())["1"]; item.Level != reloads {
+		t.Errorf("最终 holder 应为最后一次重载的数据，实际 %+v", item)
+	}
+}
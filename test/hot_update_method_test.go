@@ -0,0 +1,134 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+)
+
+// HotUpdateMethodConfig 用于测试热更新方法回调的配置
+type HotUpdateMethodConfig struct {
+	Id   int    `json:"id" config233:"uid"`
+	Name string `json:"name"`
+}
+
+// hotUpdateMethodHolder 通过方法名约定接收热更新
+type hotUpdateMethodHolder struct {
+	ConfigMap map[int]*HotUpdateMethodConfig `config233:"inject"`
+
+	hotUpdateCount   atomic.Int32
+	typedCount       atomic.Int32
+	lastInjectedName atomic.Value
+}
+
+// OnHotUpdate 任一注入的配置热更新后调用
+func (h *hotUpdateMethodHolder) OnHotUpdate() {
+	h.hotUpdateCount.Add(1)
+	if cfg := h.ConfigMap[1]; cfg != nil {
+		h.lastInjectedName.Store(cfg.Name)
+	}
+}
+
+// OnHotUpdateMethodConfigHotUpdate HotUpdateMethodConfig 热更新后调用
+func (h *hotUpdateMethodHolder) OnHotUpdateMethodConfigHotUpdate() {
+	h.typedCount.Add(1)
+}
+
+// waitUntil 轮询等待条件成立
+func waitUntil(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return cond()
+}
+
+// TestConfig233_HotUpdateMethods 测试修改配置文件后重新注入字段并调用热更新方法
+func TestConfig233_HotUpdateMethods(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "HotUpdateMethodConfig.json", `[{"id": 1, "name": "v1"}]`)
+
+	typ := reflect.TypeOf(HotUpdateMethodConfig{})
+	cfg := config233.NewConfig233().
+		Directory(tempDir).
+		AddConfigHandler("json", &json.JsonConfigHandler{}).
+		RegisterConfigClass("HotUpdateMethodConfig", typ).
+		Start()
+
+	var funcCount atomic.Int32
+	cfg.RegisterHotUpdateFunc(typ, func() {
+		funcCount.Add(1)
+	})
+
+	holder := &hotUpdateMethodHolder{}
+	cfg.RegisterForHotUpdate(holder)
+	if holder.ConfigMap[1] == nil || holder.ConfigMap[1].Name != "v1" {
+		t.Fatalf("初始注入错误: %+v", holder.ConfigMap)
+	}
+	if holder.hotUpdateCount.Load() != 0 || holder.typedCount.Load() != 0 {
+		t.Fatal("注册时不应调用热更新方法")
+	}
+
+	path := filepath.Join(tempDir, "HotUpdateMethodConfig.json")
+	if err := os.WriteFile(path, []byte(`[{"id": 1, "name": "v2"}, {"id": 2, "name": "new"}]`), 0644); err != nil {
+		t.Fatalf("写入配置文件失败: %v", err)
+	}
+
+	ok := waitUntil(3*time.Second, func() bool {
+		name, _ := holder.lastInjectedName.Load().(string)
+		return name == "v2" && holder.typedCount.Load() > 0 && funcCount.Load() > 0
+	})
+	if !ok {
+		t.Fatalf("热更新回调未触发: OnHotUpdate=%d, typed=%d, func=%d, lastName=%v",
+			holder.hotUpdateCount.Load(), holder.typedCount.Load(), funcCount.Load(), holder.lastInjectedName.Load())
+	}
+	if len(holder.ConfigMap) != 2 {
+		t.Errorf("热更新后字段应重新注入: %+v", holder.ConfigMap)
+	}
+}
+
+// panicHotUpdateHolder 热更新方法 panic 的对象
+type panicHotUpdateHolder struct {
+	ConfigMap map[int]HotUpdateMethodConfig `config233:"inject"`
+}
+
+// OnHotUpdate 模拟业务回调出错
+func (h *panicHotUpdateHolder) OnHotUpdate() {
+	panic("hot update failed")
+}
+
+// TestConfig233_HotUpdateMethodPanic 测试热更新方法 panic 不影响其他监听器
+func TestConfig233_HotUpdateMethodPanic(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "HotUpdateMethodConfig.json", `[{"id": 1, "name": "v1"}]`)
+
+	typ := reflect.TypeOf(HotUpdateMethodConfig{})
+	cfg := config233.NewConfig233().
+		Directory(tempDir).
+		AddConfigHandler("json", &json.JsonConfigHandler{}).
+		RegisterConfigClass("HotUpdateMethodConfig", typ).
+		Start()
+
+	cfg.RegisterForHotUpdate(&panicHotUpdateHolder{})
+	var funcCount atomic.Int32
+	cfg.RegisterHotUpdateFunc(typ, func() {
+		funcCount.Add(1)
+	})
+
+	path := filepath.Join(tempDir, "HotUpdateMethodConfig.json")
+	if err := os.WriteFile(path, []byte(`[{"id": 1, "name": "v2"}]`), 0644); err != nil {
+		t.Fatalf("写入配置文件失败: %v", err)
+	}
+	if !waitUntil(3*time.Second, func() bool { return funcCount.Load() > 0 }) {
+		t.Error("前一个回调 panic 后，后续回调仍应被调用")
+	}
+}
@@ -0,0 +1,114 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+)
+
+// InjectInt64Config 主键为 int64 的配置
+type InjectInt64Config struct {
+	Id   int64  `json:"id" config233:"uid"`
+	Name string `json:"name"`
+}
+
+// InjectStringConfig 主键为 string 的配置
+type InjectStringConfig struct {
+	Code string `json:"code" config233:"uid"`
+	Name string `json:"name"`
+}
+
+// InjectIntConfig 主键为 int 的配置
+type InjectIntConfig struct {
+	Id   int    `json:"id" config233:"uid"`
+	Name string `json:"name"`
+}
+
+// injectKeyHolder 各种 key 类型的注入字段
+type injectKeyHolder struct {
+	Int64Map        map[int64]InjectInt64Config   `config233:"inject"`
+	StringMap       map[string]InjectStringConfig `config233:"inject"`
+	IntMap          map[int]InjectIntConfig       `config233:"inject"`
+	IntAsStringMap  map[string]InjectIntConfig    `config233:"inject"`
+	StringAsIntMap  map[int]InjectStringConfig    `config233:"inject"`
+	Int64AsInt32Map map[int32]InjectInt64Config   `config233:"inject"`
+}
+
+func newInjectConfig233(t *testing.T) *config233.Config233 {
+	t.Helper()
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "InjectInt64Config.json", `[{"id": 9007199254740993, "name": "big"}, {"id": 1, "name": "small"}]`)
+	writeTextFile(t, tempDir, "InjectStringConfig.json", `[{"code": "sword", "name": "剑"}, {"code": "1001", "name": "数字编码"}]`)
+	writeTextFile(t, tempDir, "InjectIntConfig.json", `[{"id": 7, "name": "seven"}]`)
+
+	return config233.NewConfig233().
+		Directory(tempDir).
+		AddConfigHandler("json", &json.JsonConfigHandler{}).
+		RegisterConfigClass("InjectInt64Config", reflect.TypeOf(InjectInt64Config{})).
+		RegisterConfigClass("InjectStringConfig", reflect.TypeOf(InjectStringConfig{})).
+		RegisterConfigClass("InjectIntConfig", reflect.TypeOf(InjectIntConfig{})).
+		Start()
+}
+
+// TestConfig233_InjectMapKeyTypes 测试按字段声明的 key 类型注入 int64 / string / int 主键
+func TestConfig233_InjectMapKeyTypes(t *testing.T) {
+	cfg := newInjectConfig233(t)
+
+	holder := &injectKeyHolder{}
+	cfg.RegisterForHotUpdate(holder)
+
+	if len(holder.Int64Map) != 2 || holder.Int64Map[9007199254740993].Name != "big" {
+		t.Errorf("int64 主键注入错误: %+v", holder.Int64Map)
+	}
+	if len(holder.StringMap) != 2 || holder.StringMap["sword"].Name != "剑" {
+		t.Errorf("string 主键注入错误: %+v", holder.StringMap)
+	}
+	if len(holder.IntMap) != 1 || holder.IntMap[7].Name != "seven" {
+		t.Errorf("int 主键注入错误: %+v", holder.IntMap)
+	}
+	if holder.IntAsStringMap["7"].Name != "seven" {
+		t.Errorf("int 主键应能转换为 string key: %+v", holder.IntAsStringMap)
+	}
+
+	// 无法转换的键被跳过，其余正常注入
+	if len(holder.StringAsIntMap) != 1 || holder.StringAsIntMap[1001].Name != "数字编码" {
+		t.Errorf("无法转换为 int 的主键应被跳过: %+v", holder.StringAsIntMap)
+	}
+	if len(holder.Int64AsInt32Map) != 1 || holder.Int64AsInt32Map[1].Name != "small" {
+		t.Errorf("超出 int32 范围的主键应被跳过: %+v", holder.Int64AsInt32Map)
+	}
+}
+
+// injectListener 文档示例中的热更新监听器，value 声明为指针
+type injectListener struct {
+	ConfigMap map[string]*InjectStringConfig `config233:"inject"`
+	IntMap    map[int]*InjectIntConfig       `config233:"inject"`
+	Int64Map  map[int64]*InjectInt64Config   `config233:"inject"`
+}
+
+// TestConfig233_InjectPointerValues 测试 value 声明为 *T 的字段注入
+func TestConfig233_InjectPointerValues(t *testing.T) {
+	cfg := newInjectConfig233(t)
+
+	listener := &injectListener{}
+	cfg.RegisterForHotUpdate(listener)
+
+	if len(listener.ConfigMap) != 2 {
+		t.Fatalf("map[string]*T 应注入 2 条配置，实际: %+v", listener.ConfigMap)
+	}
+	sword := listener.ConfigMap["sword"]
+	if sword == nil || sword.Name != "剑" || sword.Code != "sword" {
+		t.Errorf("map[string]*T 注入错误: %+v", sword)
+	}
+	if listener.ConfigMap["1001"] == sword {
+		t.Error("每条配置应注入独立的指针")
+	}
+	if seven := listener.IntMap[7]; seven == nil || seven.Name != "seven" {
+		t.Errorf("map[int]*T 注入错误: %+v", listener.IntMap)
+	}
+	if big := listener.Int64Map[9007199254740993]; big == nil || big.Name != "big" {
+		t.Errorf("map[int64]*T 注入错误: %+v", listener.Int64Map)
+	}
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// JsonCellItemContext 单元格内嵌的 JSON 对象
+type JsonCellItemContext struct {
+	ItemId int `json:"itemId"`
+	Count  int `json:"count"`
+}
+
+// JsonCellConfig 带内嵌 JSON 字段的配置
+type JsonCellConfig struct {
+	Id             int                  `json:"id"`
+	UseItemContext JsonCellItemContext  `json:"useItemContext"`
+	Weights        map[string]int       `json:"weights"`
+	Targets        []int                `json:"targets" config233_json:"true"`
+	Detail         *JsonCellItemContext `json:"detail"`
+	Desc           string               `json:"desc"`
+}
+
+// checkJsonCellConfig 校验第 1 行为合法 JSON、第 2 行为坏 JSON
+func checkJsonCellConfig(t *testing.T, source string, list []JsonCellConfig) {
+	t.Helper()
+	if len(list) != 2 {
+		t.Fatalf("[%s] 期望 2 条配置，实际: %+v", source, list)
+	}
+	first := list[0]
+	if first.UseItemContext != (JsonCellItemContext{ItemId: 1001, Count: 2}) {
+		t.Errorf("[%s] struct 字段解析错误: %+v", source, first.UseItemContext)
+	}
+	if !reflect.DeepEqual(first.Weights, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("[%s] map 字段解析错误: %v", source, first.Weights)
+	}
+	if !reflect.DeepEqual(first.Targets, []int{3, 4}) {
+		t.Errorf("[%s] config233_json 切片字段解析错误: %v", source, first.Targets)
+	}
+	if first.Detail == nil || first.Detail.ItemId != 7 {
+		t.Errorf("[%s] 指针 struct 字段解析错误: %+v", source, first.Detail)
+	}
+	// 普通字符串字段不会被误判为 JSON
+	if first.Desc != "{not json}" {
+		t.Errorf("[%s] 字符串字段应保持原样: %q", source, first.Desc)
+	}
+
+	// 解析失败时保留零值，其余字段正常
+	second := list[1]
+	if second.Id != 2 {
+		t.Errorf("[%s] 坏 JSON 不应影响其他字段: %+v", source, second)
+	}
+	if second.UseItemContext != (JsonCellItemContext{}) || second.Weights != nil || second.Targets != nil {
+		t.Errorf("[%s] 解析失败时应保留零值: %+v", source, second)
+	}
+}
+
+// TestJsonCell_ConfigManager 测试 ConfigManager233 加载时解析字符串中的内嵌 JSON
+func TestJsonCell_ConfigManager(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "JsonCellConfig.json", `[
+		{"id": 1, "useItemContext": "{\"itemId\": 1001, \"count\": 2}", "weights": "{\"a\": 1, \"b\": 2}",
+		 "targets": "[3, 4]", "detail": "{\"itemId\": 7}", "desc": "{not json}"},
+		{"id": 2, "useItemContext": "{bad", "weights": "[1, 2]", "targets": "3,4"}
+	]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[JsonCellConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	var list []JsonCellConfig
+	for _, id := range []int{1, 2} {
+		cfg, ok := config233.GetConfigById[JsonCellConfig](id)
+		if !ok {
+			t.Fatalf("未找到 id=%d 的配置", id)
+		}
+		list = append(list, *cfg)
+	}
+	checkJsonCellConfig(t, "json", list)
+}
+
+// TestJsonCell_Handlers 测试 Excel / TSV 处理器直接转换结构体时解析内嵌 JSON
+func TestJsonCell_Handlers(t *testing.T) {
+	header := []interface{}{"id", "useItemContext", "weights", "targets", "detail", "desc"}
+	excelData := buildExcelBytes(t, [][]interface{}{
+		header,
+		{1, `{"itemId": 1001, "count": 2}`, `{"a": 1, "b": 2}`, "[3, 4]", `{"itemId": 7}`, "{not json}"},
+		{2, "{bad", "[1, 2]", "3,4", "", "ok"},
+	})
+	excelItems, err := (&excel.ExcelConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(JsonCellConfig{}), "JsonCellConfig", excelData)
+	requireConversionErrors(t, err, 3)
+	var excelList []JsonCellConfig
+	for _, item := range excelItems {
+		excelList = append(excelList, item.(JsonCellConfig))
+	}
+	checkJsonCellConfig(t, "excel", excelList)
+
+	tsvData := []byte("UseItemContext\tId\tWeights\tTargets\tDetail\tDesc\n" +
+		"{\"itemId\": 1001, \"count\": 2}\t1\t{\"a\": 1, \"b\": 2}\t[3, 4]\t{\"itemId\": 7}\t{not json}\n" +
+		"{bad\t2\t[1, 2]\t3,4\t\tok\n")
+	tsvItems, err := (&tsv.TsvConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(JsonCellConfig{}), "JsonCellConfig", tsvData)
+	requireConversionErrors(t, err, 3)
+	var tsvList []JsonCellConfig
+	for _, item := range tsvItems {
+		tsvList = append(tsvList, item.(JsonCellConfig))
+	}
+	checkJsonCellConfig(t, "tsv", tsvList)
+}
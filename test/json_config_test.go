@@ -0,0 +1,161 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+)
+
+// JsonIdMapConfig 用于测试 id 映射结构的 JSON 配置
+type JsonIdMapConfig struct {
+	Id    int    `json:"id"`
+	Name  string `json:"name"`
+	Level int    `json:"level"`
+}
+
+const jsonIdMapContent = `{
+	"1002": {"name": "shield", "level": 2},
+	"1001": {"name": "sword", "level": 1},
+	"10":   {"id": 10, "name": "bow", "level": 3}
+}`
+
+// TestJsonConfigHandler_IdMapTopLevel 测试顶层为 id 映射对象的 JSON
+func TestJsonConfigHandler_IdMapTopLevel(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "JsonIdMapConfig.json", jsonIdMapContent)
+	handler := &json.JsonConfigHandler{}
+
+	result := mustReadDataList(t, handler, "JsonIdMapConfig", path)
+	if len(result.DataList) != 3 {
+		t.Fatalf("期望 3 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	// 按 id 数值排序，缺少 id 时使用 key 补全
+	if result.DataList[0]["id"] != float64(10) || result.DataList[1]["id"] != "1001" || result.DataList[2]["name"] != "shield" {
+		t.Errorf("id 映射解析错误: %v", result.DataList)
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(JsonIdMapConfig{}), "JsonIdMapConfig", path)
+	if len(list) != 3 {
+		t.Fatalf("期望 3 个对象，实际 %d 个", len(list))
+	}
+	second := list[1].(JsonIdMapConfig)
+	if second.Id != 1001 || second.Name != "sword" || second.Level != 1 {
+		t.Errorf("id 映射 ORM 解析错误: %+v", second)
+	}
+	if list[0].(JsonIdMapConfig).Id != 10 {
+		t.Errorf("记录自带的 id 不应被覆盖: %+v", list[0])
+	}
+}
+
+// TestJsonConfigHandler_SingleObjectStillSingleRecord 测试普通对象仍作为单条配置
+func TestJsonConfigHandler_SingleObjectStillSingleRecord(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "JsonIdMapConfig.json", `{"id": 1, "name": "sword", "level": 1}`)
+	handler := &json.JsonConfigHandler{}
+
+	result := mustReadDataList(t, handler, "JsonIdMapConfig", path)
+	if len(result.DataList) != 1 || result.DataList[0]["name"] != "sword" {
+		t.Fatalf("单个对象应解析为一条配置，实际: %v", result.DataList)
+	}
+}
+
+// TestJsonConfigHandler_InvalidContentReturnsError 测试无法解析的内容返回错误而不是 panic
+func TestJsonConfigHandler_InvalidContentReturnsError(t *testing.T) {
+	handler := &json.JsonConfigHandler{}
+	tempDir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"Broken.json":   `[{"id": 1,`,
+		"Scalar.json":   `123`,
+		"BadIdMap.json": `{"1": {"id": "not-a-number"}}`,
+	} {
+		path := writeTextFile(t, tempDir, name, content)
+
+		if name != "BadIdMap.json" {
+			if _, err := handler.ReadToFrontEndDataList(name, path); err == nil {
+				t.Errorf("%s: 期望 ReadToFrontEndDataList 返回错误", name)
+			}
+		}
+		if _, err := handler.ReadConfigAndORM(reflect.TypeOf(JsonIdMapConfig{}), name, path); err == nil {
+			t.Errorf("%s: 期望 ReadConfigAndORM 返回错误", name)
+		}
+	}
+}
+
+// TestConfigManager233_LoadJsonIdMap 测试配置管理器加载 id 映射结构的 JSON
+func TestConfigManager233_LoadJsonIdMap(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "JsonIdMapConfig.json", jsonIdMapContent)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[JsonIdMapConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	cfg, ok := config233.GetConfigById[JsonIdMapConfig](1002)
+	if !ok || cfg.Name != "shield" || cfg.Id != 1002 {
+		t.Fatalf("id 映射配置加载错误: %+v", cfg)
+	}
+	if config233.GetConfigListCount[JsonIdMapConfig]() != 3 {
+		t.Errorf("期望 3 条配置，实际 %d 条", config233.GetConfigListCount[JsonIdMapConfig]())
+	}
+}
+
+// jsonWithComments 含行注释、块注释、尾逗号以及字符串中 // 的 JSON
+const jsonWithComments = `// 物品配置
+[
+	/* 第一件
+	   武器 */
+	{"id": 1, "name": "http://sword", "level": 1}, // 行尾注释
+	{"id": 2, "name": "shield /* not comment */", "level": 2,},
+	{"id": 3, "name": "say \"//hi\"", "level": 3},
+]
+`
+
+// TestJsonConfigHandler_AllowComments 测试容错模式去掉注释与尾逗号，且不误删字符串内的内容
+func TestJsonConfigHandler_AllowComments(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "JsonIdMapConfig.json", jsonWithComments)
+
+	// 默认严格模式，含注释的 JSON 解析失败
+	strict := &json.JsonConfigHandler{}
+	if _, err := strict.ReadToFrontEndDataList("JsonIdMapConfig", path); err == nil {
+		t.Error("默认应严格解析，含注释时返回错误")
+	}
+
+	handler := &json.JsonConfigHandler{AllowComments: true}
+	result := mustReadDataList(t, handler, "JsonIdMapConfig", path)
+	if len(result.DataList) != 3 {
+		t.Fatalf("期望 3 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	list := mustReadORM(t, handler, reflect.TypeOf(JsonIdMapConfig{}), "JsonIdMapConfig", path)
+	wantNames := []string{"http://sword", "shield /* not comment */", `say "//hi"`}
+	for i, want := range wantNames {
+		if got := list[i].(JsonIdMapConfig).Name; got != want {
+			t.Errorf("第 %d 条的字符串内容不应被修改: got %q want %q", i+1, got, want)
+		}
+	}
+}
+
+// TestConfigManager233_JsonAllowComments 测试配置管理器通过 SetJsonAllowComments 开启容错解析
+func TestConfigManager233_JsonAllowComments(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "JsonIdMapConfig.json", jsonWithComments)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[JsonIdMapConfig]()
+	if err := manager.LoadAllConfigsStrict(); err == nil {
+		t.Fatal("未开启容错模式时含注释的 JSON 应加载失败")
+	}
+
+	manager.SetJsonAllowComments(true)
+	if err := manager.LoadAllConfigsStrict(); err != nil {
+		t.Fatalf("开启容错模式后应加载成功: %v", err)
+	}
+	if cfg, ok := config233.GetConfigById[JsonIdMapConfig](2); !ok || cfg.Level != 2 {
+		t.Errorf("尾逗号所在的配置应正常加载: %+v", cfg)
+	}
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+)
+
+// jsonlContent 含空行、\r\n 换行、非对象行与坏行的 JSON Lines
+const jsonlContent = "{\"id\": 1, \"name\": \"sword\", \"level\": 1}\r\n" +
+	"\n" +
+	"{\"id\": 2, \"name\": \"shield\"\n" +
+	"   \n" +
+	"[1, 2]\n" +
+	"{\"id\": 3, \"name\": \"bow\", \"level\": 3}\n"
+
+// TestJsonlConfigHandler_SkipBlankAndBadLines 测试逐行解析，空行跳过，坏行记录行号后继续
+func TestJsonlConfigHandler_SkipBlankAndBadLines(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "JsonIdMapConfig.jsonl", jsonlContent)
+	handler := &json.JsonConfigHandler{Lines: true}
+
+	result := mustReadDataList(t, handler, "JsonIdMapConfig", path)
+	if len(result.DataList) != 2 || result.DataList[0]["name"] != "sword" || result.DataList[1]["name"] != "bow" {
+		t.Fatalf("应跳过空行与坏行，读出 2 条数据，实际: %v", result.DataList)
+	}
+
+	list, err := handler.ReadConfigAndORM(reflect.TypeOf(JsonIdMapConfig{}), "JsonIdMapConfig", path)
+	if len(list) != 2 || list[1].(JsonIdMapConfig) != (JsonIdMapConfig{Id: 3, Name: "bow", Level: 3}) {
+		t.Fatalf("坏行不应影响其他行的转换，实际: %+v", list)
+	}
+	var convErrs dto.ConversionErrors
+	if !errors.As(err, &convErrs) || len(convErrs) != 2 {
+		t.Fatalf("期望返回 2 个行解析错误，实际: %v", err)
+	}
+	if convErrs[0].RowIndex != 3 || convErrs[1].RowIndex != 5 {
+		t.Errorf("错误应带文件中的行号 3 与 5，实际 %d 与 %d", convErrs[0].RowIndex, convErrs[1].RowIndex)
+	}
+}
+
+// TestConfigManager233_LoadJsonl 测试配置管理器识别 .jsonl 文件
+func TestConfigManager233_LoadJsonl(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "JsonIdMapConfig.jsonl", jsonlContent)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[JsonIdMapConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if count := config233.GetConfigListCount[JsonIdMapConfig](); count != 2 {
+		t.Fatalf("期望加载 2 条配置，实际 %d 条", count)
+	}
+	if item, ok := config233.GetConfigById[JsonIdMapConfig](3); !ok || item.Name != "bow" {
+		t.Errorf("应能按 id 查询 .jsonl 中的配置，实际 %+v", item)
+	}
+	if stat := manager.GetLoadStats()["JsonIdMapConfig"]; stat.Format != "jsonl" {
+		t.Errorf("加载统计的格式应为 jsonl，实际 %q", stat.Format)
+	}
+}
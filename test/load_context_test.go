@@ -0,0 +1,127 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// ContextLoadConfig 用于测试可取消加载的配置
+type ContextLoadConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// SlowContextLoadConfig AfterLoad 较慢的配置，用于模拟加载卡住的文件
+type SlowContextLoadConfig struct {
+	Id int `json:"id"`
+}
+
+// AfterLoad 模拟耗时的加载后处理
+func (c *SlowContextLoadConfig) AfterLoad() {
+	time.Sleep(300 * time.Millisecond)
+}
+
+// contextLoadManager 记录加载完成回调次数
+type contextLoadManager struct {
+	loadCompleteCount atomic.Int32
+	firstDoneCount    atomic.Int32
+}
+
+func (m *contextLoadManager) OnConfigLoadComplete(changedConfigNameList []string) {
+	m.loadCompleteCount.Add(1)
+}
+
+func (m *contextLoadManager) OnFirstAllConfigDone() {
+	m.firstDoneCount.Add(1)
+}
+
+func setupContextLoadManager(t *testing.T) (*config233.ConfigManager233, *contextLoadManager) {
+	t.Helper()
+	files := map[string]string{
+		"ContextLoadConfig.json":     `[{"id": 1, "name": "ok"}]`,
+		"SlowContextLoadConfig.json": `[{"id": 1}]`,
+	}
+	for i := 0; i < 5; i++ {
+		files[fmt.Sprintf("ContextLoadConfig%d.json", i)] = `[{"id": 1, "name": "ok"}]`
+	}
+	manager, _ := newTestManager(t, files,
+		config233.RegisterType[ContextLoadConfig], config233.RegisterType[SlowContextLoadConfig])
+
+	business := &contextLoadManager{}
+	manager.RegisterBusinessManager(business)
+	return manager, business
+}
+
+// TestLoadAllConfigsContext_Canceled 测试已取消的 ctx 直接返回 context.Canceled 且不加载任何配置
+func TestLoadAllConfigsContext_Canceled(t *testing.T) {
+	manager, business := setupContextLoadManager(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := manager.LoadAllConfigsContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("期望返回 context.Canceled，实际: %v", err)
+	}
+	if names := manager.GetLoadedConfigNames(); len(names) != 0 {
+		t.Errorf("取消后不应加载任何配置，实际已加载: %v", names)
+	}
+	if business.loadCompleteCount.Load() != 0 || business.firstDoneCount.Load() != 0 {
+		t.Error("取消的加载不应触发完成回调")
+	}
+}
+
+// TestLoadAllConfigsContext_Timeout 测试超时后尽快返回，迟到的任务不会再写入内存
+func TestLoadAllConfigsContext_Timeout(t *testing.T) {
+	manager, business := setupContextLoadManager(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := manager.LoadAllConfigsContext(ctx)
+	elapsed := time.Since(start)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("期望返回 context.DeadlineExceeded，实际: %v", err)
+	}
+	if elapsed >= 300*time.Millisecond {
+		t.Errorf("超时后应尽快返回，而不是等待慢任务完成，实际耗时 %v", elapsed)
+	}
+
+	// 等慢任务跑完，确认其结果被丢弃
+	time.Sleep(400 * time.Millisecond)
+	for _, name := range manager.GetLoadedConfigNames() {
+		if name == "SlowContextLoadConfig" {
+			t.Error("取消后完成的任务不应写入内存")
+		}
+	}
+	// 已写入的配置保持完整
+	if cfg, ok := config233.GetConfigById[ContextLoadConfig](1); ok && cfg.Name != "ok" {
+		t.Errorf("已写入的配置应保持完整: %+v", cfg)
+	}
+	if business.firstDoneCount.Load() != 0 {
+		t.Error("超时的加载不应触发 OnFirstAllConfigDone")
+	}
+}
+
+// TestLoadAllConfigsContext_Completes 测试未取消时与 LoadAllConfigs 行为一致
+func TestLoadAllConfigsContext_Completes(t *testing.T) {
+	manager, business := setupContextLoadManager(t)
+
+	if err := manager.LoadAllConfigsContext(context.Background()); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if len(manager.GetLoadedConfigNames()) != 7 {
+		t.Errorf("期望加载 7 个配置，实际: %v", manager.GetLoadedConfigNames())
+	}
+	if business.loadCompleteCount.Load() != 1 || business.firstDoneCount.Load() != 1 {
+		t.Errorf("完成的加载应触发一次回调: loadComplete=%d, firstDone=%d",
+			business.loadCompleteCount.Load(), business.firstDoneCount.Load())
+	}
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// LoadErrorGoodConfig 用于测试坏文件不影响正常配置加载
+type LoadErrorGoodConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestConfigManager233_SkipBrokenFiles 测试坏文件被跳过并汇总返回错误，其余配置正常加载
+func TestConfigManager233_SkipBrokenFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "LoadErrorGoodConfig.json", `[{"id": 1, "name": "ok"}]`)
+	writeTextFile(t, tempDir, "BrokenJsonConfig.json", `[{"id": 1,`)
+	writeTextFile(t, tempDir, "BrokenExcelConfig.xlsx", "not a zip file")
+	writeTextFile(t, tempDir, "BrokenYamlConfig.yaml", "- id: [1")
+	writeTextFile(t, tempDir, "BrokenXmlConfig.xml", "<root><data id=\"1\"></root>")
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[LoadErrorGoodConfig]()
+
+	err := manager.LoadAllConfigs()
+	var failed config233.ConfigValidationErrors
+	if !errors.As(err, &failed) || len(failed) != 4 {
+		t.Fatalf("每个坏文件应作为一项加载错误返回: %v", err)
+	}
+
+	cfg, ok := config233.GetConfigById[LoadErrorGoodConfig](1)
+	if !ok || cfg.Name != "ok" {
+		t.Fatalf("正常配置应被加载: %+v", cfg)
+	}
+
+	loaded := make(map[string]bool)
+	for _, name := range manager.GetLoadedConfigNames() {
+		loaded[name] = true
+	}
+	for _, name := range []string{"BrokenJsonConfig", "BrokenExcelConfig", "BrokenYamlConfig", "BrokenXmlConfig"} {
+		if loaded[name] {
+			t.Errorf("坏文件 %s 不应被加载", name)
+		}
+	}
+}
+
+// LoadErrorOtherConfig 与 LoadErrorGoodConfig 一起加载的另一个正常配置
+type LoadErrorOtherConfig struct {
+	Id    int `json:"id"`
+	Price int `json:"price"`
+}
+
+// TestConfigManager233_LoadAllConfigsReturnsFileErrors 测试一个坏文件 + 多个好文件时返回可遍历的错误，好文件仍加载并通知业务管理器
+func TestConfigManager233_LoadAllConfigsReturnsFileErrors(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "LoadErrorGoodConfig.json", `[{"id": 1, "name": "ok"}]`)
+	writeTextFile(t, tempDir, "LoadErrorOtherConfig.json", `[{"id": 1, "price": 100}]`)
+	writeTextFile(t, tempDir, "LoadErrorRawConfig.csv", "id,name\n1,x\n")
+	brokenPath := writeTextFile(t, tempDir, "BrokenJsonConfig.json", `[{"id": 1,`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[LoadErrorGoodConfig]()
+	config233.RegisterType[LoadErrorOtherConfig]()
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+
+	err := manager.LoadAllConfigs()
+	if err == nil {
+		t.Fatal("存在坏文件时应返回错误")
+	}
+	var failed config233.ConfigValidationErrors
+	if !errors.As(err, &failed) || len(failed) != 1 {
+		t.Fatalf("错误应为只包含坏文件的 ConfigValidationErrors，实际 %T: %v", err, err)
+	}
+	if failed[0].ConfigName != "BrokenJsonConfig" || failed[0].FilePath != brokenPath || failed[0].Err == nil {
+		t.Errorf("错误应包含配置名、文件路径与原始错误: %+v", failed[0])
+	}
+	if !strings.Contains(err.Error(), "BrokenJsonConfig") {
+		t.Errorf("错误信息应包含失败的配置名: %v", err)
+	}
+	var target *config233.ConfigValidationError
+	if !errors.As(err, &target) || target.ConfigName != "BrokenJsonConfig" {
+		t.Errorf("应支持用 errors.As 取出单个文件的错误: %v", target)
+	}
+
+	if cfg, ok := config233.GetConfigById[LoadErrorGoodConfig](1); !ok || cfg.Name != "ok" {
+		t.Errorf("LoadErrorGoodConfig 应被加载: %+v", cfg)
+	}
+	if cfg, ok := config233.GetConfigById[LoadErrorOtherConfig](1); !ok || cfg.Price != 100 {
+		t.Errorf("LoadErrorOtherConfig 应被加载: %+v", cfg)
+	}
+	if names := manager.GetLoadedConfigNames(); len(names) != 3 {
+		t.Errorf("期望加载 3 个好文件，实际 %v", names)
+	}
+	if batches := recorder.snapshot(); len(batches) != 1 || len(batches[0]) != 3 {
+		t.Errorf("部分失败时仍应通知业务管理器成功加载的配置: %v", batches)
+	}
+}
+
+// TestConfigManager233_FirstDoneWaitsForFullSuccess 测试有坏文件的全量加载不触发 OnFirstAllConfigDone，修复后的加载才触发
+func TestConfigManager233_FirstDoneWaitsForFullSuccess(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "LoadErrorGoodConfig.json", `[{"id": 1, "name": "ok"}]`)
+	writeTextFile(t, tempDir, "BrokenJsonConfig.json", `[{"id": 1,`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[LoadErrorGoodConfig]()
+	business := &contextLoadManager{}
+	manager.RegisterBusinessManager(business)
+
+	if err := manager.LoadAllConfigs(); err == nil {
+		t.Fatal("存在坏文件时应返回错误")
+	}
+	if business.loadCompleteCount.Load() != 1 {
+		t.Errorf("部分失败时仍应通知成功加载的配置，实际 %d 次", business.loadCompleteCount.Load())
+	}
+	if business.firstDoneCount.Load() != 0 {
+		t.Error("有文件加载失败时不应触发 OnFirstAllConfigDone")
+	}
+
+	writeTextFile(t, tempDir, "BrokenJsonConfig.json", `[{"id": 1}]`)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("修复坏文件后加载失败: %v", err)
+	}
+	if business.firstDoneCount.Load() != 1 {
+		t.Errorf("所有文件加载成功后应触发一次 OnFirstAllConfigDone，实际 %d 次", business.firstDoneCount.Load())
+	}
+}
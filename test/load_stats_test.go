@@ -0,0 +1,89 @@
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// LoadStatItemConfig 用于测试加载统计的配置
+type LoadStatItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestConfigManager233_GetLoadStats 测试每个配置文件的加载统计与汇总
+func TestConfigManager233_GetLoadStats(t *testing.T) {
+	tempDir := t.TempDir()
+	jsonPath := writeTextFile(t, tempDir, "LoadStatItemConfig.json", `[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}, {"id": 3, "name": "c"}]`)
+	writeTextFile(t, tempDir, "LoadStatRawConfig.csv", "id,name\n1,x\n2,y\n")
+	writeTextFile(t, tempDir, "LoadStatYamlConfig.yaml", "- id: 1\n")
+	writeTextFile(t, tempDir, "LoadStatBrokenConfig.json", `[{"id": 1,`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[LoadStatItemConfig]()
+
+	before := time.Now()
+	var failed config233.ConfigValidationErrors
+	if err := manager.LoadAllConfigs(); !errors.As(err, &failed) || len(failed) != 1 || failed[0].ConfigName != "LoadStatBrokenConfig" {
+		t.Fatalf("坏文件应作为加载错误返回: %v", err)
+	}
+
+	stats := manager.GetLoadStats()
+	if len(stats) != 3 {
+		t.Fatalf("期望 3 个配置的统计（坏文件不计入），实际: %v", stats)
+	}
+
+	item := stats["LoadStatItemConfig"]
+	if item.FileName != jsonPath || item.Format != "json" || item.RecordCount != 3 || !item.StructConverted {
+		t.Errorf("JSON 配置统计错误: %+v", item)
+	}
+	if item.ParseDuration <= 0 || item.LastLoadTime.Before(before) {
+		t.Errorf("耗时与加载时间应被记录: %+v", item)
+	}
+
+	raw := stats["LoadStatRawConfig"]
+	if raw.Format != "csv" || raw.RecordCount != 2 || raw.StructConverted {
+		t.Errorf("未注册类型的 CSV 配置统计错误: %+v", raw)
+	}
+	if stats["LoadStatYamlConfig"].Format != "yaml" {
+		t.Errorf("YAML 配置格式错误: %+v", stats["LoadStatYamlConfig"])
+	}
+
+	// 返回的是副本
+	delete(stats, "LoadStatItemConfig")
+	if _, ok := manager.GetLoadStats()["LoadStatItemConfig"]; !ok {
+		t.Error("修改返回值不应影响内部统计")
+	}
+
+	summary := manager.GetLoadStatsSummary()
+	if summary.FileCount != 3 || summary.RecordCount != 6 {
+		t.Errorf("汇总统计错误: %+v", summary)
+	}
+	var total time.Duration
+	for _, stat := range manager.GetLoadStats() {
+		total += stat.ParseDuration
+	}
+	if summary.TotalParseDuration != total {
+		t.Errorf("总耗时应为各文件耗时之和: %v != %v", summary.TotalParseDuration, total)
+	}
+	if len(summary.SlowestConfigs) != 3 {
+		t.Fatalf("应按耗时列出所有配置: %v", summary.SlowestConfigs)
+	}
+	for i := 1; i < len(summary.SlowestConfigs); i++ {
+		prev := manager.GetLoadStats()[summary.SlowestConfigs[i-1]]
+		cur := manager.GetLoadStats()[summary.SlowestConfigs[i]]
+		if prev.ParseDuration < cur.ParseDuration {
+			t.Errorf("配置应按解析耗时从高到低排序: %v", summary.SlowestConfigs)
+		}
+	}
+
+	// 重新创建管理器后统计被清空
+	manager = config233.NewConfigManager233(t.TempDir())
+	if len(manager.GetLoadStats()) != 0 {
+		t.Error("重新创建管理器后统计应被清空")
+	}
+}
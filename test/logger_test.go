@@ -0,0 +1,153 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/json"
+)
+
+// logr.Logger 可以直接作为 config233.Logger 使用
+var _ config233.Logger = logr.Logger{}
+
+// recordingLogger 记录所有日志的 Logger
+type recordingLogger struct {
+	mu     sync.Mutex
+	infos  []string
+	errors []string
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.infos = append(l.infos, msg)
+}
+
+func (l *recordingLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors = append(l.errors, msg)
+}
+
+// snapshot 返回已记录日志的副本
+func (l *recordingLogger) snapshot() (infos, errs []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.infos...), append([]string(nil), l.errors...)
+}
+
+// reset 清空已记录的日志
+func (l *recordingLogger) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.infos, l.errors = nil, nil
+}
+
+// TestSetLogger_AppliesToHandlerPackages 测试 SetLogger 对根包和处理器子包同时生效
+func TestSetLogger_AppliesToHandlerPackages(t *testing.T) {
+	logger := &recordingLogger{}
+	config233.SetLogger(logger)
+	defer config233.SetLogger(nil)
+
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "LoggerTestConfig.json", `[{"id": 1}]`)
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if infos, _ := logger.snapshot(); len(infos) == 0 {
+		t.Error("根包日志应输出到自定义 Logger")
+	}
+
+	// json 处理器子包的错误日志
+	_, err := (&json.JsonConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(struct{ Id int }{}), "Broken", []byte(`[{"id": }]`))
+	if err == nil {
+		t.Fatal("坏 JSON 应返回错误")
+	}
+	_, errs := logger.snapshot()
+	found := false
+	for _, msg := range errs {
+		if strings.Contains(msg, "JSON") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("json 处理器的错误日志应输出到自定义 Logger，实际: %v", errs)
+	}
+}
+
+// TestSetLogger_Adapters 测试 logr 与 slog 适配
+func TestSetLogger_Adapters(t *testing.T) {
+	defer config233.SetLogger(nil)
+
+	var logrOut []string
+	config233.SetLogger(funcr.New(func(prefix, args string) {
+		logrOut = append(logrOut, args)
+	}, funcr.Options{}))
+	_, _ = (&json.JsonConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(struct{ Id int }{}), "Broken", []byte(`{`))
+	if len(logrOut) == 0 || !strings.Contains(strings.Join(logrOut, "\n"), "Broken") {
+		t.Errorf("logr.Logger 应收到日志: %v", logrOut)
+	}
+
+	var buf bytes.Buffer
+	config233.SetLogger(config233.NewSlogLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+	_, _ = (&json.JsonConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(struct{ Id int }{}), "Broken", []byte(`{`))
+	if out := buf.String(); !strings.Contains(out, "level=ERROR") || !strings.Contains(out, "error=") {
+		t.Errorf("slog 适配器应以 ERROR 级别输出并附带 error 字段: %s", out)
+	}
+
+	// nil 恢复默认实现，不会 panic
+	config233.SetLogger(nil)
+	config233.NewSlogLogger(nil).Error(errors.New("boom"), "nil slog 使用默认 logger")
+}
+
+// TestSetLogLevel 测试日志级别过滤
+func TestSetLogLevel(t *testing.T) {
+	logger := &recordingLogger{}
+	config233.SetLogger(logger)
+	defer config233.SetLogger(nil)
+	defer config233.SetLogLevel(config233.LogLevelInfo)
+
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "LoggerTestConfig.json", `[{"id": 1}]`)
+	load := func() {
+		manager := config233.NewConfigManager233(tempDir)
+		config233.Instance = manager
+		if err := manager.LoadAllConfigs(); err != nil {
+			t.Fatalf("加载配置失败: %v", err)
+		}
+		_, _ = (&json.JsonConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(struct{ Id int }{}), "Broken", []byte(`{`))
+	}
+
+	config233.SetLogLevel(config233.LogLevelError)
+	load()
+	infos, errs := logger.snapshot()
+	if len(infos) != 0 {
+		t.Errorf("LogLevelError 下不应输出 Info 日志: %v", infos)
+	}
+	if len(errs) == 0 {
+		t.Error("LogLevelError 下仍应输出 Error 日志")
+	}
+
+	logger.reset()
+	config233.SetLogLevel(config233.LogLevelSilent)
+	load()
+	if infos, errs := logger.snapshot(); len(infos) != 0 || len(errs) != 0 {
+		t.Errorf("LogLevelSilent 下不应输出任何日志: infos=%v errors=%v", infos, errs)
+	}
+
+	config233.SetLogLevel(config233.LogLevelInfo)
+	load()
+	if infos, _ := logger.snapshot(); len(infos) == 0 {
+		t.Error("恢复 LogLevelInfo 后应输出 Info 日志")
+	}
+}
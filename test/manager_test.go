@@ -3,6 +3,7 @@ package test
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -265,9 +266,9 @@ func TestJsonConfigHandler(t *testing.T) {
 	jsonFile := filepath.Join(testDir, "RedundantConfigJson.json")
 
 	if _, err := os.Stat(jsonFile); err == nil {
-		result := handler.ReadToFrontEndDataList("test", jsonFile)
-		if result == nil {
-			t.Error("期望 JSON 处理成功，但返回 nil")
+		result, err := handler.ReadToFrontEndDataList("test", jsonFile)
+		if err != nil || result == nil {
+			t.Errorf("期望 JSON 处理成功，但返回错误: %v", err)
 		} else {
 			dto := result.(*dto.FrontEndConfigDto)
 			if dto.Type != "json" {
@@ -333,9 +334,9 @@ func TestExcelConfigHandler(t *testing.T) {
 	excelFile := filepath.Join(testDir, "StudentExcel.xlsx")
 
 	if _, err := os.Stat(excelFile); err == nil {
-		result := handler.ReadToFrontEndDataList("test", excelFile)
-		if result == nil {
-			t.Error("期望 Excel 处理成功，但返回 nil")
+		result, err := handler.ReadToFrontEndDataList("test", excelFile)
+		if err != nil || result == nil {
+			t.Errorf("期望 Excel 处理成功，但返回错误: %v", err)
 		} else {
 			dto := result.(*dto.FrontEndConfigDto)
 			if dto.Type != "excel" {
@@ -362,9 +363,9 @@ func TestTsvConfigHandler(t *testing.T) {
 	tsvFile := filepath.Join(testDir, "test.tsv")
 
 	if _, err := os.Stat(tsvFile); err == nil {
-		result := handler.ReadToFrontEndDataList("test", tsvFile)
-		if result == nil {
-			t.Error("期望 TSV 处理成功，但返回 nil")
+		result, err := handler.ReadToFrontEndDataList("test", tsvFile)
+		if err != nil || result == nil {
+			t.Errorf("期望 TSV 处理成功，但返回错误: %v", err)
 		} else {
 			dto := result.(*dto.FrontEndConfigDto)
 			if dto.Type != "tsv" {
@@ -446,6 +447,26 @@ func BenchmarkConfigManager233_LoadAllConfigs(b *testing.B) {
 	}
 }
 
+// mustReadDataList 读取前端数据列表，失败时终止测试
+func mustReadDataList(t *testing.T, handler config233.ConfigHandler, configName, path string) *dto.FrontEndConfigDto {
+	t.Helper()
+	result, err := handler.ReadToFrontEndDataList(configName, path)
+	if err != nil {
+		t.Fatalf("读取配置 %s 失败: %v", configName, err)
+	}
+	return result.(*dto.FrontEndConfigDto)
+}
+
+// mustReadORM 读取并转换为对象列表，失败时终止测试
+func mustReadORM(t *testing.T, handler config233.ConfigHandler, typ reflect.Type, configName, path string) []interface{} {
+	t.Helper()
+	list, err := handler.ReadConfigAndORM(typ, configName, path)
+	if err != nil {
+		t.Fatalf("读取配置 %s 失败: %v", configName, err)
+	}
+	return list
+}
+
 // getTestDataDir 获取测试数据目录
 // 从项目根目录查找 testdata 目录
 func getTestDataDir() string {
@@ -520,6 +541,100 @@ func TestConfigManager233_GetKvToCsvStringList(t *testing.T) {
 	}
 }
 
+// TestConfigManager233_GetKvToCsvStringListOrEmpty 测试区分"id 存在值为空"与"id 不存在"
+func TestConfigManager233_GetKvToCsvStringListOrEmpty(t *testing.T) {
+	tempDir := t.TempDir()
+	kvJsonContent := `[
+		{"id": "list1", "value": "a, b"},
+		{"id": "empty", "value": ""},
+		{"id": "commas", "value": " , ,"}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "TestKvConfig.json"), []byte(kvJsonContent), 0644); err != nil {
+		t.Fatalf("写入测试文件失败: %v", err)
+	}
+
+	config233.Instance = config233.NewConfigManager233(tempDir)
+	config233.RegisterType[TestKvConfig]()
+	if err := config233.Instance.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	list, found := config233.GetKvToCsvStringListOrEmpty[TestKvConfig]("list1")
+	if !found || len(list) != 2 || list[0] != "a" || list[1] != "b" {
+		t.Errorf("list1 解析错误: %v, found=%v", list, found)
+	}
+
+	// id 存在但值为空：返回空切片与 found=true
+	for _, id := range []string{"empty", "commas"} {
+		list, found := config233.GetKvToCsvStringListOrEmpty[TestKvConfig](id)
+		if !found || list == nil || len(list) != 0 {
+			t.Errorf("%s 应返回非 nil 的空切片与 found=true: %#v, found=%v", id, list, found)
+		}
+	}
+
+	// id 不存在：返回 nil 与 found=false
+	if list, found := config233.GetKvToCsvStringListOrEmpty[TestKvConfig]("not_exist"); found || list != nil {
+		t.Errorf("not_exist 应返回 nil 与 found=false: %#v, found=%v", list, found)
+	}
+
+	// 原函数行为不变：值为空时仍返回默认值
+	if list := config233.GetKvToCsvStringList[TestKvConfig]("empty", []string{"d"}); len(list) != 1 || list[0] != "d" {
+		t.Errorf("GetKvToCsvStringList 值为空时应返回默认值: %v", list)
+	}
+}
+
+// TestConfigManager233_GetKvNumberApis 测试 GetKvToInt64 / GetKvToFloat64 / GetKvToCsvIntList
+func TestConfigManager233_GetKvNumberApis(t *testing.T) {
+	tempDir := t.TempDir()
+	kvJsonContent := `[
+		{"id": "big", "value": "9007199254740993"},
+		{"id": "rate", "value": " 0.25 "},
+		{"id": "ints", "value": " 1 , 2 ,, 3 "},
+		{"id": "bad", "value": "1,x"},
+		{"id": "empty", "value": ""}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "TestKvConfig.json"), []byte(kvJsonContent), 0644); err != nil {
+		t.Fatalf("写入测试文件失败: %v", err)
+	}
+
+	config233.Instance = config233.NewConfigManager233(tempDir)
+	config233.RegisterType[TestKvConfig]()
+	if err := config233.Instance.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if v := config233.GetKvToInt64[TestKvConfig]("big", 0); v != 9007199254740993 {
+		t.Errorf("GetKvToInt64 big 错误: %d", v)
+	}
+	if v := config233.GetKvToFloat64[TestKvConfig]("rate", 0); v != 0.25 {
+		t.Errorf("GetKvToFloat64 rate 错误: %v", v)
+	}
+	if v := config233.GetKvToFloat64[TestKvConfig]("big", 0); v != 9007199254740993 {
+		t.Errorf("GetKvToFloat64 应能解析整数: %v", v)
+	}
+	if list := config233.GetKvToCsvIntList[TestKvConfig]("ints", nil); len(list) != 3 || list[0] != 1 || list[1] != 2 || list[2] != 3 {
+		t.Errorf("GetKvToCsvIntList ints 错误: %v", list)
+	}
+
+	// 缺失、空值与无效值都返回默认值
+	defaultList := []int{7}
+	for _, id := range []string{"not_exist", "empty", "bad"} {
+		if list := config233.GetKvToCsvIntList[TestKvConfig](id, defaultList); len(list) != 1 || list[0] != 7 {
+			t.Errorf("GetKvToCsvIntList %s 应返回默认值: %v", id, list)
+		}
+	}
+	for _, id := range []string{"not_exist", "empty", "rate"} {
+		if v := config233.GetKvToInt64[TestKvConfig](id, -1); v != -1 {
+			t.Errorf("GetKvToInt64 %s 应返回默认值: %d", id, v)
+		}
+	}
+	for _, id := range []string{"not_exist", "empty", "bad"} {
+		if v := config233.GetKvToFloat64[TestKvConfig](id, 1.5); v != 1.5 {
+			t.Errorf("GetKvToFloat64 %s 应返回默认值: %v", id, v)
+		}
+	}
+}
+
 // 辅助类型 (需放在 Top Level 以便反射获取 Name)
 type TestKvConfig struct {
 	Id  string `json:"id"`
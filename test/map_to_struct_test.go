@@ -0,0 +1,56 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// CamelCaseKeyConfig 字段名与 json tag 大小写不一致的配置
+type CamelCaseKeyConfig struct {
+	Id            int     `json:"id"`
+	Itemid        int     `json:"itemId"`
+	Expiretimems  int64   `json:"expireTimeMs"`
+	Droprate      float64 `json:"dropRate"`
+	Isopen        bool    `json:"isOpen"`
+	Displayname   string  `json:"displayName" config233_column:"display_name"`
+	Rewardgroupid int     `json:"rewardGroupId"`
+}
+
+// TestMapToStruct_CamelCaseJsonTags 测试 key 全为 camelCase json tag 时的转换
+func TestMapToStruct_CamelCaseJsonTags(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "CamelCaseKeyConfig.json", `[
+		{"id": 1, "itemId": "1001", "expireTimeMs": "86400000", "dropRate": "0.25", "isOpen": "true",
+		 "displayName": "剑", "RewardGroupID": 7},
+		{"id": 2, "itemId": 1002, "expireTimeMs": 0, "dropRate": 1, "isOpen": 0, "display_name": "盾"}
+	]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[CamelCaseKeyConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	first, ok := config233.GetConfigById[CamelCaseKeyConfig](1)
+	if !ok {
+		t.Fatal("未找到 id=1 的配置")
+	}
+	want := CamelCaseKeyConfig{
+		Id: 1, Itemid: 1001, Expiretimems: 86400000, Droprate: 0.25, Isopen: true,
+		Displayname: "剑", Rewardgroupid: 7,
+	}
+	if *first != want {
+		t.Errorf("按 json tag 匹配并转换类型失败:\n实际 %+v\n期望 %+v", *first, want)
+	}
+
+	// json tag 未命中时回退到 config233_column tag
+	second, ok := config233.GetConfigById[CamelCaseKeyConfig](2)
+	if !ok {
+		t.Fatal("未找到 id=2 的配置")
+	}
+	if second.Itemid != 1002 || second.Displayname != "盾" || second.Isopen {
+		t.Errorf("回退匹配失败: %+v", *second)
+	}
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// MemoryItemConfig 用于测试内存估算的配置
+type MemoryItemConfig struct {
+	Id   int      `json:"id"`
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// writeMemoryItems 写入 count 条配置
+func writeMemoryItems(t *testing.T, dir string, count int) {
+	t.Helper()
+	items := make([]string, count)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"id": %d, "name": "item-%d", "tags": ["a", "b"]}`, i+1, i+1)
+	}
+	writeTextFile(t, dir, "MemoryItemConfig.json", "["+strings.Join(items, ",")+"]")
+}
+
+// TestEstimateMemoryUsage 测试估算值随数据量增大、重复估算结果稳定，总量为各配置之和
+func TestEstimateMemoryUsage(t *testing.T) {
+	tempDir := t.TempDir()
+	writeMemoryItems(t, tempDir, 10)
+	writeTextFile(t, tempDir, "MemoryOtherConfig.json", `[{"id": 1, "value": "x"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[MemoryItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	small := manager.EstimateMemoryUsage()
+	if len(small) != 2 || small["MemoryItemConfig"] <= 0 || small["MemoryOtherConfig"] <= 0 {
+		t.Fatalf("每个已加载的配置都应有估算值: %v", small)
+	}
+	if again := manager.EstimateMemoryUsage(); again["MemoryItemConfig"] != small["MemoryItemConfig"] {
+		t.Errorf("相同数据的估算值应稳定: %d != %d", again["MemoryItemConfig"], small["MemoryItemConfig"])
+	}
+	if total := manager.EstimateTotalMemoryUsage(); total != small["MemoryItemConfig"]+small["MemoryOtherConfig"] {
+		t.Errorf("总量应为各配置之和: %d", total)
+	}
+
+	writeMemoryItems(t, tempDir, 100)
+	if err := manager.ReloadConfig("MemoryItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	large := manager.EstimateMemoryUsage()
+	if large["MemoryItemConfig"] <= small["MemoryItemConfig"]*5 {
+		t.Errorf("数据量增大 10 倍后估算值应明显增大: %d -> %d", small["MemoryItemConfig"], large["MemoryItemConfig"])
+	}
+	if large["MemoryOtherConfig"] != small["MemoryOtherConfig"] {
+		t.Errorf("未变化的配置估算值应保持不变: %d -> %d", small["MemoryOtherConfig"], large["MemoryOtherConfig"])
+	}
+}
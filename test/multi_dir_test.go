@@ -0,0 +1,109 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// MultiDirItemConfig 用于测试多目录加载的配置
+type MultiDirItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// MultiDirShopConfig 只存在于基础目录的配置
+type MultiDirShopConfig struct {
+	Id    int `json:"id"`
+	Price int `json:"price"`
+}
+
+// MultiDirEventConfig 只存在于覆盖目录的配置
+type MultiDirEventConfig struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+func setupMultiDirManager(t *testing.T) (*config233.ConfigManager233, string, string) {
+	t.Helper()
+	manager, baseDir := newTestManager(t, map[string]string{
+		"MultiDirItemConfig.json": `[{"id": 1, "name": "base"}, {"id": 2, "name": "base-only"}]`,
+		"MultiDirShopConfig.json": `[{"id": 1, "price": 100}]`,
+	}, config233.RegisterType[MultiDirItemConfig], config233.RegisterType[MultiDirShopConfig], config233.RegisterType[MultiDirEventConfig])
+	overrideDir := writeTestFiles(t, map[string]string{
+		"MultiDirItemConfig.json":  `[{"id": 1, "name": "override"}]`,
+		"MultiDirEventConfig.json": `[{"id": 1, "title": "event"}]`,
+	})
+	if _, err := manager.AddConfigDir(overrideDir); err != nil {
+		t.Fatalf("添加配置目录失败: %v", err)
+	}
+	return manager, baseDir, overrideDir
+}
+
+// TestConfigManager233_AddConfigDirOverride 测试默认策略下后加入的目录整表覆盖先加入的目录
+func TestConfigManager233_AddConfigDirOverride(t *testing.T) {
+	manager, baseDir, overrideDir := setupMultiDirManager(t)
+
+	dirs := manager.GetConfigDirs()
+	if len(dirs) != 2 || dirs[0] != baseDir || dirs[1] != overrideDir {
+		t.Fatalf("配置目录顺序错误: %v", dirs)
+	}
+	// 重复加入的目录会被忽略
+	_, _ = manager.AddConfigDir(overrideDir)
+	_, _ = manager.AddConfigDir(baseDir)
+	if len(manager.GetConfigDirs()) != 2 {
+		t.Errorf("重复加入的目录应被忽略: %v", manager.GetConfigDirs())
+	}
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	item, ok := config233.GetConfigById[MultiDirItemConfig](1)
+	if !ok || item.Name != "override" {
+		t.Errorf("同名配置应使用覆盖目录中的文件: %+v", item)
+	}
+	// 覆盖以整张表为单位，基础表中的其他行不保留
+	if _, ok := config233.GetConfigById[MultiDirItemConfig](2); ok {
+		t.Error("被覆盖的配置表不应保留基础目录中的数据")
+	}
+	if shop, ok := config233.GetConfigById[MultiDirShopConfig](1); !ok || shop.Price != 100 {
+		t.Errorf("只存在于基础目录的配置应正常加载: %+v", shop)
+	}
+	if event, ok := config233.GetConfigById[MultiDirEventConfig](1); !ok || event.Title != "event" {
+		t.Errorf("只存在于覆盖目录的配置应正常加载: %+v", event)
+	}
+}
+
+// TestConfigManager233_AddConfigDirConflictError 测试冲突报错策略下同名配置导致加载失败
+func TestConfigManager233_AddConfigDirConflictError(t *testing.T) {
+	manager, _, _ := setupMultiDirManager(t)
+	manager.SetConfigDirConflictPolicy(config233.ConfigDirConflictError)
+
+	err := manager.LoadAllConfigs()
+	if err == nil {
+		t.Fatal("同名配置出现在多个目录时应返回错误")
+	}
+	if !strings.Contains(err.Error(), "MultiDirItemConfig") {
+		t.Errorf("错误信息应包含冲突的配置名: %v", err)
+	}
+	if len(manager.GetLoadedConfigNames()) != 0 {
+		t.Errorf("冲突时不应加载任何配置，实际已加载: %v", manager.GetLoadedConfigNames())
+	}
+}
+
+// TestConfigManager233_NewManagerResetsConfigDirs 测试重新创建管理器时清空追加的目录和冲突策略
+func TestConfigManager233_NewManagerResetsConfigDirs(t *testing.T) {
+	manager, _, _ := setupMultiDirManager(t)
+	manager.SetConfigDirConflictPolicy(config233.ConfigDirConflictError)
+
+	dir := t.TempDir()
+	manager = config233.NewConfigManager233(dir)
+	if dirs := manager.GetConfigDirs(); len(dirs) != 1 || dirs[0] != dir {
+		t.Errorf("重新创建后只应保留主目录: %v", dirs)
+	}
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Errorf("重新创建后冲突策略应恢复默认: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// NestedBase 被匿名嵌入的公共字段
+type NestedBase struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// NestedReward 嵌套的奖励结构体
+type NestedReward struct {
+	ItemId int `json:"itemId"`
+	Count  int `json:"count"`
+}
+
+// NestedCost 通过指针嵌套的消耗结构体
+type NestedCost struct {
+	Gold int `json:"gold"`
+}
+
+// NestedItemConfig 含匿名嵌入、嵌套结构体与嵌套结构体指针的配置
+type NestedItemConfig struct {
+	NestedBase
+	Reward NestedReward `json:"reward"`
+	Cost   *NestedCost  `json:"cost"`
+}
+
+// createNestedExcel 创建含嵌入字段与点分列名的 Excel
+func createNestedExcel(t *testing.T) string {
+	return createExcelWithRows(t, "NestedItemConfig.xlsx", [][]interface{}{
+		{"Client", "id", "name", "reward.itemId", "reward.count", "cost.gold"},
+		{"type", "int", "string", "int", "int", "int"},
+		{"Server", "id", "name", "reward.itemId", "reward.count", "cost.gold"},
+		{"", 1, "sword", 100, 2, 50},
+		{"", 2, "shield", 101, 1, ""},
+	})
+}
+
+// TestNestedStruct_ExcelORM 测试 Excel 处理器将嵌入字段与点分列名映射到嵌套结构体
+func TestNestedStruct_ExcelORM(t *testing.T) {
+	path := createNestedExcel(t)
+	list := mustReadORM(t, &excel.ExcelConfigHandler{}, reflect.TypeOf(NestedItemConfig{}), "NestedItemConfig", path)
+	if len(list) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(list))
+	}
+
+	first := list[0].(NestedItemConfig)
+	if first.Id != 1 || first.Name != "sword" {
+		t.Errorf("匿名嵌入结构体的字段应被映射: %+v", first.NestedBase)
+	}
+	if first.Reward != (NestedReward{ItemId: 100, Count: 2}) {
+		t.Errorf("点分列名应映射到嵌套结构体字段: %+v", first.Reward)
+	}
+	if first.Cost == nil || first.Cost.Gold != 50 {
+		t.Errorf("嵌套结构体指针应自动初始化并赋值: %+v", first.Cost)
+	}
+	if second := list[1].(NestedItemConfig); second.Reward.ItemId != 101 {
+		t.Errorf("第二行嵌套字段应被映射: %+v", second.Reward)
+	}
+}
+
+// TestNestedStruct_TsvORM 测试 TSV 处理器按字段名映射嵌入字段与嵌套字段
+func TestNestedStruct_TsvORM(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "NestedItemConfig.tsv",
+		"Id\tName\tReward.ItemId\tReward.Count\tCost.Gold\n1\tsword\t100\t2\t50\n")
+
+	list := mustReadORM(t, &tsv.TsvConfigHandler{}, reflect.TypeOf(NestedItemConfig{}), "NestedItemConfig", path)
+	if len(list) != 1 {
+		t.Fatalf("期望 1 条数据，实际 %d 条", len(list))
+	}
+	item := list[0].(NestedItemConfig)
+	if item.Id != 1 || item.Name != "sword" || item.Reward.ItemId != 100 || item.Reward.Count != 2 {
+		t.Errorf("嵌入字段与嵌套字段应被映射: %+v", item)
+	}
+	if item.Cost == nil || item.Cost.Gold != 50 {
+		t.Errorf("嵌套结构体指针应自动初始化并赋值: %+v", item.Cost)
+	}
+}
+
+// TestNestedStruct_ConfigManager 测试 ConfigManager233 加载 Excel 与 JSON 时映射嵌套结构体
+func TestNestedStruct_ConfigManager(t *testing.T) {
+	excelPath := createNestedExcel(t)
+	manager := config233.NewConfigManager233(filepath.Dir(excelPath))
+	config233.Instance = manager
+	config233.RegisterType[NestedItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	item, ok := config233.GetConfigById[NestedItemConfig](1)
+	if !ok {
+		t.Fatal("应能按匿名嵌入结构体中的 id 查询配置")
+	}
+	if item.Name != "sword" || item.Reward.ItemId != 100 || item.Reward.Count != 2 {
+		t.Errorf("嵌入字段与点分列名应被映射: %+v", item)
+	}
+	if item.Cost == nil || item.Cost.Gold != 50 {
+		t.Errorf("嵌套结构体指针应自动初始化并赋值: %+v", item.Cost)
+	}
+
+	// JSON 的嵌套对象同样映射到嵌套结构体
+	jsonDir := t.TempDir()
+	writeTextFile(t, jsonDir, "NestedItemConfig.json",
+		`[{"id": 3, "name": "bow", "reward": {"itemId": 102, "count": 5}, "cost": {"gold": 7}}]`)
+	manager = config233.NewConfigManager233(jsonDir)
+	config233.Instance = manager
+	config233.RegisterType[NestedItemConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	item, ok = config233.GetConfigById[NestedItemConfig](3)
+	if !ok || item.Reward.ItemId != 102 || item.Reward.Count != 5 || item.Cost == nil || item.Cost.Gold != 7 {
+		t.Errorf("JSON 嵌套对象应被映射: %+v", item)
+	}
+}
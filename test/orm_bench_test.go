@@ -0,0 +1,120 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+	"github.com/xuri/excelize/v2"
+)
+
+// WideBenchConfig 字段较多的配置，用于对比大表的 ORM 转换耗时
+type WideBenchConfig struct {
+	Id      int      `json:"id"`
+	Name    string   `json:"name"`
+	Level   int      `json:"level"`
+	Quality int      `json:"quality"`
+	Price   float64  `json:"price"`
+	Weight  float64  `json:"weight"`
+	Stack   int      `json:"stack"`
+	Bind    bool     `json:"bind"`
+	Icon    string   `json:"icon"`
+	Desc    string   `json:"desc"`
+	Atk     int      `json:"atk"`
+	Def     int      `json:"def"`
+	Hp      int      `json:"hp"`
+	Mp      int      `json:"mp"`
+	Speed   float64  `json:"speed"`
+	Crit    float64  `json:"crit"`
+	Tags    []string `json:"tags"`
+	Drops   []int    `json:"drops"`
+	Group   string   `json:"group"`
+	Remark  string   `json:"remark" config233_column:"memo"`
+}
+
+const wideBenchRows = 2000
+
+var wideBenchColumns = []string{"id", "name", "level", "quality", "price", "weight", "stack", "bind", "icon", "desc",
+	"atk", "def", "hp", "mp", "speed", "crit", "tags", "drops", "group", "memo"}
+
+// wideBenchRow 生成第 i 行数据
+func wideBenchRow(i int) []string {
+	return []string{fmt.Sprint(i), fmt.Sprintf("item%d", i), "10", "3", "1.5", "0.25", "99", "true", "icon.png", "desc",
+		"100", "50", "1000", "200", "1.25", "0.05", "a,b,c", "1,2,3", "weapon", "memo"}
+}
+
+// wideBenchTsv 生成 wideBenchRows 行的 TSV 内容，列名与字段名一致
+func wideBenchTsv() []byte {
+	var b bytes.Buffer
+	headers := make([]string, len(wideBenchColumns))
+	for i, column := range wideBenchColumns {
+		headers[i] = strings.ToUpper(column[:1]) + column[1:]
+	}
+	headers[len(headers)-1] = "Remark"
+	b.WriteString(strings.Join(headers, "\t") + "\n")
+	for i := 1; i <= wideBenchRows; i++ {
+		b.WriteString(strings.Join(wideBenchRow(i), "\t") + "\n")
+	}
+	return b.Bytes()
+}
+
+// wideBenchExcel 生成 wideBenchRows 行的单行表头 Excel 内容
+func wideBenchExcel(b *testing.B) []byte {
+	f := excelize.NewFile()
+	defer f.Close()
+	write := func(row int, values []string) {
+		cells := make([]interface{}, len(values))
+		for i, v := range values {
+			cells[i] = v
+		}
+		cell, _ := excelize.CoordinatesToCellName(1, row)
+		if err := f.SetSheetRow("Sheet1", cell, &cells); err != nil {
+			b.Fatalf("写入 Excel 行失败: %v", err)
+		}
+	}
+	write(1, wideBenchColumns)
+	for i := 1; i <= wideBenchRows; i++ {
+		write(i+1, wideBenchRow(i))
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		b.Fatalf("生成 Excel 失败: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// BenchmarkTsvReadConfigAndORM 测试字段较多的大表 TSV 转换为对象的耗时
+func BenchmarkTsvReadConfigAndORM(b *testing.B) {
+	data := wideBenchTsv()
+	handler := &tsv.TsvConfigHandler{}
+	typ := reflect.TypeOf(WideBenchConfig{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list, err := handler.ReadConfigAndORMFromBytes(typ, "WideBenchConfig", data)
+		if err != nil || len(list) != wideBenchRows {
+			b.Fatalf("转换失败: %v, %d", err, len(list))
+		}
+	}
+}
+
+// BenchmarkExcelReadConfigAndORM 测试字段较多的大表 Excel 转换为对象的耗时（含 xlsx 解析）
+func BenchmarkExcelReadConfigAndORM(b *testing.B) {
+	data := wideBenchExcel(b)
+	handler := &excel.ExcelConfigHandler{}
+	typ := reflect.TypeOf(WideBenchConfig{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list, err := handler.ReadConfigAndORMFromBytes(typ, "WideBenchConfig", data)
+		if err != nil || len(list) != wideBenchRows {
+			b.Fatalf("转换失败: %v, %d", err, len(list))
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"fmt"
+	"runtime"
 	"testing"
 
 	"github.com/neko233-com/config233-go/pkg/config233"
@@ -19,6 +21,57 @@ func BenchmarkParallelLoading(b *testing.B) {
 	}
 }
 
+// BenchmarkParallelLoading_Concurrency 测试不同并发度下的加载耗时
+func BenchmarkParallelLoading_Concurrency(b *testing.B) {
+	testDir := getTestDataDir()
+
+	for _, n := range []int{1, 2, 4, runtime.NumCPU()} {
+		b.Run(fmt.Sprintf("workers=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				manager := config233.NewConfigManager233(testDir)
+				manager.SetLoadConcurrency(n)
+				if err := manager.LoadAllConfigs(); err != nil {
+					b.Fatalf("加载配置失败: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// TestLoadConcurrency_SameResult 测试不同并发度下加载结果一致
+func TestLoadConcurrency_SameResult(t *testing.T) {
+	testDir := getTestDataDir()
+
+	load := func(n int) map[string]int {
+		manager := config233.NewConfigManager233(testDir)
+		manager.SetLoadConcurrency(n)
+		if err := manager.LoadAllConfigs(); err != nil {
+			t.Fatalf("并发度 %d 加载配置失败: %v", n, err)
+		}
+		counts := make(map[string]int)
+		for _, name := range manager.GetLoadedConfigNames() {
+			counts[name] = manager.GetConfigCount(name)
+		}
+		return counts
+	}
+
+	expected := load(0)
+	if len(expected) == 0 {
+		t.Fatal("默认并发度下没有加载到任何配置")
+	}
+	for _, n := range []int{1, 2, 100} {
+		got := load(n)
+		if len(got) != len(expected) {
+			t.Errorf("并发度 %d 加载的配置数 %d 与默认 %d 不一致", n, len(got), len(expected))
+		}
+		for name, count := range expected {
+			if got[name] != count {
+				t.Errorf("并发度 %d 下 %s 数量 %d，期望 %d", n, name, got[name], count)
+			}
+		}
+	}
+}
+
 // TestParallelLoadingCorrectness 测试并行加载的正确性
 func TestParallelLoadingCorrectness(t *testing.T) {
 	testDir := getTestDataDir()
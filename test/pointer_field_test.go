@@ -0,0 +1,104 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// OptionalFieldConfig 用指针字段区分"没填"与"填了 0"的配置
+type OptionalFieldConfig struct {
+	Id    int      `json:"id"`
+	Level *int     `json:"level"`
+	Name  *string  `json:"name"`
+	Rate  *float64 `json:"rate"`
+	On    *bool    `json:"on"`
+}
+
+// checkOptionalFields 校验第一条全部显式填写零值（字符串为 "x"），第二条全部留空
+func checkOptionalFields(t *testing.T, filled, empty OptionalFieldConfig) {
+	t.Helper()
+	if filled.Level == nil || *filled.Level != 0 {
+		t.Errorf("填了 0 的 *int 应分配并为 0: %v", filled.Level)
+	}
+	if filled.Name == nil || *filled.Name != "x" {
+		t.Errorf("有值的 *string 应分配并赋值: %v", filled.Name)
+	}
+	if filled.Rate == nil || *filled.Rate != 0 {
+		t.Errorf("填了 0 的 *float64 应分配并为 0: %v", filled.Rate)
+	}
+	if filled.On == nil || *filled.On {
+		t.Errorf("填了 false 的 *bool 应分配并为 false: %v", filled.On)
+	}
+	if empty.Level != nil || empty.Name != nil || empty.Rate != nil || empty.On != nil {
+		t.Errorf("空单元格的指针字段应保持 nil: %+v", empty)
+	}
+}
+
+// createOptionalFieldExcel 创建第一行填写零值、第二行留空的 Excel
+func createOptionalFieldExcel(t *testing.T) string {
+	return createExcelWithRows(t, "OptionalFieldConfig.xlsx", [][]interface{}{
+		{"Client", "id", "level", "name", "rate", "on"},
+		{"type", "int", "int", "string", "double", "bool"},
+		{"Server", "id", "level", "name", "rate", "on"},
+		{"", 1, 0, "x", 0, "false"},
+		{"", 2, "", "", "", "", "end"}, // 末尾多一列无表头的内容，保证前面的空单元格被读出
+	})
+}
+
+// TestPointerField_ExcelORM 测试 Excel 处理器对指针标量字段：空单元格为 nil，有值时分配
+func TestPointerField_ExcelORM(t *testing.T) {
+	path := createOptionalFieldExcel(t)
+	list := mustReadORM(t, &excel.ExcelConfigHandler{}, reflect.TypeOf(OptionalFieldConfig{}), "OptionalFieldConfig", path)
+	if len(list) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(list))
+	}
+	checkOptionalFields(t, list[0].(OptionalFieldConfig), list[1].(OptionalFieldConfig))
+}
+
+// TestPointerField_TsvORM 测试 TSV 处理器对指针标量字段：空单元格为 nil，有值时分配
+func TestPointerField_TsvORM(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "OptionalFieldConfig.tsv",
+		"Id\tLevel\tName\tRate\tOn\n1\t0\tx\t0\tfalse\n2\t \t\t\t\n")
+	list := mustReadORM(t, &tsv.TsvConfigHandler{}, reflect.TypeOf(OptionalFieldConfig{}), "OptionalFieldConfig", path)
+	if len(list) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(list))
+	}
+	checkOptionalFields(t, list[0].(OptionalFieldConfig), list[1].(OptionalFieldConfig))
+}
+
+// TestPointerField_ConfigManager 测试 ConfigManager233 加载 Excel、TSV 与 JSON 时指针标量字段的 nil 语义
+func TestPointerField_ConfigManager(t *testing.T) {
+	tsvDir := t.TempDir()
+	writeTextFile(t, tsvDir, "OptionalFieldConfig.tsv",
+		"id\tlevel\tname\trate\ton\n1\t0\tx\t0\tfalse\n2\t\t\t\t\n")
+	jsonDir := t.TempDir()
+	writeTextFile(t, jsonDir, "OptionalFieldConfig.json",
+		`[{"id": 1, "level": 0, "name": "x", "rate": 0, "on": false}, {"id": 2, "level": null, "name": "", "rate": ""}]`)
+
+	for format, dir := range map[string]string{
+		"excel": filepath.Dir(createOptionalFieldExcel(t)),
+		"tsv":   tsvDir,
+		"json":  jsonDir,
+	} {
+		manager := config233.NewConfigManager233(dir)
+		config233.Instance = manager
+		config233.RegisterType[OptionalFieldConfig]()
+		if err := manager.LoadAllConfigs(); err != nil {
+			t.Fatalf("%s: 加载配置失败: %v", format, err)
+		}
+
+		filled, ok1 := config233.GetConfigById[OptionalFieldConfig](1)
+		empty, ok2 := config233.GetConfigById[OptionalFieldConfig](2)
+		if !ok1 || !ok2 {
+			t.Fatalf("%s: 配置应被加载", format)
+		}
+		t.Run(format, func(t *testing.T) {
+			checkOptionalFields(t, *filled, *empty)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package test
+
+import (
+	"testing"
+
+	config233 "github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// FishingWeaponConfigFix 结构体名与文件名不同，通过 RegisterTypeWithName 映射到 FishingWeaponConfig.xlsx
+type FishingWeaponConfigFix struct {
+	Id                  int `json:"id" config233_column:"id"`
+	SkillId             int `json:"skillId" config233_column:"skillId"`
+	UnlockCostGoldCount int `json:"unlockCostGoldCount" config233_column:"unlockCostGoldCount"`
+}
+
+// RenamedJsonConfig 映射到 JSON 配置文件的结构体
+type RenamedJsonConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestRegisterTypeWithName_Excel 测试结构体名与 Excel 文件名解耦
+func TestRegisterTypeWithName_Excel(t *testing.T) {
+	manager := config233.NewConfigManager233(getTestDataDir())
+	config233.Instance = manager
+	config233.RegisterTypeWithName[FishingWeaponConfigFix]("FishingWeaponConfig")
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	cfg, ok := config233.GetConfigById[FishingWeaponConfigFix](1001)
+	if !ok || cfg == nil || cfg.Id != 1001 {
+		t.Fatalf("应能按映射的配置名获取配置: %+v", cfg)
+	}
+	list := config233.GetConfigList[FishingWeaponConfigFix]()
+	if len(list) == 0 {
+		t.Fatal("GetConfigList 应返回映射配置的数据")
+	}
+	if count := config233.GetConfigListCount[FishingWeaponConfigFix](); count != len(list) {
+		t.Errorf("GetConfigListCount 应与列表长度一致: count=%d len=%d", count, len(list))
+	}
+	if m := config233.GetConfigMap[FishingWeaponConfigFix](); len(m) != len(list) {
+		t.Errorf("GetConfigMap 应与列表长度一致: map=%d len=%d", len(m), len(list))
+	}
+}
+
+// TestRegisterTypeWithName_ResetByNewManager 测试重新创建管理器后映射被清空
+func TestRegisterTypeWithName_ResetByNewManager(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "RenamedConfig.json", `[{"id": 1, "name": "renamed"}]`)
+	writeTextFile(t, tempDir, "RenamedJsonConfig.json", `[{"id": 1, "name": "by-type-name"}]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterTypeWithName[RenamedJsonConfig]("RenamedConfig")
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if cfg, ok := config233.GetConfigById[RenamedJsonConfig](1); !ok || cfg.Name != "renamed" {
+		t.Fatalf("应读取 RenamedConfig.json: %+v", cfg)
+	}
+
+	manager = config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[RenamedJsonConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	if cfg, ok := config233.GetConfigById[RenamedJsonConfig](1); !ok || cfg.Name != "by-type-name" {
+		t.Errorf("重新创建管理器后应按类型名读取: %+v", cfg)
+	}
+}
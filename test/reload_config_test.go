@@ -0,0 +1,138 @@
+package test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// ReloadItemConfig 用于测试按名称重载的配置
+type ReloadItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ReloadShopConfig 不参与重载的配置
+type ReloadShopConfig struct {
+	Id    int `json:"id"`
+	Price int `json:"price"`
+}
+
+// reloadRecorder 记录 OnConfigLoadComplete 收到的配置名
+type reloadRecorder struct {
+	mu      sync.Mutex
+	batches [][]string
+}
+
+func (r *reloadRecorder) OnConfigLoadComplete(changedConfigNameList []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, changedConfigNameList)
+}
+
+func (r *reloadRecorder) OnFirstAllConfigDone() {}
+
+func (r *reloadRecorder) snapshot() [][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([][]string(nil), r.batches...)
+}
+
+func setupReloadManager(t *testing.T) (*config233.ConfigManager233, string, *reloadRecorder) {
+	t.Helper()
+	manager, tempDir := newTestManager(t, map[string]string{
+		"ReloadItemConfig.json": `[{"id": 1, "name": "v1"}]`,
+		"ReloadShopConfig.json": `[{"id": 1, "price": 100}]`,
+	}, config233.RegisterType[ReloadItemConfig], config233.RegisterType[ReloadShopConfig])
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+	return manager, tempDir, recorder
+}
+
+// TestConfigManager233_ReloadConfig 测试只重载指定配置并触发一次加载完成回调
+func TestConfigManager233_ReloadConfig(t *testing.T) {
+	manager, tempDir, recorder := setupReloadManager(t)
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}]`)
+	writeTextFile(t, tempDir, "ReloadShopConfig.json", `[{"id": 1, "price": 200}]`)
+
+	if err := manager.ReloadConfig("ReloadItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v2" {
+		t.Errorf("指定的配置应被重载: %+v", item)
+	}
+	if shop, ok := config233.GetConfigById[ReloadShopConfig](1); !ok || shop.Price != 100 {
+		t.Errorf("未指定的配置不应被重载: %+v", shop)
+	}
+	batches := recorder.snapshot()
+	if len(batches) != 1 || len(batches[0]) != 1 || batches[0][0] != "ReloadItemConfig" {
+		t.Errorf("应触发一次只包含重载配置的回调: %v", batches)
+	}
+}
+
+// TestConfigManager233_ReloadConfigs 测试多个配置合并为一次回调
+func TestConfigManager233_ReloadConfigs(t *testing.T) {
+	manager, tempDir, recorder := setupReloadManager(t)
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}]`)
+	writeTextFile(t, tempDir, "ReloadShopConfig.json", `[{"id": 1, "price": 200}]`)
+
+	if err := manager.ReloadConfigs([]string{"ReloadItemConfig", "ReloadShopConfig"}); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v2" {
+		t.Errorf("ReloadItemConfig 应被重载: %+v", item)
+	}
+	if shop, ok := config233.GetConfigById[ReloadShopConfig](1); !ok || shop.Price != 200 {
+		t.Errorf("ReloadShopConfig 应被重载: %+v", shop)
+	}
+	if batches := recorder.snapshot(); len(batches) != 1 || len(batches[0]) != 2 {
+		t.Errorf("多个配置应合并为一次回调: %v", batches)
+	}
+}
+
+// TestConfigManager233_ReloadConfigNotFound 测试找不到配置文件时返回错误且不重载任何配置
+func TestConfigManager233_ReloadConfigNotFound(t *testing.T) {
+	manager, tempDir, recorder := setupReloadManager(t)
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}]`)
+
+	err := manager.ReloadConfigs([]string{"ReloadItemConfig", "MissingReloadConfig"})
+	if err == nil {
+		t.Fatal("找不到配置文件时应返回错误")
+	}
+	if !strings.Contains(err.Error(), "MissingReloadConfig") {
+		t.Errorf("错误信息应包含找不到的配置名: %v", err)
+	}
+
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v1" {
+		t.Errorf("存在缺失文件时不应重载任何配置: %+v", item)
+	}
+	if batches := recorder.snapshot(); len(batches) != 0 {
+		t.Errorf("失败的重载不应触发回调: %v", batches)
+	}
+}
+
+// TestConfigManager233_ReloadConfigFailed 测试重载失败时返回错误并保留旧数据
+func TestConfigManager233_ReloadConfigFailed(t *testing.T) {
+	manager, tempDir, _ := setupReloadManager(t)
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": `)
+
+	err := manager.ReloadConfig("ReloadItemConfig")
+	if err == nil || !strings.Contains(err.Error(), "ReloadItemConfig") {
+		t.Fatalf("重载失败时应返回包含配置名的错误: %v", err)
+	}
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v1" {
+		t.Errorf("重载失败时应保留旧数据: %+v", item)
+	}
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestSubscribeReload_DeliversEvents 测试每次批量重载完成后向所有订阅者发送事件
+func TestSubscribeReload_DeliversEvents(t *testing.T) {
+	manager, tempDir, _ := setupReloadManager(t)
+	first := manager.SubscribeReload()
+	second := manager.SubscribeReload()
+	defer manager.Unsubscribe(first)
+	defer manager.Unsubscribe(second)
+
+	before := time.Now()
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}]`)
+	if err := os.Remove(filepath.Join(tempDir, "ReloadShopConfig.json")); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+	if err := manager.TriggerReloadAndWait([]string{"ReloadShopConfig", "ReloadItemConfig"}); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+
+	for _, ch := range []<-chan config233.ReloadEvent{first, second} {
+		select {
+		case event := <-ch:
+			if want := []string{"ReloadItemConfig", "ReloadShopConfig"}; !reflect.DeepEqual(event.ConfigNames, want) {
+				t.Errorf("期望变更配置 %v，实际 %v", want, event.ConfigNames)
+			}
+			if want := []string{"ReloadShopConfig"}; !reflect.DeepEqual(event.RemovedConfigs, want) {
+				t.Errorf("期望删除配置 %v，实际 %v", want, event.RemovedConfigs)
+			}
+			if event.Time.Before(before) {
+				t.Errorf("事件时间应为重载完成时间: %v", event.Time)
+			}
+			if event.Trigger != config233.ReloadTriggerManual || event.FileEvents != 0 || event.BatchConfigs != 2 {
+				t.Errorf("手动触发的事件统计不符合预期: trigger=%s fileEvents=%d batchConfigs=%d", event.Trigger, event.FileEvents, event.BatchConfigs)
+			}
+		default:
+			t.Fatal("重载完成后每个订阅者都应收到事件")
+		}
+	}
+}
+
+// TestSubscribeReload_Unsubscribe 测试取消订阅后 channel 被关闭且不再收到事件
+func TestSubscribeReload_Unsubscribe(t *testing.T) {
+	manager, _, _ := setupReloadManager(t)
+	ch := manager.SubscribeReload()
+
+	if !manager.Unsubscribe(ch) {
+		t.Fatal("应能取消已有的订阅")
+	}
+	if manager.Unsubscribe(ch) {
+		t.Error("重复取消订阅应返回 false")
+	}
+	if err := manager.TriggerReloadAndWait([]string{"ReloadItemConfig"}); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("取消订阅后 channel 应被关闭且没有新事件")
+	}
+}
+
+// TestSubscribeReload_SlowConsumer 测试消费者不读取时重载不被阻塞，缓冲满后保留最新的事件
+func TestSubscribeReload_SlowConsumer(t *testing.T) {
+	manager, tempDir, _ := setupReloadManager(t)
+	ch := manager.SubscribeReload()
+	defer manager.Unsubscribe(ch)
+
+	total := config233.ReloadEventBufferSize + 5
+	for i := 0; i < total; i++ {
+		name := "ReloadItemConfig"
+		if i == total-1 {
+			// 最后一次只重载另一个配置，用于确认保留的是最新事件
+			name = "ReloadShopConfig"
+		}
+		writeTextFile(t, tempDir, name+".json", `[{"id": 1}]`)
+		if err := manager.TriggerReloadAndWait([]string{name}); err != nil {
+			t.Fatalf("重载配置失败: %v", err)
+		}
+	}
+
+	if got := len(ch); got != config233.ReloadEventBufferSize {
+		t.Fatalf("缓冲应保持满的 %d 个事件，实际 %d 个", config233.ReloadEventBufferSize, got)
+	}
+	var last config233.ReloadEvent
+	for len(ch) > 0 {
+		last = <-ch
+	}
+	if !reflect.DeepEqual(last.ConfigNames, []string{"ReloadShopConfig"}) {
+		t.Errorf("应丢弃最旧的事件并保留最新的事件，最后一个事件为 %v", last.ConfigNames)
+	}
+}
@@ -0,0 +1,229 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// RemoteItemConfig 用于测试从 HTTP 加载的配置
+type RemoteItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// remoteConfigServer 可在测试中修改响应内容的配置中心
+type remoteConfigServer struct {
+	mu          sync.Mutex
+	body        string
+	contentType string
+	failTimes   int32 // 前几次请求返回 500
+	requests    atomic.Int32
+}
+
+func (s *remoteConfigServer) set(body, contentType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.body, s.contentType = body, contentType
+}
+
+func (s *remoteConfigServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if n := s.requests.Add(1); n <= s.failTimes {
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+		return
+	}
+	s.mu.Lock()
+	body, contentType := s.body, s.contentType
+	s.mu.Unlock()
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Write([]byte(body))
+}
+
+// TestLoadConfigFromURL_Json 测试从 HTTP 拉取 JSON 配置并建立 ID 索引
+func TestLoadConfigFromURL_Json(t *testing.T) {
+	server := &remoteConfigServer{}
+	server.set(`[{"id": 1, "name": "sword"}, {"id": 2, "name": "shield"}]`, "application/json; charset=utf-8")
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+
+	if err := config233.LoadConfigFromURL[RemoteItemConfig]("RemoteItemConfig", ts.URL+"/configs/item"); err != nil {
+		t.Fatalf("从 URL 加载配置失败: %v", err)
+	}
+	item, ok := config233.GetConfigById[RemoteItemConfig](2)
+	if !ok || item.Name != "shield" {
+		t.Errorf("应按 id 建立索引，实际: %+v, ok=%v", item, ok)
+	}
+	if list := config233.GetConfigList[RemoteItemConfig](); len(list) != 2 {
+		t.Errorf("期望 2 条配置，实际 %d", len(list))
+	}
+	if batches := recorder.snapshot(); len(batches) != 1 || batches[0][0] != "RemoteItemConfig" {
+		t.Errorf("加载后应通知 OnConfigLoadComplete: %v", batches)
+	}
+	if stat := manager.GetLoadStats()["RemoteItemConfig"]; stat.Format != "json" || stat.RecordCount != 2 {
+		t.Errorf("加载统计不正确: %+v", stat)
+	}
+}
+
+// TestLoadConfigFromURL_FormatByExt 测试 Content-Type 无法识别时按 name 的扩展名选择解析器
+func TestLoadConfigFromURL_FormatByExt(t *testing.T) {
+	server := &remoteConfigServer{}
+	server.set("id\tname\n1\tsword\n", "text/plain")
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	config233.Instance = config233.NewConfigManager233(t.TempDir())
+	if err := config233.LoadConfigFromURL[RemoteItemConfig]("RemoteItemConfig.tsv", ts.URL); err != nil {
+		t.Fatalf("从 URL 加载配置失败: %v", err)
+	}
+	if item, ok := config233.GetConfigById[RemoteItemConfig](1); !ok || item.Name != "sword" {
+		t.Errorf("应按 tsv 解析，实际: %+v, ok=%v", item, ok)
+	}
+}
+
+// TestLoadConfigFromURL_RetryAndTimeout 测试失败重试与超时
+func TestLoadConfigFromURL_RetryAndTimeout(t *testing.T) {
+	server := &remoteConfigServer{failTimes: 2}
+	server.set(`[{"id": 1, "name": "sword"}]`, "application/json")
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	manager.SetRemoteLoadRetry(1, 10*time.Millisecond)
+	if err := config233.LoadConfigFromURL[RemoteItemConfig]("RemoteItemConfig", ts.URL); err == nil {
+		t.Fatal("重试次数不足时应返回错误")
+	}
+	if _, ok := config233.GetConfigById[RemoteItemConfig](1); ok {
+		t.Error("加载失败时不应写入配置")
+	}
+
+	manager.SetRemoteLoadRetry(2, 10*time.Millisecond)
+	server.requests.Store(0)
+	if err := config233.LoadConfigFromURL[RemoteItemConfig]("RemoteItemConfig", ts.URL); err != nil {
+		t.Fatalf("重试后应加载成功: %v", err)
+	}
+	if n := server.requests.Load(); n != 3 {
+		t.Errorf("期望请求 3 次，实际 %d 次", n)
+	}
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(`[]`))
+	}))
+	defer slow.Close()
+	manager.SetRemoteLoadTimeout(20*time.Millisecond).SetRemoteLoadRetry(0, 0)
+	start := time.Now()
+	if err := config233.LoadConfigFromURL[RemoteItemConfig]("RemoteItemConfig", slow.URL); err == nil {
+		t.Error("超时应返回错误")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("超时设置未生效，耗时 %v", elapsed)
+	}
+}
+
+// TestStartPollingURL 测试轮询刷新：内容变化时重新加载并通知，内容不变时不通知
+func TestStartPollingURL(t *testing.T) {
+	server := &remoteConfigServer{}
+	server.set(`[{"id": 1, "name": "v1"}]`, "application/json")
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	config233.RegisterTypeWithName[RemoteItemConfig]("RemoteItemConfig")
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+
+	if err := manager.StartPollingURL("RemoteItemConfig", ts.URL, 0); err == nil {
+		t.Error("轮询间隔为 0 时应返回错误")
+	}
+	if err := manager.StartPollingURL("RemoteItemConfig", ts.URL, 20*time.Millisecond); err != nil {
+		t.Fatalf("启动轮询失败: %v", err)
+	}
+	defer manager.StopPollingURL("RemoteItemConfig")
+
+	if !waitUntil(2*time.Second, func() bool {
+		item, ok := config233.GetConfigById[RemoteItemConfig](1)
+		return ok && item.Name == "v1"
+	}) {
+		t.Fatal("轮询应完成首次加载")
+	}
+
+	// 等待若干轮内容不变的轮询，不应重复通知
+	time.Sleep(100 * time.Millisecond)
+	if n := len(recorder.snapshot()); n != 1 {
+		t.Errorf("内容不变时不应重复通知，实际通知 %d 次", n)
+	}
+
+	server.set(`[{"id": 1, "name": "v2"}]`, "application/json")
+	if !waitUntil(2*time.Second, func() bool {
+		item, ok := config233.GetConfigById[RemoteItemConfig](1)
+		return ok && item.Name == "v2"
+	}) {
+		t.Fatal("内容变化后应重新加载")
+	}
+	if n := len(recorder.snapshot()); n != 2 {
+		t.Errorf("内容变化后应再次通知，实际通知 %d 次", n)
+	}
+
+	if !manager.StopPollingURL("RemoteItemConfig") {
+		t.Error("StopPollingURL 应返回 true")
+	}
+	if manager.StopPollingURL("RemoteItemConfig") {
+		t.Error("重复停止应返回 false")
+	}
+	requests := server.requests.Load()
+	time.Sleep(100 * time.Millisecond)
+	if n := server.requests.Load(); n > requests+1 {
+		t.Errorf("停止后不应继续轮询: %d -> %d", requests, n)
+	}
+	if !strings.Contains(manager.GetLoadStats()["RemoteItemConfig"].FileName, ts.URL) {
+		t.Errorf("加载统计应记录 URL: %+v", manager.GetLoadStats()["RemoteItemConfig"])
+	}
+}
+
+// TestStartPollingURL_StoppedByReset 测试重置管理器会停止之前的轮询，旧轮询不再写入配置或通知新的业务管理器
+func TestStartPollingURL_StoppedByReset(t *testing.T) {
+	server := &remoteConfigServer{}
+	server.set(`[{"id": 1, "name": "v1"}]`, "application/json")
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	manager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	config233.RegisterType[RemoteItemConfig]()
+	if err := manager.StartPollingURL("RemoteItemConfig", ts.URL, 10*time.Millisecond); err != nil {
+		t.Fatalf("启动轮询失败: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	manager = config233.NewConfigManager233(t.TempDir())
+	config233.Instance = manager
+	recorder := &reloadRecorder{}
+	manager.RegisterBusinessManager(recorder)
+	server.set(`[{"id": 1, "name": "v2"}]`, "application/json")
+	time.Sleep(100 * time.Millisecond)
+
+	if n := manager.GetConfigCount("RemoteItemConfig"); n != 0 {
+		t.Errorf("重置后旧轮询不应写入配置，实际 %d 条", n)
+	}
+	if calls := recorder.snapshot(); len(calls) != 0 {
+		t.Errorf("重置后旧轮询不应通知新的业务管理器: %v", calls)
+	}
+	if manager.StopPollingURL("RemoteItemConfig") {
+		t.Error("重置后不应再有进行中的轮询")
+	}
+}
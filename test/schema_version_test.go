@@ -0,0 +1,88 @@
+package test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// SchemaJsonConfig 在 JSON 顶层声明版本号的配置
+type SchemaJsonConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// SchemaExcelConfig 在 Excel 表头声明版本号的配置
+type SchemaExcelConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// newSchemaVersionManager 创建加载声明了 schema 版本号配置的管理器：JSON 为版本 2，Excel 为版本 3
+func newSchemaVersionManager(t *testing.T) *config233.ConfigManager233 {
+	t.Helper()
+	path := createExcelWithRows(t, "SchemaExcelConfig.xlsx", [][]interface{}{
+		{"version", 3},
+		{"Client", "id", "name"},
+		{"type", "int", "string"},
+		{"Server", "id", "name"},
+		{"", 1, "sword"},
+		{"", 2, "shield"},
+	})
+	dir := filepath.Dir(path)
+	writeTextFile(t, dir, "SchemaJsonConfig.json", `{"__version": 2, "data": [{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]}`)
+
+	return newTestManagerAt(t, dir, config233.RegisterType[SchemaJsonConfig], config233.RegisterType[SchemaExcelConfig])
+}
+
+// TestSchemaVersion_Match 测试版本号在支持范围内时正常加载，版本号不作为配置数据
+func TestSchemaVersion_Match(t *testing.T) {
+	manager := newSchemaVersionManager(t)
+	manager.SetSupportedSchemaVersion(2, 3)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("版本号在支持范围内时不应加载失败: %v", err)
+	}
+
+	jsonList := config233.GetConfigList[SchemaJsonConfig]()
+	if len(jsonList) != 2 || jsonList[1].Name != "b" {
+		t.Errorf("JSON 配置应从 data 字段读取 2 条，实际 %d 条", len(jsonList))
+	}
+	excelList := config233.GetConfigList[SchemaExcelConfig]()
+	if len(excelList) != 2 || excelList[0].Name != "sword" {
+		t.Errorf("Excel 版本行不应影响表头识别，实际 %d 条", len(excelList))
+	}
+}
+
+// TestSchemaVersion_Mismatch 测试版本号超出支持范围时拒绝加载
+func TestSchemaVersion_Mismatch(t *testing.T) {
+	manager := newSchemaVersionManager(t)
+	manager.SetSupportedSchemaVersion(1, 2)
+	err := manager.LoadAllConfigs()
+	if !errors.Is(err, config233.ErrSchemaVersionUnsupported) {
+		t.Fatalf("期望返回 ErrSchemaVersionUnsupported，实际: %v", err)
+	}
+
+	if list := config233.GetConfigList[SchemaExcelConfig](); len(list) != 0 {
+		t.Errorf("版本过新的 Excel 配置不应被加载，实际 %d 条", len(list))
+	}
+	if list := config233.GetConfigList[SchemaJsonConfig](); len(list) != 2 {
+		t.Errorf("版本匹配的 JSON 配置应照常加载，实际 %d 条", len(list))
+	}
+}
+
+// TestSchemaVersion_WarnPolicy 测试 SchemaVersionWarn 策略下版本不匹配仍然加载
+func TestSchemaVersion_WarnPolicy(t *testing.T) {
+	manager := newSchemaVersionManager(t)
+	manager.SetSupportedSchemaVersion(4, 0).SetSchemaVersionPolicy(config233.SchemaVersionWarn)
+	t.Cleanup(func() {
+		manager.SetSupportedSchemaVersion(0, 0).SetSchemaVersionPolicy(config233.SchemaVersionReject)
+	})
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("SchemaVersionWarn 策略下不应加载失败: %v", err)
+	}
+	if list := config233.GetConfigList[SchemaJsonConfig](); len(list) != 2 {
+		t.Errorf("版本不匹配时仍应加载，实际 %d 条", len(list))
+	}
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// SliceFieldConfig 带逗号分隔切片字段的配置
+type SliceFieldConfig struct {
+	Id               int       `json:"id"`
+	UseConditionList []int     `json:"useConditionList"`
+	Tags             []string  `json:"tags"`
+	Rewards          []int64   `json:"rewards" config233_sep:";"`
+	Rates            []float64 `json:"rates" config233_sep:"|"`
+}
+
+// checkSliceFieldConfig 校验第 1 行为完整数据、第 2 行为空单元格
+func checkSliceFieldConfig(t *testing.T, source string, list []SliceFieldConfig) {
+	t.Helper()
+	if len(list) != 2 {
+		t.Fatalf("[%s] 期望 2 条配置，实际: %+v", source, list)
+	}
+	first := list[0]
+	if !reflect.DeepEqual(first.UseConditionList, []int{1, 2, 3}) {
+		t.Errorf("[%s] []int 拆分错误: %v", source, first.UseConditionList)
+	}
+	if !reflect.DeepEqual(first.Tags, []string{"a", "b"}) {
+		t.Errorf("[%s] []string 拆分错误: %v", source, first.Tags)
+	}
+	if !reflect.DeepEqual(first.Rewards, []int64{10001, 10002}) {
+		t.Errorf("[%s] config233_sep 自定义分隔符拆分错误: %v", source, first.Rewards)
+	}
+	if !reflect.DeepEqual(first.Rates, []float64{0.5, 1.25}) {
+		t.Errorf("[%s] []float64 拆分错误: %v", source, first.Rates)
+	}
+
+	second := list[1]
+	if second.UseConditionList == nil || len(second.UseConditionList) != 0 {
+		t.Errorf("[%s] 空字符串应得到空切片: %#v", source, second.UseConditionList)
+	}
+	if second.Rewards == nil || len(second.Rewards) != 0 {
+		t.Errorf("[%s] 空字符串应得到空切片: %#v", source, second.Rewards)
+	}
+}
+
+// TestSliceField_ConfigManager 测试 ConfigManager233 加载 JSON / TSV 时拆分切片字段
+func TestSliceField_ConfigManager(t *testing.T) {
+	for _, tc := range []struct {
+		fileName string
+		content  string
+	}{
+		{"SliceFieldConfig.json", `[
+			{"id": 1, "useConditionList": "1,2,3", "tags": "a, b", "rewards": "10001;10002", "rates": "0.5|1.25"},
+			{"id": 2, "useConditionList": "", "tags": "", "rewards": "", "rates": ""}
+		]`},
+		{"SliceFieldConfig.tsv", "id\tuseConditionList\ttags\trewards\trates\n" +
+			"1\t1,2,3\ta,b\t10001;10002\t0.5|1.25\n" +
+			"2\t\t\t\t\n"},
+	} {
+		t.Run(tc.fileName, func(t *testing.T) {
+			tempDir := t.TempDir()
+			writeTextFile(t, tempDir, tc.fileName, tc.content)
+
+			manager := config233.NewConfigManager233(tempDir)
+			config233.Instance = manager
+			config233.RegisterType[SliceFieldConfig]()
+			if err := manager.LoadAllConfigs(); err != nil {
+				t.Fatalf("加载配置失败: %v", err)
+			}
+
+			var list []SliceFieldConfig
+			for _, id := range []int{1, 2} {
+				cfg, ok := config233.GetConfigById[SliceFieldConfig](id)
+				if !ok {
+					t.Fatalf("未找到 id=%d 的配置", id)
+				}
+				list = append(list, *cfg)
+			}
+			checkSliceFieldConfig(t, tc.fileName, list)
+		})
+	}
+}
+
+// TsvSliceFieldConfig TSV 处理器直接转换的结构体，表头即字段名
+type TsvSliceFieldConfig struct {
+	Id               int
+	UseConditionList []int
+	Tags             []string
+	Rewards          []int64   `config233_sep:";"`
+	Rates            []float64 `config233_sep:"|"`
+}
+
+// TestSliceField_Handlers 测试 Excel / TSV 处理器直接转换结构体时拆分切片字段
+func TestSliceField_Handlers(t *testing.T) {
+	excelData := buildExcelBytes(t, [][]interface{}{
+		{"id", "useConditionList", "tags", "rewards", "rates"},
+		{1, "1,2,3", "a,b", "10001;10002", "0.5|1.25"},
+		// Excel 会省略行尾的空单元格，最后一列留值保证中间的空单元格被读取
+		{2, "", "", "", "0"},
+	})
+	excelItems, err := (&excel.ExcelConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(SliceFieldConfig{}), "SliceFieldConfig", excelData)
+	if err != nil {
+		t.Fatalf("读取 Excel 失败: %v", err)
+	}
+	var excelList []SliceFieldConfig
+	for _, item := range excelItems {
+		excelList = append(excelList, item.(SliceFieldConfig))
+	}
+	checkSliceFieldConfig(t, "excel", excelList)
+
+	tsvData := []byte("Id\tUseConditionList\tTags\tRewards\tRates\n" +
+		"1\t1,2,3\ta,b\t10001;10002\t0.5|1.25\n" +
+		"2\t\t\t\t\n")
+	tsvItems, err := (&tsv.TsvConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(TsvSliceFieldConfig{}), "TsvSliceFieldConfig", tsvData)
+	if err != nil {
+		t.Fatalf("读取 TSV 失败: %v", err)
+	}
+	var tsvList []SliceFieldConfig
+	for _, item := range tsvItems {
+		c := item.(TsvSliceFieldConfig)
+		tsvList = append(tsvList, SliceFieldConfig(c))
+	}
+	checkSliceFieldConfig(t, "tsv", tsvList)
+}
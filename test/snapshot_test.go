@@ -0,0 +1,83 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestConfigManager233_SnapshotRollback 测试每次成功加载后自动保存快照，回滚后恢复数据并通知业务管理器
+func TestConfigManager233_SnapshotRollback(t *testing.T) {
+	manager, tempDir, recorder := setupReloadManager(t)
+
+	snapshots := manager.ListSnapshots()
+	if len(snapshots) != 1 {
+		t.Fatalf("首次加载后应有 1 个快照，实际 %d 个", len(snapshots))
+	}
+	first := snapshots[0]
+	if len(first.ConfigNames) != 2 || first.ConfigNames[0] != "ReloadItemConfig" || first.ConfigNames[1] != "ReloadShopConfig" {
+		t.Errorf("快照应包含两个配置（已排序），实际 %v", first.ConfigNames)
+	}
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}, {"id": 2, "name": "new"}]`)
+	if err := manager.ReloadConfig("ReloadItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if len(manager.ListSnapshots()) != 2 {
+		t.Fatalf("重载成功后应新增快照，实际 %d 个", len(manager.ListSnapshots()))
+	}
+
+	if err := manager.Rollback(first.Id); err != nil {
+		t.Fatalf("回滚失败: %v", err)
+	}
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v1" {
+		t.Errorf("回滚后应恢复为 v1: %+v", item)
+	}
+	if _, ok := config233.GetConfigById[ReloadItemConfig](2); ok {
+		t.Error("回滚后快照之后新增的配置项应不存在")
+	}
+	if count := config233.GetConfigListCount[ReloadItemConfig](); count != 1 {
+		t.Errorf("回滚后应只有 1 条配置，实际 %d 条", count)
+	}
+	if shop, ok := config233.GetConfigById[ReloadShopConfig](1); !ok || shop.Price != 100 {
+		t.Errorf("未变更的配置应保持不变: %+v", shop)
+	}
+
+	batches := recorder.snapshot()
+	if len(batches) != 2 || len(batches[1]) != 2 {
+		t.Errorf("回滚后应通知快照中的全部配置，实际 %v", batches)
+	}
+	if len(manager.ListSnapshots()) != 2 {
+		t.Errorf("回滚本身不应产生新快照，实际 %d 个", len(manager.ListSnapshots()))
+	}
+
+	// 回滚到较新的快照
+	latest := manager.ListSnapshots()[1]
+	if err := manager.Rollback(latest.Id); err != nil {
+		t.Fatalf("回滚失败: %v", err)
+	}
+	if item, ok := config233.GetConfigById[ReloadItemConfig](2); !ok || item.Name != "new" {
+		t.Errorf("回滚到较新的快照后应恢复新增的配置项: %+v", item)
+	}
+}
+
+// TestConfigManager233_SnapshotLimit 测试超出份数时丢弃最旧的快照
+func TestConfigManager233_SnapshotLimit(t *testing.T) {
+	manager, _, _ := setupReloadManager(t)
+	manager.SetSnapshotLimit(2)
+
+	firstId := manager.ListSnapshots()[0].Id
+	second := manager.Snapshot()
+	third := manager.Snapshot()
+
+	snapshots := manager.ListSnapshots()
+	if len(snapshots) != 2 || snapshots[0].Id != second || snapshots[1].Id != third {
+		t.Fatalf("应只保留最新的 2 个快照，实际 %+v", snapshots)
+	}
+	if err := manager.Rollback(firstId); err == nil {
+		t.Error("已丢弃的快照应无法回滚")
+	}
+	if err := manager.Rollback(third); err != nil {
+		t.Errorf("保留的快照应可以回滚: %v", err)
+	}
+}
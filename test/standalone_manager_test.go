@@ -0,0 +1,78 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// StandaloneConfig 用于测试多个独立管理器并存的配置
+type StandaloneConfig struct {
+	Id    int    `json:"id"`
+	Name  string `json:"name"`
+	Group string `json:"group"`
+}
+
+func newStandaloneManager(t *testing.T, content string) *config233.ConfigManager233 {
+	t.Helper()
+	dir := writeTestFiles(t, map[string]string{"StandaloneConfig.json": content})
+	manager := config233.NewStandaloneConfigManager233(dir)
+	manager.RegisterType(reflect.TypeOf(StandaloneConfig{}))
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	return manager
+}
+
+// TestStandaloneManager_Coexist 测试多个独立管理器的数据互不串扰，也不影响全局单例
+func TestStandaloneManager_Coexist(t *testing.T) {
+	globalManager := config233.NewConfigManager233(t.TempDir())
+	config233.Instance = globalManager
+
+	a := newStandaloneManager(t, `[{"id": 1, "name": "a1", "group": "x"}, {"id": 2, "name": "a2", "group": "x"}]`)
+	b := newStandaloneManager(t, `[{"id": 1, "name": "b1", "group": "y"}]`)
+	if a == b || a == config233.GetInstance() {
+		t.Fatal("NewStandaloneConfigManager233 应返回独立的实例")
+	}
+
+	if cfg, ok := config233.GetConfigByIdFrom[StandaloneConfig](a, 1); !ok || cfg.Name != "a1" {
+		t.Errorf("manager a 读取错误: %+v", cfg)
+	}
+	if cfg, ok := config233.GetConfigByIdFrom[StandaloneConfig](b, 1); !ok || cfg.Name != "b1" {
+		t.Errorf("manager b 读取错误: %+v", cfg)
+	}
+	if _, ok := config233.GetConfigByIdFrom[StandaloneConfig](b, 2); ok {
+		t.Error("manager b 不应读到 manager a 的数据")
+	}
+
+	if n := len(config233.GetConfigListFrom[StandaloneConfig](a)); n != 2 {
+		t.Errorf("manager a 列表长度错误: %d", n)
+	}
+	if n := config233.GetConfigListCountFrom[StandaloneConfig](b); n != 1 {
+		t.Errorf("manager b 列表长度错误: %d", n)
+	}
+	if m := config233.GetConfigMapFrom[StandaloneConfig](a); len(m) != 2 || m["2"].Name != "a2" {
+		t.Errorf("manager a 映射错误: %v", m)
+	}
+	found, missing := config233.GetConfigByIdsFrom[StandaloneConfig](b, []string{"1", "2"})
+	if len(found) != 1 || len(missing) != 1 || missing[0] != "2" {
+		t.Errorf("manager b 批量查询错误: found=%v missing=%v", found, missing)
+	}
+	filtered := config233.GetConfigListByFilterFrom[StandaloneConfig](a, func(c *StandaloneConfig) bool { return c.Id == 2 })
+	if len(filtered) != 1 || filtered[0].Name != "a2" {
+		t.Errorf("manager a 筛选错误: %v", filtered)
+	}
+	if list := config233.GetConfigByIndexFrom[StandaloneConfig](b, "Group", "y"); len(list) != 1 || list[0].Name != "b1" {
+		t.Errorf("manager b 索引查询错误: %v", list)
+	}
+	groups := config233.GetConfigGroupByFrom[StandaloneConfig](a, func(c *StandaloneConfig) string { return c.Group })
+	if len(groups["x"]) != 2 {
+		t.Errorf("manager a 分组错误: %v", groups)
+	}
+
+	// 全局单例未加载该配置
+	if _, ok := config233.GetConfigById[StandaloneConfig](1); ok {
+		t.Error("独立管理器的数据不应出现在全局单例中")
+	}
+}
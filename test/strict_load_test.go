@@ -0,0 +1,121 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// StrictItemConfig 用于测试严格模式加载的配置
+type StrictItemConfig struct {
+	Id    int `json:"id"`
+	Level int `json:"level"`
+}
+
+// Check 等级不能为负数
+func (c *StrictItemConfig) Check() error {
+	if c.Level < 0 {
+		return fmt.Errorf("level 不能为负数: %d", c.Level)
+	}
+	return nil
+}
+
+// StrictShopConfig 用于测试严格模式加载的第二张配置
+type StrictShopConfig struct {
+	Id    int `json:"id"`
+	Price int `json:"price"`
+}
+
+// Check 价格必须大于 0
+func (c *StrictShopConfig) Check() error {
+	if c.Price <= 0 {
+		return fmt.Errorf("price 必须大于 0: %d", c.Price)
+	}
+	return nil
+}
+
+func setupStrictManager(t *testing.T, files map[string]string) *config233.ConfigManager233 {
+	t.Helper()
+	manager, _ := newTestManager(t, files,
+		config233.RegisterType[StrictItemConfig], config233.RegisterType[StrictShopConfig])
+	return manager
+}
+
+// TestLoadAllConfigsStrict_AllValid 测试全部校验通过时正常加载
+func TestLoadAllConfigsStrict_AllValid(t *testing.T) {
+	manager := setupStrictManager(t, map[string]string{
+		"StrictItemConfig.json": `[{"id": 1, "level": 1}, {"id": 2, "level": 2}]`,
+		"StrictShopConfig.json": `[{"id": 1, "price": 10}]`,
+	})
+
+	if err := manager.LoadAllConfigsStrict(); err != nil {
+		t.Fatalf("全部合法时不应返回错误: %v", err)
+	}
+	if count := config233.GetConfigListCount[StrictItemConfig](); count != 2 {
+		t.Errorf("期望 2 条 StrictItemConfig，实际 %d 条", count)
+	}
+	if _, ok := config233.GetConfigById[StrictShopConfig](1); !ok {
+		t.Error("StrictShopConfig id=1 应被加载")
+	}
+}
+
+// TestLoadAllConfigsStrict_AggregatesErrors 测试聚合所有问题并且不写入内存
+func TestLoadAllConfigsStrict_AggregatesErrors(t *testing.T) {
+	manager := setupStrictManager(t, map[string]string{
+		"StrictItemConfig.json":   `[{"id": 1, "level": -1}, {"id": 2, "level": 2}, {"id": 3, "level": -3}]`,
+		"StrictShopConfig.json":   `[{"id": 1, "price": 0}]`,
+		"StrictBrokenConfig.json": `[{"id": 1,`,
+	})
+
+	err := manager.LoadAllConfigsStrict()
+	if err == nil {
+		t.Fatal("存在校验失败时应返回错误")
+	}
+
+	var problems config233.ConfigValidationErrors
+	if !errors.As(err, &problems) {
+		t.Fatalf("错误应为 ConfigValidationErrors，实际 %T: %v", err, err)
+	}
+	if len(problems) != 4 {
+		t.Fatalf("期望 4 个问题，实际 %d 个: %v", len(problems), problems)
+	}
+
+	expected := []struct{ configName, id string }{
+		{"StrictBrokenConfig", ""},
+		{"StrictItemConfig", "1"},
+		{"StrictItemConfig", "3"},
+		{"StrictShopConfig", "1"},
+	}
+	for i, want := range expected {
+		if problems[i].ConfigName != want.configName || problems[i].Id != want.id {
+			t.Errorf("第 %d 个问题期望 %s[id=%s]，实际 %s[id=%s]", i, want.configName, want.id, problems[i].ConfigName, problems[i].Id)
+		}
+		if problems[i].FilePath == "" {
+			t.Errorf("第 %d 个问题应包含文件路径", i)
+		}
+	}
+
+	// 任何一项失败则不写入内存
+	if count := config233.GetConfigListCount[StrictItemConfig](); count != 0 {
+		t.Errorf("严格模式失败时不应写入配置，实际 %d 条", count)
+	}
+	if names := manager.GetLoadedConfigNames(); len(names) != 0 {
+		t.Errorf("严格模式失败时不应加载任何配置，实际 %v", names)
+	}
+}
+
+// TestLoadAllConfigs_LenientByDefault 测试默认加载仍保持宽松行为
+func TestLoadAllConfigs_LenientByDefault(t *testing.T) {
+	manager := setupStrictManager(t, map[string]string{
+		"StrictItemConfig.json": `[{"id": 1, "level": -1}, {"id": 2, "level": 2}]`,
+	})
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("默认模式不应因校验失败返回错误: %v", err)
+	}
+	if count := config233.GetConfigListCount[StrictItemConfig](); count != 2 {
+		t.Errorf("默认模式下校验失败的配置仍应加载，实际 %d 条", count)
+	}
+}
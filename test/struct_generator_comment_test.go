@@ -0,0 +1,66 @@
+package test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestGenerateStruct_FieldComments 测试由中文行与注释行生成字段注释
+func TestGenerateStruct_FieldComments(t *testing.T) {
+	path := createExcelWithRows(t, "GenCommentConfig.xlsx", [][]interface{}{
+		{"注释", "配置ID", "", "多行\n说明"},
+		{"中文", "编号", "名称", ""},
+		{"Client", "id", "name", "desc", "count"},
+		{"type", "string", "string", "string", "int"},
+		{"Server", "id", "name", "desc", "count"},
+		{"", "k1", "v1", "d1", 1},
+	})
+	outDir := t.TempDir()
+
+	if err := config233.GenerateStructFromExcel(path, outDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code := readGenerated(t, filepath.Join(outDir, "GenCommentConfig.go"))
+
+	expected := "type GenCommentConfig struct {\n" +
+		"\t// 编号 配置ID\n" +
+		"\tId string `json:\"id\"`\n" +
+		"\t// 名称\n" +
+		"\tName string `json:\"name\"`\n" +
+		"\t// 多行\n" +
+		"\t// 说明\n" +
+		"\tDesc  string `json:\"desc\"`\n" +
+		"\tCount int    `json:\"count\"`\n" +
+		"}\n"
+	if !strings.Contains(code, expected) {
+		t.Errorf("字段注释不符合预期:\n%s", code)
+	}
+}
+
+// TestGenerateStruct_NoCommentRows 测试注释行与中文行缺失时不生成字段注释
+func TestGenerateStruct_NoCommentRows(t *testing.T) {
+	path := createExcelWithRows(t, "GenNoCommentConfig.xlsx", [][]interface{}{
+		{},
+		{"", "id"},
+		{"Client", "id", "name"},
+		{"type", "int", "string"},
+		{"Server", "id", "name"},
+		{"", 1, "sword"},
+	})
+	outDir := t.TempDir()
+
+	if err := config233.GenerateStructFromExcel(path, outDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code := readGenerated(t, filepath.Join(outDir, "GenNoCommentConfig.go"))
+
+	if strings.Contains(code, "\t//") {
+		t.Errorf("没有说明的字段不应生成注释:\n%s", code)
+	}
+	if !strings.Contains(code, "\tId   int    `json:\"id\"`\n\tName string `json:\"name\"`\n") {
+		t.Errorf("生成的字段应对齐:\n%s", code)
+	}
+}
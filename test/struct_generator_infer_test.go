@@ -0,0 +1,115 @@
+package test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestGenerateStructFromJson 测试从 JSON 推断字段与类型，null 和空数组由其他记录补全
+func TestGenerateStructFromJson(t *testing.T) {
+	srcDir := t.TempDir()
+	writeTextFile(t, srcDir, "JsonGenConfig.json", `[
+		{"id": 1, "name": "sword", "rate": 1, "enabled": true, "bigId": 9007199254740993,
+		 "owner": null, "tags": [], "levels": [1, 2], "reward": {"itemId": 100, "count": 2}, "drops": [], "extra": null},
+		{"id": 2, "name": "shield", "rate": 0.5, "enabled": false, "bigId": 1,
+		 "owner": "npc", "tags": ["a"], "levels": [], "reward": {"itemId": 101, "count": 1, "bind": true},
+		 "drops": [{"itemId": 1, "weight": 0.3}], "extra": null}
+	]`)
+	outDir := t.TempDir()
+
+	if err := config233.GenerateStructFromJson(filepath.Join(srcDir, "JsonGenConfig.json"), outDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code := readGenerated(t, filepath.Join(outDir, "JsonGenConfig.go"))
+
+	expected := "type JsonGenConfig struct {\n" +
+		"\tId      int                  `json:\"id\"`\n" +
+		"\tName    string               `json:\"name\"`\n" +
+		"\tRate    float64              `json:\"rate\"`\n" +
+		"\tEnabled bool                 `json:\"enabled\"`\n" +
+		"\tBigId   int64                `json:\"bigId\"`\n" +
+		"\tOwner   string               `json:\"owner\"`\n" +
+		"\tTags    []string             `json:\"tags\"`\n" +
+		"\tLevels  []int                `json:\"levels\"`\n" +
+		"\tReward  JsonGenConfigReward  `json:\"reward\"`\n" +
+		"\tDrops   []JsonGenConfigDrops `json:\"drops\"`\n" +
+		"\tExtra   interface{}          `json:\"extra\"`\n" +
+		"}\n"
+	if !strings.Contains(code, expected) {
+		t.Errorf("推断的 struct 不符合预期:\n%s", code)
+	}
+	for _, want := range []string{
+		"type JsonGenConfigReward struct {\n\tItemId int  `json:\"itemId\"`\n\tCount  int  `json:\"count\"`\n\tBind   bool `json:\"bind\"`\n}",
+		"type JsonGenConfigDrops struct {\n\tItemId int     `json:\"itemId\"`\n\tWeight float64 `json:\"weight\"`\n}",
+		"// Source: JsonGenConfig.json",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("生成的代码应包含 %q:\n%s", want, code)
+		}
+	}
+}
+
+// TestGenerateStructFromJson_IdMap 测试 id 映射写法的 JSON 与空数组全为空时的降级
+func TestGenerateStructFromJson_IdMap(t *testing.T) {
+	srcDir := t.TempDir()
+	writeTextFile(t, srcDir, "JsonGenMapConfig.json", `{"1001": {"name": "sword", "tags": []}, "1002": {"name": "shield", "tags": []}}`)
+	outDir := t.TempDir()
+
+	if err := config233.GenerateStructFromJson(filepath.Join(srcDir, "JsonGenMapConfig.json"), outDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code := readGenerated(t, filepath.Join(outDir, "JsonGenMapConfig.go"))
+	if !strings.Contains(code, "\tName string        `json:\"name\"`\n\tTags []interface{} `json:\"tags\"`\n") {
+		t.Errorf("id 映射写法的推断结果不符合预期:\n%s", code)
+	}
+}
+
+// TestGenerateStructFromJson_Invalid 测试空文件与非法 JSON 返回错误
+func TestGenerateStructFromJson_Invalid(t *testing.T) {
+	srcDir := t.TempDir()
+	writeTextFile(t, srcDir, "EmptyGenConfig.json", ``)
+	writeTextFile(t, srcDir, "BadGenConfig.json", `[{"id": 1,`)
+
+	for _, name := range []string{"EmptyGenConfig.json", "BadGenConfig.json"} {
+		if err := config233.GenerateStructFromJson(filepath.Join(srcDir, name), t.TempDir()); err == nil {
+			t.Errorf("%s 应返回错误", name)
+		}
+	}
+}
+
+// TestGenerateStructFromTsv 测试从 TSV 表头与采样数据推断类型
+func TestGenerateStructFromTsv(t *testing.T) {
+	srcDir := t.TempDir()
+	writeTextFile(t, srcDir, "TsvGenConfig.tsv", "id\tname\trate\tenabled\tbigId\tnote\n"+
+		"1\tsword\t1\ttrue\t9007199254740993\t\n"+
+		"2\tshield\t0.5\tFALSE\t1\t\n")
+	writeTextFile(t, srcDir, "CsvGenConfig.csv", "id,code\n1,A01\n2,002\n")
+	outDir := t.TempDir()
+
+	if err := config233.GenerateStructFromTsv(filepath.Join(srcDir, "TsvGenConfig.tsv"), outDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code := readGenerated(t, filepath.Join(outDir, "TsvGenConfig.go"))
+	expected := "type TsvGenConfig struct {\n" +
+		"\tId      int     `json:\"id\"`\n" +
+		"\tName    string  `json:\"name\"`\n" +
+		"\tRate    float64 `json:\"rate\"`\n" +
+		"\tEnabled bool    `json:\"enabled\"`\n" +
+		"\tBigId   int64   `json:\"bigId\"`\n" +
+		"\tNote    string  `json:\"note\"`\n" +
+		"}\n"
+	if !strings.Contains(code, expected) {
+		t.Errorf("推断的 struct 不符合预期:\n%s", code)
+	}
+
+	if err := config233.GenerateStructFromTsv(filepath.Join(srcDir, "CsvGenConfig.csv"), outDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code = readGenerated(t, filepath.Join(outDir, "CsvGenConfig.go"))
+	if !strings.Contains(code, "\tId   int    `json:\"id\"`\n\tCode string `json:\"code\"`\n") {
+		t.Errorf("CSV 应按逗号读取并推断类型:\n%s", code)
+	}
+}
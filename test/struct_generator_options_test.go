@@ -0,0 +1,127 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// createGenItemExcel 创建用于生成 struct 的多行表头 Excel
+func createGenItemExcel(t *testing.T, name, valueType string) string {
+	t.Helper()
+	return createExcelWithRows(t, name, [][]interface{}{
+		{"注释", "ID", "值"},
+		{"中文", "ID", "值"},
+		{"Client", "id", "value"},
+		{"type", "int", valueType},
+		{"Server", "id", "value"},
+		{"", 1, "1"},
+	})
+}
+
+// readGenerated 读取生成的 Go 文件
+func readGenerated(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("读取生成的文件失败: %v", err)
+	}
+	return string(content)
+}
+
+// TestGenerateStructWithOptions_Default 测试零值选项与 GenerateStructFromExcel 的输出一致
+func TestGenerateStructWithOptions_Default(t *testing.T) {
+	path := createGenItemExcel(t, "GenKvConfig.xlsx", "int")
+	plainDir := t.TempDir()
+	optsDir := t.TempDir()
+
+	if err := config233.GenerateStructFromExcel(path, plainDir); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	if err := config233.GenerateStructFromExcelWithOptions(path, config233.GenOptions{OutputDir: optsDir}); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+
+	plain := readGenerated(t, filepath.Join(plainDir, "GenKvConfig.go"))
+	withOpts := readGenerated(t, filepath.Join(optsDir, "GenKvConfig.go"))
+	if plain != withOpts {
+		t.Errorf("零值选项的输出应与默认一致:\n%s\n---\n%s", plain, withOpts)
+	}
+	for _, want := range []string{"package generated", "GetValue()", "strconv.FormatInt"} {
+		if !strings.Contains(withOpts, want) {
+			t.Errorf("默认输出应包含 %q:\n%s", want, withOpts)
+		}
+	}
+}
+
+// TestGenerateStructWithOptions_PackageAndFileName 测试自定义包名与文件名风格
+func TestGenerateStructWithOptions_PackageAndFileName(t *testing.T) {
+	path := createGenItemExcel(t, "GodLvUpConfig.xlsx", "string")
+
+	cases := []struct {
+		style    config233.FileNameStyle
+		fileName string
+	}{
+		{config233.FileNameStyleOriginal, "GodLvUpConfig.go"},
+		{config233.FileNameStyleSnake, "god_lv_up_config.go"},
+		{config233.FileNameStyleCamel, "godLvUpConfig.go"},
+	}
+	for _, c := range cases {
+		outDir := t.TempDir()
+		opts := config233.GenOptions{OutputDir: outDir, PackageName: "cfg", FileNameStyle: c.style}
+		if err := config233.GenerateStructFromExcelWithOptions(path, opts); err != nil {
+			t.Fatalf("生成 struct 失败 (%s): %v", c.style, err)
+		}
+
+		code := readGenerated(t, filepath.Join(outDir, c.fileName))
+		if !strings.Contains(code, "package cfg\n") {
+			t.Errorf("%s: 应使用指定的包名:\n%s", c.style, code)
+		}
+		if !strings.Contains(code, "type GodLvUpConfig struct") {
+			t.Errorf("%s: struct 名不应受文件名风格影响:\n%s", c.style, code)
+		}
+	}
+}
+
+// TestGenerateStructWithOptions_UidTag 测试为主键字段生成 uid 标签
+func TestGenerateStructWithOptions_UidTag(t *testing.T) {
+	path := createGenItemExcel(t, "GenItemConfig.xlsx", "string")
+	outDir := t.TempDir()
+
+	if err := config233.GenerateStructFromExcelWithOptions(path, config233.GenOptions{OutputDir: outDir, UidTag: true}); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+
+	code := readGenerated(t, filepath.Join(outDir, "GenItemConfig.go"))
+	if !strings.Contains(code, "`json:\"id\" config233:\"uid\"`") {
+		t.Errorf("id 字段应带 uid 标签:\n%s", code)
+	}
+	if strings.Count(code, "config233:\"uid\"") != 1 {
+		t.Errorf("只有主键字段应带 uid 标签:\n%s", code)
+	}
+}
+
+// TestGenerateStructWithOptions_KvConfig 测试 IKvConfig 实现由选项控制
+func TestGenerateStructWithOptions_KvConfig(t *testing.T) {
+	kvPath := createGenItemExcel(t, "GenKvConfig.xlsx", "string")
+	itemPath := createGenItemExcel(t, "GenItemConfig.xlsx", "bool")
+
+	outDir := t.TempDir()
+	if err := config233.GenerateStructFromExcelWithOptions(kvPath, config233.GenOptions{OutputDir: outDir, KvConfig: config233.KvConfigNever}); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	if code := readGenerated(t, filepath.Join(outDir, "GenKvConfig.go")); strings.Contains(code, "GetValue") || strings.Contains(code, "import") {
+		t.Errorf("KvConfigNever 不应实现 IKvConfig:\n%s", code)
+	}
+
+	if err := config233.GenerateStructFromExcelWithOptions(itemPath, config233.GenOptions{OutputDir: outDir, KvConfig: config233.KvConfigAlways}); err != nil {
+		t.Fatalf("生成 struct 失败: %v", err)
+	}
+	code := readGenerated(t, filepath.Join(outDir, "GenItemConfig.go"))
+	if !strings.Contains(code, "var _ config233.IKvConfig = (*GenItemConfig)(nil)") || !strings.Contains(code, "strconv.FormatBool(c.Value)") {
+		t.Errorf("KvConfigAlways 应实现 IKvConfig:\n%s", code)
+	}
+}
@@ -0,0 +1,90 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/excel"
+)
+
+// TimeFieldConfig 带时间与时长字段的配置
+type TimeFieldConfig struct {
+	Id         int           `json:"id"`
+	ExpireTime time.Time     `json:"expireTime"`
+	OpenDate   time.Time     `json:"openDate" config233_timefmt:"2006/01/02"`
+	Cooldown   time.Duration `json:"cooldown"`
+	Interval   time.Duration `json:"interval"`
+}
+
+// checkTimeFieldConfig 校验第 1 行为合法数据、第 2 行为坏数据
+func checkTimeFieldConfig(t *testing.T, source string, list []TimeFieldConfig) {
+	t.Helper()
+	if len(list) != 2 {
+		t.Fatalf("[%s] 期望 2 条配置，实际: %+v", source, list)
+	}
+	first := list[0]
+	if want := time.Date(2024, 12, 31, 23, 59, 59, 0, time.Local); !first.ExpireTime.Equal(want) {
+		t.Errorf("[%s] 默认 layout 解析错误: %v", source, first.ExpireTime)
+	}
+	if want := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local); !first.OpenDate.Equal(want) {
+		t.Errorf("[%s] config233_timefmt 解析错误: %v", source, first.OpenDate)
+	}
+	if first.Cooldown != 5*time.Second {
+		t.Errorf("[%s] 时长解析错误: %v", source, first.Cooldown)
+	}
+	if first.Interval != 1500*time.Millisecond {
+		t.Errorf("[%s] 纯数字时长应按毫秒处理: %v", source, first.Interval)
+	}
+
+	second := list[1]
+	if want := time.Date(2024, 12, 31, 8, 0, 0, 0, time.UTC); !second.ExpireTime.Equal(want) {
+		t.Errorf("[%s] RFC3339 解析错误: %v", source, second.ExpireTime)
+	}
+	if !second.OpenDate.IsZero() || second.Cooldown != 0 {
+		t.Errorf("[%s] 解析失败时应保留零值: %+v", source, second)
+	}
+}
+
+// TestTimeField_ConfigManager 测试 ConfigManager233 加载时解析 time.Time / time.Duration 字段
+func TestTimeField_ConfigManager(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "TimeFieldConfig.json", `[
+		{"id": 1, "expireTime": "2024-12-31 23:59:59", "openDate": "2024/05/01", "cooldown": "5s", "interval": 1500},
+		{"id": 2, "expireTime": "2024-12-31T08:00:00Z", "openDate": "2024-05-01", "cooldown": "soon"}
+	]`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[TimeFieldConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	var list []TimeFieldConfig
+	for _, id := range []int{1, 2} {
+		cfg, ok := config233.GetConfigById[TimeFieldConfig](id)
+		if !ok {
+			t.Fatalf("未找到 id=%d 的配置", id)
+		}
+		list = append(list, *cfg)
+	}
+	checkTimeFieldConfig(t, "json", list)
+}
+
+// TestTimeField_ExcelHandler 测试 Excel 处理器直接转换结构体时解析时间字段
+func TestTimeField_ExcelHandler(t *testing.T) {
+	data := buildExcelBytes(t, [][]interface{}{
+		{"id", "expireTime", "openDate", "cooldown", "interval"},
+		{1, "2024-12-31 23:59:59", "2024/05/01", "5s", "1500"},
+		{2, "2024-12-31T08:00:00Z", "2024-05-01", "soon", "0"},
+	})
+	items, err := (&excel.ExcelConfigHandler{}).ReadConfigAndORMFromBytes(reflect.TypeOf(TimeFieldConfig{}), "TimeFieldConfig", data)
+	requireConversionErrors(t, err, 2)
+	var list []TimeFieldConfig
+	for _, item := range items {
+		list = append(list, item.(TimeFieldConfig))
+	}
+	checkTimeFieldConfig(t, "excel", list)
+}
@@ -0,0 +1,53 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestTriggerReloadAndWait 测试手动触发重载后立即读到新值，无需 Sleep 等待批量延迟
+func TestTriggerReloadAndWait(t *testing.T) {
+	manager, tempDir, recorder := setupReloadManager(t)
+	if err := manager.StartWatching(); err != nil {
+		t.Fatalf("启动文件监听失败: %v", err)
+	}
+	defer manager.StopWatching()
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}]`)
+	if err := manager.TriggerReloadAndWait([]string{"ReloadItemConfig"}); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v2" {
+		t.Errorf("返回后应立即读到新值: %+v", item)
+	}
+	if batches := recorder.snapshot(); len(batches) == 0 || batches[0][0] != "ReloadItemConfig" {
+		t.Errorf("返回前应已触发加载完成回调: %v", batches)
+	}
+
+	// 与热重载一致：文件已删除的配置被移除
+	if err := os.Remove(filepath.Join(tempDir, "ReloadShopConfig.json")); err != nil {
+		t.Fatalf("删除配置文件失败: %v", err)
+	}
+	if err := manager.TriggerReloadAndWait([]string{"ReloadShopConfig"}); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if _, ok := config233.GetConfigById[ReloadShopConfig](1); ok {
+		t.Error("文件删除后配置应被移除")
+	}
+}
+
+// TestTriggerReloadAndWait_Failed 测试重载失败时返回错误并保留旧数据
+func TestTriggerReloadAndWait_Failed(t *testing.T) {
+	manager, tempDir, _ := setupReloadManager(t)
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": `)
+	if err := manager.TriggerReloadAndWait([]string{"ReloadItemConfig"}); err == nil {
+		t.Fatal("文件损坏时应返回错误")
+	}
+	if item, ok := config233.GetConfigById[ReloadItemConfig](1); !ok || item.Name != "v1" {
+		t.Errorf("重载失败时应保留旧数据: %+v", item)
+	}
+}
@@ -0,0 +1,155 @@
+package test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/dto"
+	"github.com/neko233-com/config233-go/pkg/config233/tsv"
+)
+
+// TsvItemConfig 用于测试 TSV/CSV 配置的结构体
+type TsvItemConfig struct {
+	Id   int
+	Name string
+	Desc string
+}
+
+// TestTsvConfigHandler_DefaultTab 测试默认制表符分隔，兼容 BOM、CRLF 与空字段
+func TestTsvConfigHandler_DefaultTab(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "TsvItemConfig.tsv",
+		"\xEF\xBB\xBFId\tName\tDesc\r\n1\tsword\t\r\n\r\n2\t\tshield desc\r\n")
+
+	handler := &tsv.TsvConfigHandler{}
+	result := mustReadDataList(t, handler, "TsvItemConfig", path)
+	if result.Suffix != "tsv" {
+		t.Errorf("期望后缀为 tsv，实际 %q", result.Suffix)
+	}
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	if result.DataList[0]["Id"] != "1" {
+		t.Errorf("BOM 未被去除，表头解析错误: %v", result.DataList[0])
+	}
+	if result.DataList[0]["Desc"] != "" || result.DataList[1]["Name"] != "" {
+		t.Errorf("空字段应保留为空字符串: %v", result.DataList)
+	}
+	if result.DataList[1]["Desc"] != "shield desc" {
+		t.Errorf("CRLF 未被正确处理: %q", result.DataList[1]["Desc"])
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(TsvItemConfig{}), "TsvItemConfig", path)
+	if len(list) != 2 || list[1].(TsvItemConfig).Id != 2 || list[1].(TsvItemConfig).Desc != "shield desc" {
+		t.Errorf("TSV ORM 解析错误: %+v", list)
+	}
+}
+
+// TestTsvConfigHandler_CsvQuotedFields 测试逗号分隔时带引号、含分隔符和换行的字段
+func TestTsvConfigHandler_CsvQuotedFields(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "TsvItemConfig.csv",
+		"Id,Name,Desc\n1,\"sword, long\",\"line1\nline2\"\n2,bow,\"say \"\"hi\"\"\"\n")
+
+	handler := &tsv.TsvConfigHandler{Delimiter: ','}
+	result := mustReadDataList(t, handler, "TsvItemConfig", path)
+	if result.Suffix != "csv" {
+		t.Errorf("期望后缀为 csv，实际 %q", result.Suffix)
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(TsvItemConfig{}), "TsvItemConfig", path)
+	if len(list) != 2 {
+		t.Fatalf("期望 2 个对象，实际 %d 个: %+v", len(list), list)
+	}
+	first := list[0].(TsvItemConfig)
+	if first.Name != "sword, long" || first.Desc != "line1\nline2" {
+		t.Errorf("带引号字段解析错误: %+v", first)
+	}
+	if list[1].(TsvItemConfig).Desc != `say "hi"` {
+		t.Errorf("转义引号解析错误: %q", list[1].(TsvItemConfig).Desc)
+	}
+}
+
+// TestTsvConfigHandler_PipeDelimiter 测试竖线分隔
+func TestTsvConfigHandler_PipeDelimiter(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "TsvItemConfig.txt", "Id|Name\n7|axe\n")
+
+	handler := &tsv.TsvConfigHandler{Delimiter: '|'}
+	list := mustReadORM(t, handler, reflect.TypeOf(TsvItemConfig{}), "TsvItemConfig", path)
+	if len(list) != 1 || list[0].(TsvItemConfig).Id != 7 || list[0].(TsvItemConfig).Name != "axe" {
+		t.Errorf("竖线分隔解析错误: %+v", list)
+	}
+}
+
+// TestTsvConfigHandler_CommentsAndEmptyColumns 测试跳过 # 注释行，空列名（含行尾多余制表符产生的空列）不映射
+func TestTsvConfigHandler_CommentsAndEmptyColumns(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "TsvItemConfig.tsv",
+		"# 道具表\nId\t\tName\tDesc\t\n#1\tcommented\n1\tmemo\tsword\tsharp\t\n2\tmemo\tbow\t\t\n")
+
+	handler := &tsv.TsvConfigHandler{}
+	result := mustReadDataList(t, handler, "TsvItemConfig", path)
+	if len(result.DataList) != 2 {
+		t.Fatalf("注释行应被跳过，期望 2 条数据，实际 %d 条: %v", len(result.DataList), result.DataList)
+	}
+	if len(result.DataList[0]) != 3 || result.DataList[0]["Name"] != "sword" {
+		t.Errorf("空列名的列不应映射: %v", result.DataList[0])
+	}
+	if !reflect.DeepEqual(result.ColumnNames, []string{"Id", "Name", "Desc"}) {
+		t.Errorf("列名应忽略空列: %v", result.ColumnNames)
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(TsvItemConfig{}), "TsvItemConfig", path)
+	if len(list) != 2 || list[0].(TsvItemConfig) != (TsvItemConfig{Id: 1, Name: "sword", Desc: "sharp"}) ||
+		list[1].(TsvItemConfig) != (TsvItemConfig{Id: 2, Name: "bow"}) {
+		t.Errorf("TSV ORM 解析错误: %+v", list)
+	}
+}
+
+// TestTsvConfigHandler_HeaderRow 测试多行表头：指定列名所在行与数据起始行，行号不计注释行
+func TestTsvConfigHandler_HeaderRow(t *testing.T) {
+	path := writeTextFile(t, t.TempDir(), "TsvItemConfig.tsv",
+		"编号\t名称\t描述\n# 第二行为列名，第三行为类型\nId\tName\tDesc\nint\tstring\tstring\n1\tsword\t\nx\tbow\t\n")
+
+	handler := &tsv.TsvConfigHandler{HeaderRow: 2, DataRow: 4}
+	var list []interface{}
+	var err error
+	captureStdout(t, func() {
+		list, err = handler.ReadConfigAndORM(reflect.TypeOf(TsvItemConfig{}), "TsvItemConfig", path)
+	})
+	if len(list) != 2 || list[0].(TsvItemConfig).Name != "sword" || list[1].(TsvItemConfig).Name != "bow" {
+		t.Fatalf("表头与数据行解析错误: %+v", list)
+	}
+
+	var convErrs dto.ConversionErrors
+	if !errors.As(err, &convErrs) || len(convErrs) != 1 || convErrs[0].RowIndex != 5 {
+		t.Errorf("转换错误的行号应不计注释行，期望第 5 行: %v", err)
+	}
+}
+
+// CsvOnlyConfig 用于测试管理器加载 .csv 文件的结构体
+type CsvOnlyConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestConfigManager233_LoadCsvConfigs 测试配置管理器识别 .csv 文件
+func TestConfigManager233_LoadCsvConfigs(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "CsvOnlyConfig.csv", "id,name\n1,\"a,b\"\n2,c\n")
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[CsvOnlyConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	list := config233.GetConfigList[CsvOnlyConfig]()
+	if len(list) != 2 {
+		t.Fatalf("期望 .csv 配置加载 2 条，实际 %d 条", len(list))
+	}
+	if list[0].Name != "a,b" {
+		t.Errorf("CSV 字段解析错误: %+v", list[0])
+	}
+}
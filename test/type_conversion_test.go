@@ -1,6 +1,7 @@
 package test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync/atomic"
@@ -77,3 +78,42 @@ func TestAfterLoadCalledOnceOnFirstLoad(t *testing.T) {
 		t.Fatalf("期望 AfterLoad 只触发 1 次，实际 %d 次", got)
 	}
 }
+
+// TestAfterLoadCalledOncePerItemAllFormats 测试各格式加载时每条记录只创建一个实例，AfterLoad 每项只触发一次
+func TestAfterLoadCalledOncePerItemAllFormats(t *testing.T) {
+	cases := map[string]string{
+		"AfterLoadOnceConfig.json": `[{"id":1},{"id":2},{"id":3}]`,
+		"AfterLoadOnceConfig.tsv":  "id\n1\n2\n3\n",
+		"AfterLoadOnceConfig.csv":  "id\n1\n2\n3\n",
+		"AfterLoadOnceConfig.yaml": "- id: 1\n- id: 2\n- id: 3\n",
+		"AfterLoadOnceConfig.xml":  `<root><item id="1"/><item id="2"/><item id="3"/></root>`,
+	}
+
+	for fileName, content := range cases {
+		t.Run(filepath.Ext(fileName), func(t *testing.T) {
+			tempDir := t.TempDir()
+			writeTextFile(t, tempDir, fileName, content)
+
+			atomic.StoreInt32(&afterLoadOnceCounter, 0)
+			manager := config233.NewConfigManager233(tempDir)
+			config233.Instance = manager
+			config233.RegisterType[AfterLoadOnceConfig]()
+
+			if err := manager.LoadAllConfigs(); err != nil {
+				t.Fatalf("加载配置失败: %v", err)
+			}
+
+			if got := atomic.LoadInt32(&afterLoadOnceCounter); got != 3 {
+				t.Fatalf("期望 AfterLoad 每项只触发 1 次（共 3 次），实际 %d 次", got)
+			}
+
+			// map 与 list 共享同一实例
+			configMap := config233.GetConfigMap[AfterLoadOnceConfig]()
+			for _, item := range config233.GetConfigList[AfterLoadOnceConfig]() {
+				if configMap[fmt.Sprint(item.Id)] != item {
+					t.Errorf("id=%d 在 map 和 list 中应为同一个实例", item.Id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestGenerateTypeScriptFromExcelDir 测试从 Excel 生成 TypeScript interface
+func TestGenerateTypeScriptFromExcelDir(t *testing.T) {
+	path := createExcelWithRows(t, "TsItemConfig.xlsx", [][]interface{}{
+		{"注释", "ID", "名称", "", "标签", "价格", "旧名称", "开关"},
+		{"中文", "物品ID", "物品名称", "", "标签", "价格", "", "是否开启"},
+		{"Client", "id", "item_name", "", "tags", "price", "ItemName", "enabled"},
+		{"type", "int", "string", "string", "string[]", "double", "int", "bool"},
+		{"Server", "id", "item_name", "", "tags", "price", "ItemName", "enabled"},
+		{"", 1, "sword", "", "a,b", 1.5, 2, true},
+	})
+	outDir := filepath.Join(t.TempDir(), "ts")
+
+	if err := config233.GenerateTypeScriptFromExcelDir(filepath.Dir(path), outDir); err != nil {
+		t.Fatalf("生成 TypeScript 失败: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outDir, "TsItemConfig.ts"))
+	if err != nil {
+		t.Fatalf("读取生成的文件失败: %v", err)
+	}
+	code := string(content)
+
+	expected := `export interface TsItemConfig {
+  /** 物品ID */
+  id: number;
+  /** 物品名称 */
+  itemName: number;
+  /** 标签 */
+  tags: string[];
+  /** 价格 */
+  price: number;
+  /** 是否开启 */
+  enabled: boolean;
+}
+`
+	if !strings.Contains(code, expected) {
+		t.Errorf("生成的 interface 不符合预期:\n%s", code)
+	}
+	if !strings.HasPrefix(code, "// Code generated by config233. DO NOT EDIT.\n// Source: TsItemConfig.xlsx\n") {
+		t.Errorf("生成的文件缺少文件头:\n%s", code)
+	}
+}
+
+// TestGenerateTypeScriptFromExcel_NotEnoughRows 测试表头行数不足时返回错误
+func TestGenerateTypeScriptFromExcel_NotEnoughRows(t *testing.T) {
+	path := createExcelWithRows(t, "TsShortConfig.xlsx", [][]interface{}{
+		{"id", "name"},
+		{1, "sword"},
+	})
+
+	if err := config233.GenerateTypeScriptFromExcel(path, t.TempDir()); err == nil {
+		t.Error("表头行数不足时应返回错误")
+	}
+}
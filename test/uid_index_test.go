@@ -0,0 +1,59 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// UidIndexSkillConfig 主键为 skillId 且不在第一列的配置
+type UidIndexSkillConfig struct {
+	Name    string `json:"name"`
+	SkillId int    `json:"skillId" config233:"uid"`
+	Level   int    `json:"level"`
+}
+
+// TestConfigManager233_UidTagDrivesIdIndex 测试 config233:"uid" 字段驱动 ID 索引
+func TestConfigManager233_UidTagDrivesIdIndex(t *testing.T) {
+	dir := t.TempDir()
+	writeTextFile(t, dir, "UidIndexSkillConfig.json", `[
+		{"name": "fire", "skillId": 1001, "level": 1},
+		{"name": "ice", "skillId": 1002, "level": 3}
+	]`)
+
+	manager := config233.NewConfigManager233(dir)
+	config233.Instance = manager
+	config233.RegisterType[UidIndexSkillConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	skill, ok := config233.GetConfigById[UidIndexSkillConfig](1002)
+	if !ok || skill.Name != "ice" {
+		t.Errorf("应按 skillId 建立索引，实际: %+v, ok=%v", skill, ok)
+	}
+	if _, ok := config233.GetConfigById[UidIndexSkillConfig]("fire"); ok {
+		t.Error("有 uid 字段时不应按第一列建立索引")
+	}
+	configMap := config233.GetConfigMap[UidIndexSkillConfig]()
+	if len(configMap) != 2 || configMap["1001"] == nil || configMap["1001"].Name != "fire" {
+		t.Errorf("GetConfigMap 的 key 应为 skillId: %+v", configMap)
+	}
+}
+
+// TestConfigManager233_UidTagDrivesIdIndexTsv 测试 TSV 配置同样按 uid 字段建立索引
+func TestConfigManager233_UidTagDrivesIdIndexTsv(t *testing.T) {
+	dir := t.TempDir()
+	writeTextFile(t, dir, "UidIndexSkillConfig.tsv", "name\tskillId\tlevel\nfire\t1001\t1\nice\t1002\t3\n")
+
+	manager := config233.NewConfigManager233(dir)
+	config233.Instance = manager
+	config233.RegisterType[UidIndexSkillConfig]()
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if skill, ok := config233.GetConfigById[UidIndexSkillConfig]("1001"); !ok || skill.Level != 1 {
+		t.Errorf("TSV 配置应按 skillId 建立索引，实际: %+v, ok=%v", skill, ok)
+	}
+}
@@ -0,0 +1,114 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// UnregisterItemConfig 用于测试取消注册的配置
+type UnregisterItemConfig struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// UnregisterShopConfig 用于测试清空注册的配置
+type UnregisterShopConfig struct {
+	Id    int `json:"id"`
+	Price int `json:"price"`
+}
+
+func setupUnregisterManager(t *testing.T) *config233.ConfigManager233 {
+	t.Helper()
+	manager, _ := newTestManager(t, map[string]string{
+		"UnregisterItemConfig.json": `[{"id": 1, "name": "item"}]`,
+		"UnregisterShopConfig.json": `[{"id": 1, "price": 100}]`,
+	}, config233.RegisterType[UnregisterItemConfig], config233.RegisterType[UnregisterShopConfig])
+	return manager
+}
+
+// TestUnregisterType 测试取消注册后配置保留为 map，其他类型不受影响
+func TestUnregisterType(t *testing.T) {
+	manager := setupUnregisterManager(t)
+	config233.UnregisterType[UnregisterItemConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	stats := manager.GetLoadStats()
+	if stats["UnregisterItemConfig"].StructConverted {
+		t.Error("取消注册的类型不应再转换为结构体")
+	}
+	if !stats["UnregisterShopConfig"].StructConverted {
+		t.Error("未取消注册的类型应正常转换为结构体")
+	}
+}
+
+// TestClearRegisteredTypes 测试清空所有注册类型
+func TestClearRegisteredTypes(t *testing.T) {
+	manager := setupUnregisterManager(t)
+	config233.ClearRegisteredTypes()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+	for name, stat := range manager.GetLoadStats() {
+		if stat.StructConverted {
+			t.Errorf("清空注册后 %s 不应转换为结构体", name)
+		}
+	}
+}
+
+// TestClearConfigs 测试清空已加载数据，且与并发读取互不影响
+func TestClearConfigs(t *testing.T) {
+	manager := setupUnregisterManager(t)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if cfg, ok := config233.GetConfigById[UnregisterItemConfig](1); ok && cfg.Name != "item" {
+					t.Errorf("并发读取到不完整的数据: %+v", cfg)
+				}
+				_ = config233.GetConfigList[UnregisterShopConfig]()
+			}
+		}()
+	}
+
+	manager.ClearConfigs()
+	close(stop)
+	wg.Wait()
+
+	if names := manager.GetLoadedConfigNames(); len(names) != 0 {
+		t.Errorf("清空后不应有已加载的配置: %v", names)
+	}
+	if _, ok := config233.GetConfigById[UnregisterItemConfig](1); ok {
+		t.Error("清空后不应再查到配置")
+	}
+	if list := config233.GetConfigList[UnregisterShopConfig](); len(list) != 0 {
+		t.Errorf("清空后列表应为空: %v", list)
+	}
+	if stats := manager.GetLoadStats(); len(stats) != 0 {
+		t.Errorf("清空后加载统计应为空: %v", stats)
+	}
+
+	// 注册的类型保持不变，重新加载后正常转换
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+	if cfg, ok := config233.GetConfigById[UnregisterItemConfig](1); !ok || cfg.Name != "item" {
+		t.Errorf("清空后重新加载应恢复数据: %+v", cfg)
+	}
+}
@@ -0,0 +1,74 @@
+package test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// setupValidateDir 在全局单例上注册类型与跨配置校验，返回写好配置文件的目录
+func setupValidateDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	// 全局管理器使用空目录，只提供已注册的类型与跨配置校验
+	manager := newTestManagerAt(t, t.TempDir(),
+		config233.RegisterType[StrictItemConfig], config233.RegisterType[RequiredItemConfig],
+		config233.RegisterType[CrossItemConfig], config233.RegisterType[CrossJumpConfig])
+	manager.RegisterCrossValidator(checkJumpIds)
+	return writeTestFiles(t, files)
+}
+
+// TestValidateConfigDir_CollectsAllProblems 测试汇总 Check()、字段规则与文件解析的全部问题
+func TestValidateConfigDir_CollectsAllProblems(t *testing.T) {
+	dir := setupValidateDir(t, map[string]string{
+		"StrictItemConfig.json":   `[{"id": 1, "level": -1}, {"id": 2, "level": 2}]`,
+		"RequiredItemConfig.json": `[{"id": 1, "name": "", "level": 1, "tags": ["a"]}]`,
+		"CrossItemConfig.json":    `[{"id": 1, "jumpId": 99}]`,
+		"CrossJumpConfig.json":    `[{"id": 10, "page": "shop"}`,
+	})
+
+	errs := config233.ValidateConfigDir(dir)
+	if len(errs) != 3 {
+		t.Fatalf("期望 3 个问题，实际 %d 个: %v", len(errs), errs)
+	}
+	var wantErr *config233.ConfigValidationError
+	for _, err := range errs {
+		if !errors.As(err, &wantErr) {
+			t.Errorf("配置问题应为 *ConfigValidationError: %v", err)
+		}
+	}
+	got := errors.Join(errs...).Error()
+	for _, want := range []string{"CrossJumpConfig", "RequiredItemConfig", "level 不能为负数"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("问题列表应包含 %q: %s", want, got)
+		}
+	}
+	if strings.Contains(got, "jumpId 99") {
+		t.Errorf("单表存在问题时不应执行跨配置校验: %s", got)
+	}
+	if count := config233.GetConfigListCount[StrictItemConfig](); count != 0 {
+		t.Errorf("校验不应写入全局单例，实际 %d 条", count)
+	}
+}
+
+// TestValidateConfigDir_CrossReferences 测试单表全部通过后执行全局单例上注册的跨配置校验
+func TestValidateConfigDir_CrossReferences(t *testing.T) {
+	files := map[string]string{
+		"StrictItemConfig.json": `[{"id": 1, "level": 1}]`,
+		"CrossItemConfig.json":  `[{"id": 1, "jumpId": 10}, {"id": 2, "jumpId": 99}]`,
+		"CrossJumpConfig.json":  `[{"id": 10, "page": "shop"}]`,
+	}
+	dir := setupValidateDir(t, files)
+
+	errs := config233.ValidateConfigDir(dir)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "物品 2 的 jumpId 99 不存在") {
+		t.Fatalf("期望 1 个跨配置问题，实际: %v", errs)
+	}
+
+	files["CrossItemConfig.json"] = `[{"id": 1, "jumpId": 10}]`
+	dir = setupValidateDir(t, files)
+	if errs := config233.ValidateConfigDir(dir); len(errs) != 0 {
+		t.Errorf("全部通过时应返回空，实际: %v", errs)
+	}
+}
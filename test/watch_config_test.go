@@ -0,0 +1,63 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+)
+
+// TestWatchConfig 测试单个类型的监听只在该类型变更时触发，并收到转换好的配置列表
+func TestWatchConfig(t *testing.T) {
+	manager, tempDir, _ := setupReloadManager(t)
+
+	var itemLists [][]*ReloadItemConfig
+	config233.WatchConfig(func(newList []*ReloadItemConfig) {
+		itemLists = append(itemLists, newList)
+	})
+	shopCalls := 0
+	config233.WatchConfigFrom(manager, func(newList []*ReloadShopConfig) {
+		shopCalls++
+	})
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}, {"id": 2, "name": "v3"}]`)
+	if err := manager.ReloadConfig("ReloadItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+
+	if len(itemLists) != 1 {
+		t.Fatalf("ReloadItemConfig 的监听应触发一次，实际 %d 次", len(itemLists))
+	}
+	if list := itemLists[0]; len(list) != 2 || list[0].Name != "v2" || list[1].Name != "v3" {
+		t.Errorf("回调应收到重载后的配置列表: %+v", list)
+	}
+	if item, _ := config233.GetConfigById[ReloadItemConfig](1); itemLists[0][0] != item {
+		t.Error("回调收到的配置应与 GetConfigById 返回同一对象")
+	}
+	if shopCalls != 0 {
+		t.Errorf("未变更的 ReloadShopConfig 不应触发监听，实际 %d 次", shopCalls)
+	}
+}
+
+// TestWatchConfig_PanicRecovered 测试回调 panic 不影响其他监听与后续流程
+func TestWatchConfig_PanicRecovered(t *testing.T) {
+	manager, tempDir, recorder := setupReloadManager(t)
+
+	config233.WatchConfig(func(newList []*ReloadItemConfig) {
+		panic("boom")
+	})
+	called := false
+	config233.WatchConfig(func(newList []*ReloadItemConfig) {
+		called = true
+	})
+
+	writeTextFile(t, tempDir, "ReloadItemConfig.json", `[{"id": 1, "name": "v2"}]`)
+	if err := manager.ReloadConfig("ReloadItemConfig"); err != nil {
+		t.Fatalf("重载配置失败: %v", err)
+	}
+	if !called {
+		t.Error("前一个回调 panic 后，后续监听仍应被调用")
+	}
+	if batches := recorder.snapshot(); len(batches) != 1 {
+		t.Errorf("回调 panic 不应影响 OnConfigLoadComplete: %v", batches)
+	}
+}
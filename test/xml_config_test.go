@@ -0,0 +1,130 @@
+package test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/xml"
+)
+
+// XmlRewardConfig 用于测试 XML 配置的结构体
+type XmlRewardConfig struct {
+	Id      int      `json:"id"`
+	Name    string   `json:"name" config233_column:"rewardName"`
+	Weight  float64  `json:"weight"`
+	ItemIds []int    `json:"itemIds" xml:"itemId"`
+	Tags    []string `json:"tags"`
+}
+
+// TestXmlConfigHandler_AttributesAndLeafNodes 测试属性、叶子节点、命名空间和 CDATA
+func TestXmlConfigHandler_AttributesAndLeafNodes(t *testing.T) {
+	handler := &xml.XmlConfigHandler{}
+	if handler.TypeName() != "xml" {
+		t.Errorf("期望类型名称为 'xml'，实际得到 '%s'", handler.TypeName())
+	}
+
+	path := writeTextFile(t, t.TempDir(), "XmlRewardConfig.xml", `<?xml version="1.0" encoding="UTF-8"?>
+<cfg:root xmlns:cfg="http://example.com/config">
+    <cfg:reward id="1" weight="0.5">
+        <cfg:rewardName><![CDATA[<金币>]]></cfg:rewardName>
+        <cfg:itemId>1001</cfg:itemId>
+        <cfg:itemId>1002</cfg:itemId>
+        <tags>a,b</tags>
+    </cfg:reward>
+    <reward id="2" rewardName="钻石"/>
+</cfg:root>`)
+
+	result := mustReadDataList(t, handler, "XmlRewardConfig", path)
+	if result.Type != "xml" {
+		t.Errorf("期望 DTO 类型为 'xml'，实际得到 '%s'", result.Type)
+	}
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(result.DataList))
+	}
+	if result.DataList[0]["rewardName"] != "<金币>" {
+		t.Errorf("CDATA 字段解析错误: %v", result.DataList[0]["rewardName"])
+	}
+	if result.DataList[1]["rewardName"] != "钻石" {
+		t.Errorf("属性字段解析错误: %v", result.DataList[1]["rewardName"])
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(XmlRewardConfig{}), "XmlRewardConfig", path)
+	if len(list) != 2 {
+		t.Fatalf("期望 2 个对象，实际 %d 个", len(list))
+	}
+	first := list[0].(XmlRewardConfig)
+	if first.Id != 1 || first.Name != "<金币>" || first.Weight != 0.5 {
+		t.Errorf("ORM 解析错误: %+v", first)
+	}
+	if len(first.ItemIds) != 2 || first.ItemIds[1] != 1002 {
+		t.Errorf("重复节点解析错误: %+v", first.ItemIds)
+	}
+	if len(first.Tags) != 2 || first.Tags[1] != "b" {
+		t.Errorf("逗号分隔字段解析错误: %+v", first.Tags)
+	}
+}
+
+// TestXmlConfigHandler_EmptyFile 测试空文件和空根节点
+func TestXmlConfigHandler_EmptyFile(t *testing.T) {
+	handler := &xml.XmlConfigHandler{}
+	tempDir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"Empty.xml":     "",
+		"EmptyRoot.xml": "<root></root>",
+	} {
+		path := writeTextFile(t, tempDir, name, content)
+
+		result := mustReadDataList(t, handler, name, path)
+		if result.DataList != nil {
+			t.Errorf("%s: 期望 DataList 为 nil，实际 %v", name, result.DataList)
+		}
+		if list := mustReadORM(t, handler, reflect.TypeOf(XmlRewardConfig{}), name, path); list != nil {
+			t.Errorf("%s: 期望 ORM 结果为 nil，实际 %v", name, list)
+		}
+	}
+}
+
+// TestXmlConfigHandler_StudentXml 测试 testdata 中的 XML 文件
+func TestXmlConfigHandler_StudentXml(t *testing.T) {
+	handler := &xml.XmlConfigHandler{}
+	path := filepath.Join(getTestDataDir(), "StudentXml.xml")
+
+	result := mustReadDataList(t, handler, "StudentXml", path)
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(result.DataList))
+	}
+	if result.DataList[0]["name"] != "xml-1" || result.DataList[1]["age"] != "2" {
+		t.Errorf("StudentXml 解析错误: %v", result.DataList)
+	}
+}
+
+// TestConfigManager233_LoadXmlConfigs 测试配置管理器识别并重新加载 .xml 文件
+func TestConfigManager233_LoadXmlConfigs(t *testing.T) {
+	tempDir := t.TempDir()
+	path := writeTextFile(t, tempDir, "XmlRewardConfig.xml", `<root><reward id="1" rewardName="金币"/></root>`)
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[XmlRewardConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	cfg, ok := config233.GetConfigById[XmlRewardConfig](1)
+	if !ok || cfg.Name != "金币" {
+		t.Fatalf("XML 配置加载错误: %+v", cfg)
+	}
+
+	writeTextFile(t, tempDir, filepath.Base(path), `<root><reward id="1" rewardName="银币"/></root>`)
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("重新加载配置失败: %v", err)
+	}
+	cfg, ok = config233.GetConfigById[XmlRewardConfig](1)
+	if !ok || cfg.Name != "银币" {
+		t.Fatalf("XML 配置重新加载错误: %+v", cfg)
+	}
+}
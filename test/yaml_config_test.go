@@ -0,0 +1,123 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/neko233-com/config233-go/pkg/config233"
+	"github.com/neko233-com/config233-go/pkg/config233/yaml"
+)
+
+// YamlSkillConfig 用于测试 YAML 配置的结构体
+type YamlSkillConfig struct {
+	Id       int      `json:"id" config233_column:"id"`
+	Name     string   `json:"name" config233_column:"skillName"`
+	Cooldown float64  `json:"cooldown"`
+	Tags     []string `json:"tags"`
+}
+
+// TestYamlConfigHandler_ArrayTopLevel 测试顶层为数组的 YAML
+func TestYamlConfigHandler_ArrayTopLevel(t *testing.T) {
+	handler := &yaml.YamlConfigHandler{}
+	if handler.TypeName() != "yaml" {
+		t.Errorf("期望类型名称为 'yaml'，实际得到 '%s'", handler.TypeName())
+	}
+
+	path := writeTextFile(t, t.TempDir(), "YamlSkillConfig.yaml", `
+# 技能配置
+- id: 1
+  skillName: fireball
+  cooldown: 1.5
+  tags: [fire, aoe]
+- id: 2
+  skillName: heal
+  cooldown: 3
+`)
+
+	result := mustReadDataList(t, handler, "YamlSkillConfig", path)
+	if result.Type != "yaml" {
+		t.Errorf("期望 DTO 类型为 'yaml'，实际得到 '%s'", result.Type)
+	}
+	if len(result.DataList) != 2 {
+		t.Fatalf("期望 2 条数据，实际 %d 条", len(result.DataList))
+	}
+	if result.DataList[0]["skillName"] != "fireball" {
+		t.Errorf("skillName 解析错误: %v", result.DataList[0]["skillName"])
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(YamlSkillConfig{}), "YamlSkillConfig", path)
+	if len(list) != 2 {
+		t.Fatalf("期望 2 个对象，实际 %d 个", len(list))
+	}
+	first := list[0].(YamlSkillConfig)
+	if first.Id != 1 || first.Name != "fireball" || first.Cooldown != 1.5 {
+		t.Errorf("ORM 解析错误: %+v", first)
+	}
+	if len(first.Tags) != 2 || first.Tags[0] != "fire" {
+		t.Errorf("tags 解析错误: %+v", first.Tags)
+	}
+}
+
+// TestYamlConfigHandler_ObjectTopLevel 测试顶层为单个对象的 YAML
+func TestYamlConfigHandler_ObjectTopLevel(t *testing.T) {
+	handler := &yaml.YamlConfigHandler{}
+	path := writeTextFile(t, t.TempDir(), "YamlSkillConfig.yml", "id: 7\nskillName: dash\n")
+
+	result := mustReadDataList(t, handler, "YamlSkillConfig", path)
+	if len(result.DataList) != 1 {
+		t.Fatalf("期望 1 条数据，实际 %d 条", len(result.DataList))
+	}
+
+	list := mustReadORM(t, handler, reflect.TypeOf(YamlSkillConfig{}), "YamlSkillConfig", path)
+	if len(list) != 1 || list[0].(YamlSkillConfig).Name != "dash" {
+		t.Fatalf("对象型 YAML ORM 解析错误: %+v", list)
+	}
+}
+
+// TestYamlConfigHandler_EmptyAndCommentOnly 测试空文件和只有注释的文件
+func TestYamlConfigHandler_EmptyAndCommentOnly(t *testing.T) {
+	handler := &yaml.YamlConfigHandler{}
+	tempDir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"Empty.yaml":       "",
+		"CommentOnly.yaml": "# 只有注释\n# 没有数据\n",
+	} {
+		path := writeTextFile(t, tempDir, name, content)
+
+		result := mustReadDataList(t, handler, name, path)
+		if result.DataList != nil {
+			t.Errorf("%s: 期望 DataList 为 nil，实际 %v", name, result.DataList)
+		}
+		if list := mustReadORM(t, handler, reflect.TypeOf(YamlSkillConfig{}), name, path); list != nil {
+			t.Errorf("%s: 期望 ORM 结果为 nil，实际 %v", name, list)
+		}
+	}
+}
+
+// TestConfigManager233_LoadYamlConfigs 测试配置管理器识别 .yaml/.yml 文件
+func TestConfigManager233_LoadYamlConfigs(t *testing.T) {
+	tempDir := t.TempDir()
+	writeTextFile(t, tempDir, "YamlSkillConfig.yaml", "- id: 1\n  skillName: fireball\n- id: 2\n  skillName: heal\n")
+	writeTextFile(t, tempDir, "YamlOtherConfig.yml", "- id: a\n  value: x\n")
+
+	manager := config233.NewConfigManager233(tempDir)
+	config233.Instance = manager
+	config233.RegisterType[YamlSkillConfig]()
+
+	if err := manager.LoadAllConfigs(); err != nil {
+		t.Fatalf("加载配置失败: %v", err)
+	}
+
+	if manager.GetConfigCount("YamlOtherConfig") != 1 {
+		t.Errorf("期望 .yml 配置加载 1 条，实际 %d 条", manager.GetConfigCount("YamlOtherConfig"))
+	}
+
+	cfg, ok := config233.GetConfigById[YamlSkillConfig](2)
+	if !ok {
+		t.Fatal("未找到 id=2 的 YAML 配置")
+	}
+	if cfg.Name != "heal" {
+		t.Errorf("期望 Name=heal，实际 %q", cfg.Name)
+	}
+}